@@ -1,15 +1,19 @@
 package app
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log/slog"
+	"net/http"
 	"net/url"
 	"os"
 	"strconv"
 	"strings"
 
+	"github.com/sgaunet/gitlab-mcp/internal/gitlaberr"
 	"github.com/sgaunet/gitlab-mcp/internal/logger"
+	"github.com/sgaunet/gitlab-mcp/internal/metrics"
 	"gitlab.com/gitlab-org/api/client-go"
 )
 
@@ -39,22 +43,27 @@ var (
 	ErrInvalidMilestoneIdentifierType = errors.New("invalid milestone identifier type")
 	ErrMilestoneNotFound              = errors.New("milestone not found")
 	ErrLabelValidationFailed          = errors.New("label validation failed")
+	ErrInvalidLogLevel                = errors.New("log level must be one of: debug, info, warn, error")
+	ErrInvalidIssueReference          = errors.New("issue reference must look like '#123' or 'group/project#123'")
 )
 
 type App struct {
-	GitLabToken    string
-	GitLabURI      string
-	ValidateLabels bool
-	client         GitLabClient
-	logger         *slog.Logger
+	GitLabToken          string
+	GitLabURI            string
+	ValidateLabels       bool
+	AllowApprovalActions bool
+	client               GitLabClient
+	logger               *slog.Logger
+	cache                Cache
+	resolvers            *resolverCache
+	logLevel             *slog.LevelVar
+	metricsSink          metrics.Sink
+	retryConfig          RetryConfig
+	clock                Clock
+	reportConfig         ReportConfig
 }
 
 func New() (*App, error) {
-	token := os.Getenv("GITLAB_TOKEN")
-	if token == "" {
-		return nil, ErrGitLabTokenRequired
-	}
-
 	uri := os.Getenv("GITLAB_URI")
 	if uri == "" {
 		uri = defaultGitLabURI
@@ -64,6 +73,16 @@ func New() (*App, error) {
 		return nil, fmt.Errorf("invalid GitLab URI: %w", err)
 	}
 
+	store, err := NewCredentialStore(uri, DefaultCredentialsFilePath())
+	if err != nil {
+		return nil, err
+	}
+
+	cred, ok := store.Lookup(uri)
+	if !ok {
+		return nil, ErrGitLabTokenRequired
+	}
+
 	// Parse validate labels setting (default: true)
 	validateLabels := true
 	if validateStr := os.Getenv("GITLAB_VALIDATE_LABELS"); validateStr != "" {
@@ -72,25 +91,53 @@ func New() (*App, error) {
 		}
 	}
 
-	var client *gitlab.Client
-	var err error
-	if uri == "https://gitlab.com/" {
-		client, err = gitlab.NewClient(token)
-	} else {
-		client, err = gitlab.NewClient(token, gitlab.WithBaseURL(uri))
+	// Parse approval actions setting (default: false, since it gates write endpoints)
+	allowApprovalActions := false
+	if allowStr := os.Getenv("GITLAB_ALLOW_APPROVAL_ACTIONS"); allowStr != "" {
+		if parsed, err := strconv.ParseBool(allowStr); err == nil {
+			allowApprovalActions = parsed
+		}
 	}
 
+	a := &App{
+		GitLabToken:          gitLabTokenOf(cred),
+		GitLabURI:            uri,
+		ValidateLabels:       validateLabels,
+		AllowApprovalActions: allowApprovalActions,
+		logger:               logger.NoLogger(),
+		cache:                NewLRUCache(defaultCacheCapacity),
+		resolvers:            newResolverCache(resolverCacheTTLFromEnv()),
+		logLevel:             new(slog.LevelVar),
+		metricsSink:          metrics.NewNoopSink(),
+		retryConfig:          DefaultRetryConfig(DefaultRetryPolicy()),
+		clock:                realClock{},
+		reportConfig:         DefaultReportConfig(),
+	}
+
+	networkTransport := newCredentialTransport(nil, cred)
+	httpClient := &http.Client{Transport: newInstrumentedTransport(networkTransport, a)}
+
+	client, err := cred.NewClient(uri, httpClient)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create GitLab client: %w", err)
+		return nil, err
 	}
 
-	return &App{
-		GitLabToken:    token,
-		GitLabURI:      uri,
-		ValidateLabels: validateLabels,
-		client:         NewGitLabClient(client),
-		logger:         logger.NoLogger(),
-	}, nil
+	a.client = NewGitLabClient(client)
+	return a, nil
+}
+
+// gitLabTokenOf extracts the raw token backing cred, for App.GitLabToken's existing callers
+// (e.g. diagnostics). Credentials without a fixed bearer token (OAuth2Token, Anonymous) report
+// an empty string.
+func gitLabTokenOf(cred Credential) string {
+	switch c := cred.(type) {
+	case *PersonalAccessToken:
+		return c.Token
+	case *JobToken:
+		return c.Token
+	default:
+		return ""
+	}
 }
 
 // NewWithClient creates a new App instance with an injected GitLabClient (for testing).
@@ -101,6 +148,13 @@ func NewWithClient(token, uri string, client GitLabClient) *App {
 		ValidateLabels: true, // default for tests
 		client:         client,
 		logger:         logger.NoLogger(),
+		cache:          NewLRUCache(defaultCacheCapacity),
+		resolvers:      newResolverCache(defaultResolverCacheTTL),
+		logLevel:       new(slog.LevelVar),
+		metricsSink:    metrics.NewNoopSink(),
+		retryConfig:    DefaultRetryConfig(NoRetryPolicy()),
+		clock:          realClock{},
+		reportConfig:   DefaultReportConfig(),
 	}
 }
 
@@ -113,6 +167,33 @@ func NewWithClientAndValidation(token, uri string, client GitLabClient, validate
 		ValidateLabels: validateLabels,
 		client:         client,
 		logger:         logger.NoLogger(),
+		cache:          NewLRUCache(defaultCacheCapacity),
+		resolvers:      newResolverCache(defaultResolverCacheTTL),
+		logLevel:       new(slog.LevelVar),
+		metricsSink:    metrics.NewNoopSink(),
+		retryConfig:    DefaultRetryConfig(NoRetryPolicy()),
+		clock:          realClock{},
+		reportConfig:   DefaultReportConfig(),
+	}
+}
+
+// NewWithClientAndCache creates a new App instance with an injected GitLabClient and a custom
+// Cache implementation, for callers that want to plug in their own response cache (e.g. a
+// distributed cache) instead of the default in-memory LRU.
+func NewWithClientAndCache(token, uri string, client GitLabClient, cache Cache) *App {
+	return &App{
+		GitLabToken:    token,
+		GitLabURI:      uri,
+		ValidateLabels: true,
+		client:         client,
+		logger:         logger.NoLogger(),
+		cache:          cache,
+		resolvers:      newResolverCache(defaultResolverCacheTTL),
+		logLevel:       new(slog.LevelVar),
+		metricsSink:    metrics.NewNoopSink(),
+		retryConfig:    DefaultRetryConfig(NoRetryPolicy()),
+		clock:          realClock{},
+		reportConfig:   DefaultReportConfig(),
 	}
 }
 
@@ -124,20 +205,112 @@ func (a *App) SetLogger(l *slog.Logger) {
 	a.logger = l
 }
 
+// SetCache replaces the App's response cache (default: an in-memory LRUCache).
+func (a *App) SetCache(c Cache) {
+	a.cache = c
+}
+
+// SetMetricsSink replaces the App's metrics sink (default: a no-op sink). Call this during
+// startup to wire a metrics.PrometheusSink or metrics.StatsdSink; every GitLab API call made
+// afterwards is observed through it by the instrumentedTransport built in New().
+func (a *App) SetMetricsSink(sink metrics.Sink) {
+	a.metricsSink = sink
+}
+
+// SetRetryConfig replaces the App's retry configuration (default: DefaultRetryPolicy() for
+// New(), NoRetryPolicy() for the NewWithClient* test constructors).
+func (a *App) SetRetryConfig(cfg RetryConfig) {
+	a.retryConfig = cfg
+}
+
+// SetClock replaces the App's Clock (default: the real clock). Tests use this to make retry
+// backoff instantaneous instead of actually sleeping.
+func (a *App) SetClock(c Clock) {
+	a.clock = c
+}
+
+// SetReportConfig replaces the App's report_finding severity mapping (default: DefaultReportConfig()).
+func (a *App) SetReportConfig(cfg ReportConfig) {
+	a.reportConfig = cfg
+}
+
+// SetAllowApprovalActions toggles whether merge request approval write endpoints (approve,
+// unapprove, change approval configuration, approval rule CRUD) are permitted. It defaults to
+// false so that an assistant can't cast approvals or edit approval rules unless an operator
+// has explicitly opted in (env var GITLAB_ALLOW_APPROVAL_ACTIONS).
+func (a *App) SetAllowApprovalActions(allow bool) {
+	a.AllowApprovalActions = allow
+}
+
+// SetLogLevelVar shares levelVar as the App's runtime-adjustable log level. Pass the same
+// *slog.LevelVar used to build the logger passed to SetLogger so that SetLogLevel immediately
+// changes the verbosity of every log record emitted by every subsystem (client, issues, epics,
+// groups, projects), since they all log through that one shared logger.
+func (a *App) SetLogLevelVar(levelVar *slog.LevelVar) {
+	a.logLevel = levelVar
+}
+
+// GetLogLevel returns the App's current log level as a lowercase string (debug, info, warn, error).
+func (a *App) GetLogLevel() string {
+	return strings.ToLower(a.logLevel.Level().String())
+}
+
+// SetLogLevel parses level (debug, info, warn, or error; case-insensitive) and applies it to the
+// App's shared LevelVar, returning the previous level. It returns ErrInvalidLogLevel if level
+// isn't one of those four values.
+func (a *App) SetLogLevel(level string) (previous string, err error) {
+	parsed, ok := logger.ParseLevel(level)
+	if !ok {
+		return "", ErrInvalidLogLevel
+	}
+
+	previous = a.GetLogLevel()
+	a.logLevel.Set(parsed)
+	a.logger.Info("Log level changed", "previous", previous, "new", a.GetLogLevel())
+	return previous, nil
+}
+
 func (a *App) ValidateConnection() error {
 	_, _, err := a.client.Users().CurrentUser()
 	if err != nil {
-		return fmt.Errorf("failed to validate token: %w", err)
+		return gitlaberr.Classify(err, "failed to validate token")
 	}
 
 	return nil
 }
 
 // ListIssuesOptions contains options for listing project issues.
+//
+// IncludeGroupIssues additionally merges in issues from the project's ancestor group (deduplicated
+// by issue ID); it is silently ignored for top-level projects with no group in their path, and a
+// failed group-level fetch falls back to project-only results rather than failing the request.
+//
+// ListAll walks every page via Paginator instead of returning just the first, bounded by
+// MaxItems (0 = Paginator's default). Truncated is an output field: ListProjectIssues sets it to
+// true when ListAll stopped early because a cap was hit, so callers can surface a partial-result
+// marker.
+//
+// Page selects which single page to fetch (1-indexed, default 1) and is ignored when ListAll is
+// set. NextPage and TotalItems are output fields populated from GitLab's X-Next-Page/X-Total
+// response headers for that single-page fetch; NextPage is 0 once there is no further page.
+//
+// IterationTitle filters by iteration name instead of IterationID, resolved to an ID via the
+// project's ancestor group's iterations (see resolveIterationIdentifier); it is ignored when
+// IterationID is already set.
 type ListIssuesOptions struct {
-	State  string
-	Labels string
-	Limit  int64
+	State              string
+	Labels             string
+	Limit              int64
+	Page               int64
+	IterationID        *int64
+	IterationTitle     *string
+	MilestoneTitle     string
+	IncludeGroupIssues bool
+	ListAll            bool
+	MaxItems           int
+	Truncated          bool
+	NextPage           int64
+	TotalItems         int64
 }
 
 // CreateIssueOptions contains options for creating a project issue.
@@ -146,23 +319,43 @@ type CreateIssueOptions struct {
 	Description string
 	Labels      []string
 	Assignees   []int64
+	Milestone   any // Can be title (string) or ID (int)
 }
 
 // UpdateIssueOptions contains options for updating a project issue.
+//
+// Labels and Assignees accept a +/- diff list (see ParseDiffList): a bare value replaces the
+// entire set, while "+value" and "-value"/"!value" adjust the existing set in place. Assignees
+// are usernames, resolved to IDs via findUserByUsername (resolver-cached).
 type UpdateIssueOptions struct {
 	Title       string
 	Description string
 	State       string
 	Labels      []string
-	Assignees   []int64
+	Assignees   []string
+	Milestone   any // Can be title (string) or ID (int)
 }
 
 // ListLabelsOptions contains options for listing project labels.
+//
+// ListAll and MaxItems behave as on ListIssuesOptions: ListAll walks every page via Paginator,
+// bounded by MaxItems, and ListProjectLabels sets the output field Truncated to true when a cap
+// was hit before GitLab reported the result set as exhausted.
+//
+// Page, NextPage, and TotalItems behave as on ListIssuesOptions: Page selects the single page to
+// fetch (ignored when ListAll is set), and NextPage/TotalItems are populated from that fetch's
+// X-Next-Page/X-Total response headers.
 type ListLabelsOptions struct {
 	WithCounts            bool
 	IncludeAncestorGroups bool
 	Search                string
 	Limit                 int64
+	Page                  int64
+	ListAll               bool
+	MaxItems              int
+	Truncated             bool
+	NextPage              int64
+	TotalItems            int64
 }
 
 // AddIssueNoteOptions contains options for adding a note to an issue.
@@ -179,7 +372,11 @@ type CreateMergeRequestOptions struct {
 	Assignees          []any // Can be usernames (string) or IDs (int)
 	Reviewers          []any // Can be usernames (string) or IDs (int)
 	Labels             []string
-	Milestone          any // Can be title (string) or ID (int)
+	Milestone          any    // Can be title (string) or ID (int)
+	RelatedIssue       string // Issue to copy from/close on merge, e.g. "#123" or "group/project#123"
+	CopyIssueLabels    bool
+	CopyIssueMilestone bool
+	CopyIssueAssignees bool
 	RemoveSourceBranch bool
 	Draft              bool
 }
@@ -193,6 +390,8 @@ type Issue struct {
 	State       string           `json:"state"`
 	Labels      []string         `json:"labels"`
 	Assignees   []map[string]any `json:"assignees"`
+	Milestone   map[string]any   `json:"milestone,omitempty"`
+	Iteration   *Iteration       `json:"iteration,omitempty"`
 	CreatedAt   string           `json:"created_at"`
 	UpdatedAt   string           `json:"updated_at"`
 }
@@ -268,7 +467,7 @@ func convertGitLabIssue(issue *gitlab.Issue) Issue {
 		})
 	}
 
-	return Issue{
+	result := Issue{
 		ID:          issue.ID,
 		IID:         issue.IID,
 		Title:       issue.Title,
@@ -276,9 +475,23 @@ func convertGitLabIssue(issue *gitlab.Issue) Issue {
 		State:       issue.State,
 		Labels:      issue.Labels,
 		Assignees:   assignees,
-		CreatedAt:   issue.CreatedAt.Format("2006-01-02T15:04:05Z"),
-		UpdatedAt:   issue.UpdatedAt.Format("2006-01-02T15:04:05Z"),
+		CreatedAt:   formatGitLabTime(issue.CreatedAt),
+		UpdatedAt:   formatGitLabTime(issue.UpdatedAt),
+	}
+
+	if issue.Iteration != nil {
+		iteration := convertGitLabIteration(issue.Iteration)
+		result.Iteration = &iteration
 	}
+
+	if issue.Milestone != nil {
+		result.Milestone = map[string]any{
+			"id":    issue.Milestone.ID,
+			"title": issue.Milestone.Title,
+		}
+	}
+
+	return result
 }
 
 // convertGitLabMergeRequest converts a GitLab merge request to our MergeRequest struct.
@@ -337,8 +550,8 @@ func convertGitLabMergeRequest(mr *gitlab.MergeRequest) MergeRequest {
 		Milestone:    milestone,
 		WebURL:       mr.WebURL,
 		Draft:        mr.Draft,
-		CreatedAt:    mr.CreatedAt.Format("2006-01-02T15:04:05Z"),
-		UpdatedAt:    mr.UpdatedAt.Format("2006-01-02T15:04:05Z"),
+		CreatedAt:    formatGitLabTime(mr.CreatedAt),
+		UpdatedAt:    formatGitLabTime(mr.UpdatedAt),
 	}
 }
 
@@ -353,8 +566,11 @@ func normalizeListIssuesOptions(opts *ListIssuesOptions) *ListIssuesOptions {
 	if opts.Limit == 0 {
 		opts.Limit = maxIssuesPerPage
 	}
-	if opts.Limit > maxIssuesPerPage {
-		opts.Limit = maxIssuesPerPage
+	if opts.Limit > defaultMaxPaginatedItems {
+		opts.Limit = defaultMaxPaginatedItems
+	}
+	if opts.Page == 0 {
+		opts.Page = 1
 	}
 	return opts
 }
@@ -364,20 +580,21 @@ func (a *App) ListProjectIssues(projectPath string, opts *ListIssuesOptions) ([]
 	a.logger.Debug("Listing issues for project", "project_path", projectPath, "options", opts)
 
 	// Get project by path
-	project, _, err := a.client.Projects().GetProject(projectPath, nil)
+	project, err := a.getProjectCached(projectPath)
 	if err != nil {
 		a.logger.Error("Failed to get project", "error", err, "project_path", projectPath)
-		return nil, fmt.Errorf("failed to get project: %w", err)
+		return nil, err
 	}
 	projectID := project.ID
 
 	// Normalize options
 	opts = normalizeListIssuesOptions(opts)
 
-	// Create GitLab API options
+	// Create GitLab API options. PerPage is capped at maxIssuesPerPage regardless of Limit;
+	// a Limit beyond one page's worth is satisfied by auto-paginating below.
 	listOpts := &gitlab.ListProjectIssuesOptions{
 		State:       &opts.State,
-		ListOptions: gitlab.ListOptions{PerPage: opts.Limit, Page: 1},
+		ListOptions: gitlab.ListOptions{PerPage: cappedPerPage(opts.Limit, maxIssuesPerPage), Page: opts.Page},
 	}
 
 	// Add labels filter if provided
@@ -390,15 +607,97 @@ func (a *App) ListProjectIssues(projectPath string, opts *ListIssuesOptions) ([]
 		}
 	}
 
-	// Call GitLab API
-	issues, _, err := a.client.Issues().ListProjectIssues(projectID, listOpts)
-	if err != nil {
-		a.logger.Error("Failed to list project issues", "error", err, "project_id", projectID)
-		return nil, fmt.Errorf("failed to list project issues: %w", err)
+	// Add iteration filter if provided, resolving a title to an ID if needed
+	if opts.IterationID == nil && opts.IterationTitle != nil {
+		iterationID, err := a.resolveIterationIdentifier(projectPath, *opts.IterationTitle)
+		if err != nil {
+			a.logger.Error("Failed to resolve iteration", "error", err, "iteration_title", *opts.IterationTitle)
+			return nil, err
+		}
+		opts.IterationID = &iterationID
+	}
+	if opts.IterationID != nil {
+		listOpts.IterationID = opts.IterationID
+	}
+
+	// Add milestone filter if provided
+	if opts.MilestoneTitle != "" {
+		listOpts.Milestone = &opts.MilestoneTitle
+	}
+
+	// Call GitLab API, auto-paginating until Limit items are collected or GitLab reports no
+	// further page; ListAll instead walks every page up to MaxItems, ignoring Limit. The full
+	// page-walk result is cached as a unit, keyed on every option that affects it, so a repeated
+	// call with identical opts within issuesListCacheTTL skips the round-trip entirely.
+	cacheKey := issuesListCacheKey(projectID, opts, listOpts)
+	var issues []*gitlab.Issue
+	cached, cacheHit := a.cache.Get(cacheKey)
+	if entry, ok := cached.Value.(pagedListCacheEntry[*gitlab.Issue]); cacheHit && ok {
+		a.logger.Debug("Cache hit", "key", cacheKey)
+		issues = entry.Items
+		opts.Truncated = entry.Truncated
+		opts.NextPage = entry.NextPage
+		opts.TotalItems = entry.TotalItems
+	} else {
+		if opts.ListAll {
+			paginator := &Paginator[*gitlab.Issue]{
+				MaxItems: opts.MaxItems,
+				Fetch: func(cursor string) ([]*gitlab.Issue, *gitlab.Response, error) {
+					listOpts.Page = cursorToPage(cursor, listOpts.Page)
+					return retryCall(a, context.Background(), "ListProjectIssues",
+						func() ([]*gitlab.Issue, *gitlab.Response, error) {
+							return a.client.Issues().ListProjectIssues(projectID, listOpts)
+						})
+				},
+			}
+			result, err := paginator.FetchAll()
+			if err != nil {
+				a.logger.Error("Failed to list project issues", "error", err, "project_id", projectID)
+				return nil, gitlaberr.Classify(err, "failed to list project issues")
+			}
+			issues = result.Items
+			opts.Truncated = result.Truncated
+		} else {
+			var lastResp *gitlab.Response
+			paginator := &Paginator[*gitlab.Issue]{
+				MaxItems: int(opts.Limit),
+				Fetch: func(cursor string) ([]*gitlab.Issue, *gitlab.Response, error) {
+					listOpts.Page = cursorToPage(cursor, listOpts.Page)
+					items, resp, err := retryCall(a, context.Background(), "ListProjectIssues",
+						func() ([]*gitlab.Issue, *gitlab.Response, error) {
+							return a.client.Issues().ListProjectIssues(projectID, listOpts)
+						})
+					lastResp = resp
+					return items, resp, err
+				},
+			}
+			result, err := paginator.FetchAll()
+			if err != nil {
+				a.logger.Error("Failed to list project issues", "error", err, "project_id", projectID)
+				return nil, gitlaberr.Classify(err, "failed to list project issues")
+			}
+			issues = result.Items
+			opts.Truncated = result.Truncated
+			opts.NextPage, opts.TotalItems = paginationMeta(lastResp)
+		}
+
+		a.cache.Set(cacheKey, CacheEntry{Value: pagedListCacheEntry[*gitlab.Issue]{
+			Items:      issues,
+			Truncated:  opts.Truncated,
+			NextPage:   opts.NextPage,
+			TotalItems: opts.TotalItems,
+		}}, issuesListCacheTTL)
 	}
 
 	a.logger.Debug("Retrieved issues", "count", len(issues), "project_id", projectID)
 
+	// Merge in group-level issues if requested, falling back to project-only issues on any error
+	if opts.IncludeGroupIssues {
+		if groupIssues, ok := a.listGroupIssuesForMerge(projectPath, opts); ok {
+			issues = mergeIssues(issues, groupIssues, projectID)
+		}
+	}
+
 	// Convert GitLab issues to our Issue struct
 	result := make([]Issue, 0, len(issues))
 	for _, issue := range issues {
@@ -422,10 +721,10 @@ func (a *App) CreateProjectIssue(projectPath string, opts *CreateIssueOptions) (
 	a.logger.Debug("Creating issue for project", "project_path", projectPath, "title", opts.Title)
 
 	// Get project by path
-	project, _, err := a.client.Projects().GetProject(projectPath, nil)
+	project, err := a.getProjectCached(projectPath)
 	if err != nil {
 		a.logger.Error("Failed to get project", "error", err, "project_path", projectPath)
-		return nil, fmt.Errorf("failed to get project: %w", err)
+		return nil, err
 	}
 	projectID := project.ID
 
@@ -446,6 +745,18 @@ func (a *App) CreateProjectIssue(projectPath string, opts *CreateIssueOptions) (
 		createOpts.AssigneeIDs = &opts.Assignees
 	}
 
+	// Resolve milestone (title to ID)
+	if opts.Milestone != nil {
+		milestoneID, err := a.resolveMilestoneIdentifier(projectID, opts.Milestone)
+		if err != nil {
+			a.logger.Error("Failed to resolve milestone", "error", err)
+			return nil, fmt.Errorf("failed to resolve milestone: %w", err)
+		}
+		if milestoneID > 0 {
+			createOpts.MilestoneID = &milestoneID
+		}
+	}
+
 	// Validate labels if validation is enabled
 	if a.ValidateLabels && len(opts.Labels) > 0 {
 		if err := a.validateLabels(projectID, projectPath, opts.Labels); err != nil {
@@ -454,10 +765,13 @@ func (a *App) CreateProjectIssue(projectPath string, opts *CreateIssueOptions) (
 	}
 
 	// Call GitLab API
-	issue, _, err := a.client.Issues().CreateIssue(projectID, createOpts)
+	issue, _, err := retryCall(a, context.Background(), "CreateIssue",
+		func() (*gitlab.Issue, *gitlab.Response, error) {
+			return a.client.Issues().CreateIssue(projectID, createOpts)
+		})
 	if err != nil {
 		a.logger.Error("Failed to create issue", "error", err, "project_id", projectID, "title", opts.Title)
-		return nil, fmt.Errorf("failed to create issue: %w", err)
+		return nil, gitlaberr.Classify(err, "failed to create issue")
 	}
 
 	a.logger.Debug("Created issue", "id", issue.ID, "iid", issue.IID, "project_id", projectID)
@@ -476,10 +790,10 @@ func (a *App) ListProjectLabels(projectPath string, opts *ListLabelsOptions) ([]
 	a.logger.Debug("Listing labels for project", "project_path", projectPath, "options", opts)
 
 	// Get project by path
-	project, _, err := a.client.Projects().GetProject(projectPath, nil)
+	project, err := a.getProjectCached(projectPath)
 	if err != nil {
 		a.logger.Error("Failed to get project", "error", err, "project_path", projectPath)
-		return nil, fmt.Errorf("failed to get project: %w", err)
+		return nil, err
 	}
 	projectID := project.ID
 
@@ -496,15 +810,19 @@ func (a *App) ListProjectLabels(projectPath string, opts *ListLabelsOptions) ([]
 	if opts.Limit == 0 {
 		opts.Limit = maxLabelsPerPage
 	}
-	if opts.Limit > maxLabelsPerPage {
-		opts.Limit = maxLabelsPerPage // Cap at max labels per page
+	if opts.Limit > defaultMaxPaginatedItems {
+		opts.Limit = defaultMaxPaginatedItems
+	}
+	if opts.Page == 0 {
+		opts.Page = 1
 	}
 
-	// Create GitLab API options
+	// Create GitLab API options. PerPage is capped at maxLabelsPerPage regardless of Limit;
+	// a Limit beyond one page's worth is satisfied by auto-paginating below.
 	listOpts := &gitlab.ListLabelsOptions{
 		WithCounts:            &opts.WithCounts,
 		IncludeAncestorGroups: &opts.IncludeAncestorGroups,
-		ListOptions:           gitlab.ListOptions{PerPage: opts.Limit, Page: 1},
+		ListOptions:           gitlab.ListOptions{PerPage: cappedPerPage(opts.Limit, maxLabelsPerPage), Page: opts.Page},
 	}
 
 	// Add search filter if provided
@@ -512,11 +830,68 @@ func (a *App) ListProjectLabels(projectPath string, opts *ListLabelsOptions) ([]
 		listOpts.Search = &opts.Search
 	}
 
-	// Call GitLab API
-	labels, _, err := a.client.Labels().ListLabels(projectID, listOpts)
-	if err != nil {
-		a.logger.Error("Failed to list project labels", "error", err, "project_id", projectID)
-		return nil, fmt.Errorf("failed to list project labels: %w", err)
+	// Call GitLab API, auto-paginating until Limit items are collected or GitLab reports no
+	// further page; ListAll instead walks every page up to MaxItems, ignoring Limit. The full
+	// page-walk result is cached as a unit, keyed on every option that affects it, so a repeated
+	// call with identical opts within labelsListCacheTTL skips the round-trip entirely.
+	cacheKey := labelsListCacheKey(projectID, opts, listOpts)
+	var labels []*gitlab.Label
+	cached, cacheHit := a.cache.Get(cacheKey)
+	if entry, ok := cached.Value.(pagedListCacheEntry[*gitlab.Label]); cacheHit && ok {
+		a.logger.Debug("Cache hit", "key", cacheKey)
+		labels = entry.Items
+		opts.Truncated = entry.Truncated
+		opts.NextPage = entry.NextPage
+		opts.TotalItems = entry.TotalItems
+	} else {
+		if opts.ListAll {
+			paginator := &Paginator[*gitlab.Label]{
+				MaxItems: opts.MaxItems,
+				Fetch: func(cursor string) ([]*gitlab.Label, *gitlab.Response, error) {
+					listOpts.Page = cursorToPage(cursor, listOpts.Page)
+					return retryCall(a, context.Background(), "ListLabels",
+						func() ([]*gitlab.Label, *gitlab.Response, error) {
+							return a.client.Labels().ListLabels(projectID, listOpts)
+						})
+				},
+			}
+			result, err := paginator.FetchAll()
+			if err != nil {
+				a.logger.Error("Failed to list project labels", "error", err, "project_id", projectID)
+				return nil, gitlaberr.Classify(err, "failed to list project labels")
+			}
+			labels = result.Items
+			opts.Truncated = result.Truncated
+		} else {
+			var lastResp *gitlab.Response
+			paginator := &Paginator[*gitlab.Label]{
+				MaxItems: int(opts.Limit),
+				Fetch: func(cursor string) ([]*gitlab.Label, *gitlab.Response, error) {
+					listOpts.Page = cursorToPage(cursor, listOpts.Page)
+					items, resp, err := retryCall(a, context.Background(), "ListLabels",
+						func() ([]*gitlab.Label, *gitlab.Response, error) {
+							return a.client.Labels().ListLabels(projectID, listOpts)
+						})
+					lastResp = resp
+					return items, resp, err
+				},
+			}
+			result, err := paginator.FetchAll()
+			labels = result.Items
+			opts.Truncated = result.Truncated
+			opts.NextPage, opts.TotalItems = paginationMeta(lastResp)
+			if err != nil {
+				a.logger.Error("Failed to list project labels", "error", err, "project_id", projectID)
+				return nil, gitlaberr.Classify(err, "failed to list project labels")
+			}
+		}
+
+		a.cache.Set(cacheKey, CacheEntry{Value: pagedListCacheEntry[*gitlab.Label]{
+			Items:      labels,
+			Truncated:  opts.Truncated,
+			NextPage:   opts.NextPage,
+			TotalItems: opts.TotalItems,
+		}}, labelsListCacheTTL)
 	}
 
 	a.logger.Debug("Retrieved labels", "count", len(labels), "project_id", projectID)
@@ -556,10 +931,10 @@ func (a *App) UpdateProjectIssue(projectPath string, issueIID int64, opts *Updat
 	a.logger.Debug("Updating issue for project", "project_path", projectPath, "issue_iid", issueIID)
 
 	// Get project by path
-	project, _, err := a.client.Projects().GetProject(projectPath, nil)
+	project, err := a.getProjectCached(projectPath)
 	if err != nil {
 		a.logger.Error("Failed to get project", "error", err, "project_path", projectPath)
-		return nil, fmt.Errorf("failed to get project: %w", err)
+		return nil, err
 	}
 	projectID := project.ID
 
@@ -578,22 +953,41 @@ func (a *App) UpdateProjectIssue(projectPath string, issueIID int64, opts *Updat
 		updateOpts.StateEvent = &opts.State
 	}
 
-	// Add labels if provided
+	// Add labels if provided, as a +/- diff against the issue's existing labels
 	if len(opts.Labels) > 0 {
-		labels := gitlab.LabelOptions(opts.Labels)
-		updateOpts.Labels = &labels
+		applyIssueLabelDiff(updateOpts, ParseLabels(opts.Labels))
 	}
 
-	// Add assignees if provided
+	// Resolve assignees if provided, as a +/- diff against the issue's existing assignees
 	if len(opts.Assignees) > 0 {
-		updateOpts.AssigneeIDs = &opts.Assignees
+		assigneeIDs, err := a.resolveIssueAssigneeDiff(projectID, issueIID, ParseAssignees(opts.Assignees))
+		if err != nil {
+			a.logger.Error("Failed to resolve assignees", "error", err)
+			return nil, fmt.Errorf("failed to resolve assignees: %w", err)
+		}
+		updateOpts.AssigneeIDs = &assigneeIDs
+	}
+
+	// Resolve milestone (title to ID) if provided
+	if opts.Milestone != nil {
+		milestoneID, err := a.resolveMilestoneIdentifier(projectID, opts.Milestone)
+		if err != nil {
+			a.logger.Error("Failed to resolve milestone", "error", err)
+			return nil, fmt.Errorf("failed to resolve milestone: %w", err)
+		}
+		if milestoneID > 0 {
+			updateOpts.MilestoneID = &milestoneID
+		}
 	}
 
 	// Call GitLab API
-	issue, _, err := a.client.Issues().UpdateIssue(projectID, issueIID, updateOpts)
+	issue, _, err := retryCall(a, context.Background(), "UpdateIssue",
+		func() (*gitlab.Issue, *gitlab.Response, error) {
+			return a.client.Issues().UpdateIssue(projectID, issueIID, updateOpts)
+		})
 	if err != nil {
 		a.logger.Error("Failed to update issue", "error", err, "project_id", projectID, "issue_iid", issueIID)
-		return nil, fmt.Errorf("failed to update issue: %w", err)
+		return nil, gitlaberr.Classify(err, "failed to update issue")
 	}
 
 	a.logger.Debug("Updated issue", "id", issue.ID, "iid", issue.IID, "project_id", projectID)
@@ -607,6 +1001,73 @@ func (a *App) UpdateProjectIssue(projectPath string, issueIID int64, opts *Updat
 	return &result, nil
 }
 
+// UpdateIssueLabels applies a +/- diff list (see ParseDiffList) against an issue's labels without
+// touching any of its other fields. It's a thin convenience wrapper around UpdateProjectIssue for
+// callers that only want to adjust labels.
+func (a *App) UpdateIssueLabels(projectPath string, issueIID int64, labels []string) (*Issue, error) {
+	return a.UpdateProjectIssue(projectPath, issueIID, &UpdateIssueOptions{Labels: labels})
+}
+
+// applyIssueLabelDiff sets the Labels/AddLabels/RemoveLabels fields on updateOpts from a parsed
+// label diff, letting GitLab apply add/remove label changes server-side rather than requiring a
+// prior fetch of the issue's current labels.
+func applyIssueLabelDiff(updateOpts *gitlab.UpdateIssueOptions, diff DiffSet) {
+	if len(diff.Replace) > 0 {
+		labels := gitlab.LabelOptions(diff.Replace)
+		updateOpts.Labels = &labels
+		return
+	}
+	if len(diff.Add) > 0 {
+		add := gitlab.LabelOptions(diff.Add)
+		updateOpts.AddLabels = &add
+	}
+	if len(diff.Remove) > 0 {
+		remove := gitlab.LabelOptions(diff.Remove)
+		updateOpts.RemoveLabels = &remove
+	}
+}
+
+// resolveIssueAssigneeDiff resolves an assignee diff into the full set of assignee IDs the issue
+// should end up with. Add/remove tokens are applied against the issue's current assignees, since
+// GitLab's UpdateIssueOptions only supports replacing the full assignee set in one call.
+func (a *App) resolveIssueAssigneeDiff(projectID int64, issueIID int64, diff DiffSet) ([]int64, error) {
+	var current []int64
+	if len(diff.Add) > 0 || len(diff.Remove) > 0 {
+		issue, err := a.getIssueCached(projectID, int(issueIID))
+		if err != nil {
+			return nil, err
+		}
+		for _, assignee := range issue.Assignees {
+			current = append(current, assignee.ID)
+		}
+	}
+
+	return ApplyIDDiff(current, diff, a.findUserByUsername)
+}
+
+// GetProjectIssue fetches a single issue by project path and IID, serving from cache when
+// available.
+func (a *App) GetProjectIssue(projectPath string, issueIID int64) (*Issue, error) {
+	if issueIID <= 0 {
+		return nil, ErrInvalidIssueIID
+	}
+
+	project, err := a.getProjectCached(projectPath)
+	if err != nil {
+		a.logger.Error("Failed to get project", "error", err, "project_path", projectPath)
+		return nil, err
+	}
+
+	issue, err := a.getIssueCached(project.ID, int(issueIID))
+	if err != nil {
+		a.logger.Error("Failed to get issue", "error", err, "project_path", projectPath, "issue_iid", issueIID)
+		return nil, err
+	}
+
+	result := convertGitLabIssue(issue)
+	return &result, nil
+}
+
 // AddIssueNote adds a note/comment to an existing issue.
 func (a *App) AddIssueNote(projectPath string, issueIID int64, opts *AddIssueNoteOptions) (*Note, error) {
 	// Validate required parameters
@@ -620,10 +1081,10 @@ func (a *App) AddIssueNote(projectPath string, issueIID int64, opts *AddIssueNot
 	a.logger.Debug("Adding note to issue", "project_path", projectPath, "issue_iid", issueIID)
 
 	// Get project by path
-	project, _, err := a.client.Projects().GetProject(projectPath, nil)
+	project, err := a.getProjectCached(projectPath)
 	if err != nil {
 		a.logger.Error("Failed to get project", "error", err, "project_path", projectPath)
-		return nil, fmt.Errorf("failed to get project: %w", err)
+		return nil, err
 	}
 	projectID := project.ID
 
@@ -633,10 +1094,13 @@ func (a *App) AddIssueNote(projectPath string, issueIID int64, opts *AddIssueNot
 	}
 
 	// Call GitLab API
-	note, _, err := a.client.Notes().CreateIssueNote(projectID, issueIID, createOpts)
+	note, _, err := retryCall(a, context.Background(), "CreateIssueNote",
+		func() (*gitlab.Note, *gitlab.Response, error) {
+			return a.client.Notes().CreateIssueNote(projectID, issueIID, createOpts)
+		})
 	if err != nil {
 		a.logger.Error("Failed to create issue note", "error", err, "project_id", projectID, "issue_iid", issueIID)
-		return nil, fmt.Errorf("failed to create issue note: %w", err)
+		return nil, gitlaberr.Classify(err, "failed to create issue note")
 	}
 
 	a.logger.Debug("Created issue note", "id", note.ID, "project_id", projectID, "issue_iid", issueIID)
@@ -646,8 +1110,8 @@ func (a *App) AddIssueNote(projectPath string, issueIID int64, opts *AddIssueNot
 		ID:        note.ID,
 		Body:      note.Body,
 		System:    note.System,
-		CreatedAt: note.CreatedAt.Format("2006-01-02T15:04:05Z"),
-		UpdatedAt: note.UpdatedAt.Format("2006-01-02T15:04:05Z"),
+		CreatedAt: formatGitLabTime(note.CreatedAt),
+		UpdatedAt: formatGitLabTime(note.UpdatedAt),
 	}
 
 	// Convert author information
@@ -688,10 +1152,10 @@ func (a *App) CreateProjectMergeRequest(projectPath string, opts *CreateMergeReq
 		"target_branch", opts.TargetBranch)
 
 	// Get project by path
-	project, _, err := a.client.Projects().GetProject(projectPath, nil)
+	project, err := a.getProjectCached(projectPath)
 	if err != nil {
 		a.logger.Error("Failed to get project", "error", err, "project_path", projectPath)
-		return nil, fmt.Errorf("failed to get project: %w", err)
+		return nil, err
 	}
 	projectID := project.ID
 
@@ -702,13 +1166,16 @@ func (a *App) CreateProjectMergeRequest(projectPath string, opts *CreateMergeReq
 	}
 
 	// Call GitLab API
-	mr, _, err := a.client.MergeRequests().CreateMergeRequest(projectID, createOpts)
+	mr, _, err := retryCall(a, context.Background(), "CreateMergeRequest",
+		func() (*gitlab.MergeRequest, *gitlab.Response, error) {
+			return a.client.MergeRequests().CreateMergeRequest(projectID, createOpts)
+		})
 	if err != nil {
 		a.logger.Error("Failed to create merge request",
 			"error", err,
 			"project_id", projectID,
 			"title", opts.Title)
-		return nil, fmt.Errorf("failed to create merge request: %w", err)
+		return nil, gitlaberr.Classify(err, "failed to create merge request")
 	}
 
 	a.logger.Debug("Created merge request",
@@ -739,10 +1206,10 @@ func (a *App) GetProjectDescription(projectPath string) (*ProjectInfo, error) {
 	a.logger.Debug("Getting project description", "project_path", projectPath)
 
 	// Get project by path
-	project, _, err := a.client.Projects().GetProject(projectPath, nil)
+	project, err := a.getProjectCached(projectPath)
 	if err != nil {
 		a.logger.Error("Failed to get project", "error", err, "project_path", projectPath)
-		return nil, fmt.Errorf("failed to get project: %w", err)
+		return nil, err
 	}
 
 	result := &ProjectInfo{
@@ -763,10 +1230,10 @@ func (a *App) UpdateProjectDescription(projectPath string, description string) (
 	a.logger.Debug("Updating project description", "project_path", projectPath)
 
 	// Get project by path first to get the ID
-	project, _, err := a.client.Projects().GetProject(projectPath, nil)
+	project, err := a.getProjectCached(projectPath)
 	if err != nil {
 		a.logger.Error("Failed to get project", "error", err, "project_path", projectPath)
-		return nil, fmt.Errorf("failed to get project: %w", err)
+		return nil, err
 	}
 	projectID := project.ID
 
@@ -779,7 +1246,7 @@ func (a *App) UpdateProjectDescription(projectPath string, description string) (
 	updatedProject, _, err := a.client.Projects().EditProject(projectID, updateOpts)
 	if err != nil {
 		a.logger.Error("Failed to update project description", "error", err, "project_id", projectID)
-		return nil, fmt.Errorf("failed to update project description: %w", err)
+		return nil, gitlaberr.Classify(err, "failed to update project description")
 	}
 
 	result := &ProjectInfo{
@@ -801,10 +1268,10 @@ func (a *App) GetProjectTopics(projectPath string) (*ProjectInfo, error) {
 	a.logger.Debug("Getting project topics", "project_path", projectPath)
 
 	// Get project by path
-	project, _, err := a.client.Projects().GetProject(projectPath, nil)
+	project, err := a.getProjectCached(projectPath)
 	if err != nil {
 		a.logger.Error("Failed to get project", "error", err, "project_path", projectPath)
-		return nil, fmt.Errorf("failed to get project: %w", err)
+		return nil, err
 	}
 
 	result := &ProjectInfo{
@@ -821,28 +1288,34 @@ func (a *App) GetProjectTopics(projectPath string) (*ProjectInfo, error) {
 	return result, nil
 }
 
-// UpdateProjectTopics updates the topics of a GitLab project.
+// UpdateProjectTopics updates the topics of a GitLab project. topics is parsed as a +/- diff
+// list (see ParseDiffList): bare tokens replace the project's topics outright, while "+foo" /
+// "-foo" / "!foo" tokens add or remove foo from whatever topics the project already has. The
+// GitLab API has no incremental topics endpoint, so the resulting set is computed client-side
+// against the project's current topics and sent as a single EditProject call.
 func (a *App) UpdateProjectTopics(projectPath string, topics []string) (*ProjectInfo, error) {
 	a.logger.Debug("Updating project topics", "project_path", projectPath, "topics", topics)
 
-	// Get project by path first to get the ID
-	project, _, err := a.client.Projects().GetProject(projectPath, nil)
+	// Get project by path first to get the ID and, when needed, its current topics
+	project, err := a.getProjectCached(projectPath)
 	if err != nil {
 		a.logger.Error("Failed to get project", "error", err, "project_path", projectPath)
-		return nil, fmt.Errorf("failed to get project: %w", err)
+		return nil, err
 	}
 	projectID := project.ID
 
+	resolvedTopics := ApplyStringDiff(project.Topics, ParseDiffList(topics))
+
 	// Create update options
 	updateOpts := &gitlab.EditProjectOptions{
-		Topics: &topics,
+		Topics: &resolvedTopics,
 	}
 
 	// Update the project
 	updatedProject, _, err := a.client.Projects().EditProject(projectID, updateOpts)
 	if err != nil {
 		a.logger.Error("Failed to update project topics", "error", err, "project_id", projectID)
-		return nil, fmt.Errorf("failed to update project topics: %w", err)
+		return nil, gitlaberr.Classify(err, "failed to update project topics")
 	}
 
 	result := &ProjectInfo{
@@ -930,6 +1403,14 @@ func (a *App) buildMergeRequestOptions(projectID int64, opts *CreateMergeRequest
 		}
 	}
 
+	// Copy labels/milestone/assignees from a related issue and mark it to close on merge
+	if opts.RelatedIssue != "" {
+		if err := a.applyRelatedIssueToMergeRequest(projectID, opts, createOpts); err != nil {
+			a.logger.Error("Failed to resolve related issue", "error", err, "related_issue", opts.RelatedIssue)
+			return nil, fmt.Errorf("failed to resolve related issue '%s': %w", opts.RelatedIssue, err)
+		}
+	}
+
 	// Set remove source branch option (default to true in issue spec)
 	createOpts.RemoveSourceBranch = &opts.RemoveSourceBranch
 
@@ -939,7 +1420,94 @@ func (a *App) buildMergeRequestOptions(projectID int64, opts *CreateMergeRequest
 	return createOpts, nil
 }
 
-// resolveUserIdentifiers converts username strings or IDs to user IDs.
+// groupIdentifierPrefix marks a string identifier as a group full path whose members should all
+// be resolved, e.g. "group:my-group/my-subgroup".
+const groupIdentifierPrefix = "group:"
+
+// currentUserIdentifier resolves to the authenticated user's own ID via getCurrentUserIDCached.
+const currentUserIdentifier = "@me"
+
+// parseIssueReference splits an issue reference like "#123" or "group/project#123" into an
+// optional project path (empty for the merge request's own project) and the issue IID.
+func parseIssueReference(ref string) (projectPath string, issueIID int64, err error) {
+	hashIdx := strings.LastIndex(ref, "#")
+	if hashIdx < 0 {
+		return "", 0, fmt.Errorf("%w: %s", ErrInvalidIssueReference, ref)
+	}
+
+	iid, convErr := strconv.ParseInt(ref[hashIdx+1:], 10, 64)
+	if convErr != nil || iid <= 0 {
+		return "", 0, fmt.Errorf("%w: %s", ErrInvalidIssueReference, ref)
+	}
+
+	return ref[:hashIdx], iid, nil
+}
+
+// applyRelatedIssueToMergeRequest resolves opts.RelatedIssue and merges its labels, milestone,
+// and assignees into createOpts per the CopyIssue* flags, then appends a "Closes <ref>" line to
+// the description so the merge request closes the issue on merge.
+func (a *App) applyRelatedIssueToMergeRequest(
+	projectID int64, opts *CreateMergeRequestOptions, createOpts *gitlab.CreateMergeRequestOptions,
+) error {
+	issueProjectPath, issueIID, err := parseIssueReference(opts.RelatedIssue)
+	if err != nil {
+		return err
+	}
+
+	issueProjectID := projectID
+	if issueProjectPath != "" {
+		issueProject, err := a.getProjectCached(issueProjectPath)
+		if err != nil {
+			return err
+		}
+		issueProjectID = issueProject.ID
+	}
+
+	issue, err := a.getIssueCached(issueProjectID, int(issueIID))
+	if err != nil {
+		return err
+	}
+
+	if opts.CopyIssueLabels && len(issue.Labels) > 0 {
+		var base []string
+		if createOpts.Labels != nil {
+			base = []string(*createOpts.Labels)
+		}
+		merged := gitlab.LabelOptions(unionStrings(base, issue.Labels))
+		createOpts.Labels = &merged
+	}
+
+	if opts.CopyIssueMilestone && issue.Milestone != nil && createOpts.MilestoneID == nil {
+		createOpts.MilestoneID = &issue.Milestone.ID
+	}
+
+	if opts.CopyIssueAssignees && len(issue.Assignees) > 0 {
+		var base []int64
+		if createOpts.AssigneeIDs != nil {
+			base = *createOpts.AssigneeIDs
+		}
+		extra := make([]int64, 0, len(issue.Assignees))
+		for _, assignee := range issue.Assignees {
+			extra = append(extra, assignee.ID)
+		}
+		merged := unionInt64s(base, extra)
+		createOpts.AssigneeIDs = &merged
+	}
+
+	closesLine := "Closes " + opts.RelatedIssue
+	if createOpts.Description != nil && *createOpts.Description != "" {
+		description := *createOpts.Description + "\n\n" + closesLine
+		createOpts.Description = &description
+	} else {
+		createOpts.Description = &closesLine
+	}
+
+	return nil
+}
+
+// resolveUserIdentifiers converts username strings or IDs to user IDs. A string identifier may
+// also be "@me", resolving to the authenticated user, or "group:<full-path>", which expands to
+// the ID of every member of that group.
 func (a *App) resolveUserIdentifiers(identifiers []any) ([]int64, error) {
 	if len(identifiers) == 0 {
 		return nil, nil
@@ -956,12 +1524,11 @@ func (a *App) resolveUserIdentifiers(identifiers []any) ([]int64, error) {
 			// It's already an ID
 			userIDs = append(userIDs, int64(v))
 		case string:
-			// It's a username, need to resolve
-			userID, err := a.findUserByUsername(v)
+			ids, err := a.resolveUserIdentifierString(v)
 			if err != nil {
-				return nil, fmt.Errorf("failed to resolve user '%s': %w", v, err)
+				return nil, err
 			}
-			userIDs = append(userIDs, userID)
+			userIDs = append(userIDs, ids...)
 		default:
 			return nil, fmt.Errorf("%w: %T", ErrInvalidUserIdentifierType, identifier)
 		}
@@ -970,8 +1537,47 @@ func (a *App) resolveUserIdentifiers(identifiers []any) ([]int64, error) {
 	return userIDs, nil
 }
 
-// findUserByUsername searches for a user by username and returns their ID.
+// resolveUserIdentifierString resolves a single string user identifier: "@me", a
+// "group:<full-path>" member expansion, or a plain username.
+func (a *App) resolveUserIdentifierString(identifier string) ([]int64, error) {
+	if identifier == currentUserIdentifier {
+		userID, err := a.getCurrentUserIDCached()
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve '%s': %w", currentUserIdentifier, err)
+		}
+		return []int64{userID}, nil
+	}
+
+	if groupPath, ok := strings.CutPrefix(identifier, groupIdentifierPrefix); ok {
+		ids, err := a.getGroupMemberIDsCached(groupPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve group members for '%s': %w", groupPath, err)
+		}
+		return ids, nil
+	}
+
+	userID, err := a.findUserByUsername(identifier)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve user '%s': %w", identifier, err)
+	}
+	return []int64{userID}, nil
+}
+
+// findUserByUsername searches for a user by username and returns their ID, serving from the
+// resolver cache when available so that repeated lookups of the same assignee across a batch
+// flow don't each issue a ListUsers round-trip.
 func (a *App) findUserByUsername(username string) (int64, error) {
+	key := resolverCacheKey{kind: resolverKindUser, name: username}
+	if cached, ok := a.resolvers.get(key); ok {
+		if cached.err != nil {
+			return 0, cached.err
+		}
+		if id, ok := cached.value.(int64); ok {
+			a.logger.Debug("Resolver cache hit", "kind", "user", "username", username)
+			return id, nil
+		}
+	}
+
 	a.logger.Debug("Searching for user by username", "username", username)
 
 	// Search for the user
@@ -980,18 +1586,24 @@ func (a *App) findUserByUsername(username string) (int64, error) {
 		ListOptions: gitlab.ListOptions{PerPage: 1, Page: 1},
 	}
 
-	users, _, err := a.client.Users().ListUsers(listOpts)
+	users, _, err := retryCall(a, context.Background(), "ListUsers",
+		func() ([]*gitlab.User, *gitlab.Response, error) {
+			return a.client.Users().ListUsers(listOpts)
+		})
 	if err != nil {
 		a.logger.Error("Failed to search for user", "error", err, "username", username)
-		return 0, fmt.Errorf("failed to search for user: %w", err)
+		return 0, gitlaberr.Classify(err, "failed to search for user")
 	}
 
 	if len(users) == 0 {
 		a.logger.Error("User not found", "username", username)
-		return 0, fmt.Errorf("%w: %s", ErrUserNotFound, username)
+		notFoundErr := fmt.Errorf("%w: %s", ErrUserNotFound, username)
+		a.resolvers.setFailure(key, notFoundErr)
+		return 0, notFoundErr
 	}
 
 	a.logger.Debug("Found user", "username", username, "id", users[0].ID)
+	a.resolvers.setSuccess(key, users[0].ID)
 	return users[0].ID, nil
 }
 
@@ -1012,8 +1624,21 @@ func (a *App) resolveMilestoneIdentifier(projectID int64, identifier any) (int64
 	}
 }
 
-// findMilestoneByTitle searches for a milestone by title and returns its ID.
+// findMilestoneByTitle searches for a milestone by title and returns its ID, serving from the
+// resolver cache when available so that repeated lookups of the same milestone across a batch
+// flow don't each issue a ListMilestones round-trip.
 func (a *App) findMilestoneByTitle(projectID int64, title string) (int64, error) {
+	key := resolverCacheKey{kind: resolverKindMilestone, projectID: projectID, name: title}
+	if cached, ok := a.resolvers.get(key); ok {
+		if cached.err != nil {
+			return 0, cached.err
+		}
+		if id, ok := cached.value.(int64); ok {
+			a.logger.Debug("Resolver cache hit", "kind", "milestone", "project_id", projectID, "title", title)
+			return id, nil
+		}
+	}
+
 	a.logger.Debug("Searching for milestone by title", "project_id", projectID, "title", title)
 
 	// Search for active milestones
@@ -1023,25 +1648,33 @@ func (a *App) findMilestoneByTitle(projectID int64, title string) (int64, error)
 		ListOptions: gitlab.ListOptions{PerPage: maxMilestonesPerPage, Page: 1},
 	}
 
-	milestones, _, err := a.client.Milestones().ListMilestones(projectID, listOpts)
+	milestones, _, err := retryCall(a, context.Background(), "ListMilestones",
+		func() ([]*gitlab.Milestone, *gitlab.Response, error) {
+			return a.client.Milestones().ListMilestones(projectID, listOpts)
+		})
 	if err != nil {
 		a.logger.Error("Failed to list milestones", "error", err, "project_id", projectID)
-		return 0, fmt.Errorf("failed to list milestones: %w", err)
+		return 0, gitlaberr.Classify(err, "failed to list milestones")
 	}
 
 	// Look for exact match
 	for _, milestone := range milestones {
 		if milestone.Title == title {
 			a.logger.Debug("Found milestone", "title", title, "id", milestone.ID)
+			a.resolvers.setSuccess(key, milestone.ID)
 			return milestone.ID, nil
 		}
 	}
 
 	a.logger.Error("Milestone not found", "title", title)
-	return 0, fmt.Errorf("%w: %s", ErrMilestoneNotFound, title)
+	notFoundErr := fmt.Errorf("%w: %s", ErrMilestoneNotFound, title)
+	a.resolvers.setFailure(key, notFoundErr)
+	return 0, notFoundErr
 }
 
-// validateLabels checks if the requested labels exist in the project.
+// validateLabels checks if the requested labels exist in the project, serving the project's
+// label set from the resolver cache when available so that validating labels for several
+// requests against the same project doesn't each issue a ListProjectLabels round-trip.
 func (a *App) validateLabels(projectID int64, projectPath string, requestedLabels []string) error {
 	if len(requestedLabels) == 0 {
 		return nil // No labels to validate
@@ -1049,21 +1682,29 @@ func (a *App) validateLabels(projectID int64, projectPath string, requestedLabel
 
 	a.logger.Debug("Validating labels", "project_id", projectID, "requested_labels", requestedLabels)
 
-	// Get existing labels from the project
-	existingLabels, err := a.ListProjectLabels(projectPath, &ListLabelsOptions{
-		Limit: maxLabelsPerPage,
-	})
-	if err != nil {
-		a.logger.Error("Failed to retrieve existing labels for validation", "error", err, "project_id", projectID)
-		return fmt.Errorf("failed to validate labels: %w", err)
+	labelsKey := resolverCacheKey{kind: resolverKindLabels, projectID: projectID}
+	existingLabelNames, ok := a.cachedProjectLabelNames(labelsKey)
+	if !ok {
+		// Get existing labels from the project
+		existingLabels, err := a.ListProjectLabels(projectPath, &ListLabelsOptions{
+			Limit: maxLabelsPerPage,
+		})
+		if err != nil {
+			a.logger.Error("Failed to retrieve existing labels for validation", "error", err, "project_id", projectID)
+			return fmt.Errorf("failed to validate labels: %w", err)
+		}
+
+		existingLabelNames = make([]string, 0, len(existingLabels))
+		for _, label := range existingLabels {
+			existingLabelNames = append(existingLabelNames, label.Name)
+		}
+		a.resolvers.setSuccess(labelsKey, existingLabelNames)
 	}
 
 	// Create a map of existing label names (case-insensitive)
-	existingLabelMap := make(map[string]bool)
-	existingLabelNames := make([]string, 0, len(existingLabels))
-	for _, label := range existingLabels {
-		existingLabelMap[strings.ToLower(label.Name)] = true
-		existingLabelNames = append(existingLabelNames, label.Name)
+	existingLabelMap := make(map[string]bool, len(existingLabelNames))
+	for _, name := range existingLabelNames {
+		existingLabelMap[strings.ToLower(name)] = true
 	}
 
 	// Check which requested labels don't exist
@@ -241,12 +241,58 @@ func TestApp_ListProjectIssues(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "successful list with milestone filter",
+			opts: &ListIssuesOptions{State: "opened", MilestoneTitle: "Sprint 1", Limit: 100},
+			setup: func(client *MockGitLabClient, projects *MockProjectsService, issues *MockIssuesService) {
+				client.On("Projects").Return(projects)
+				client.On("Issues").Return(issues)
+
+				projects.On("GetProject", "test/project", (*gitlab.GetProjectOptions)(nil)).Return(
+					&gitlab.Project{ID: 123}, &gitlab.Response{}, nil,
+				)
+
+				expectedMilestone := "Sprint 1"
+				expectedOpts := &gitlab.ListProjectIssuesOptions{
+					State:       gitlab.Ptr("opened"),
+					Milestone:   &expectedMilestone,
+					ListOptions: gitlab.ListOptions{PerPage: 100, Page: 1},
+				}
+
+				issues.On("ListProjectIssues", 123, expectedOpts).Return(
+					[]*gitlab.Issue{
+						{
+							ID:        3,
+							IID:       30,
+							Title:     "Sprint issue",
+							State:     "opened",
+							Assignees: []*gitlab.IssueAssignee{},
+							CreatedAt: &testTime,
+							UpdatedAt: &testTime,
+						},
+					},
+					&gitlab.Response{}, nil,
+				)
+			},
+			want: []Issue{
+				{
+					ID:        3,
+					IID:       30,
+					Title:     "Sprint issue",
+					State:     "opened",
+					Assignees: []map[string]interface{}{},
+					CreatedAt: testTime.Format("2006-01-02T15:04:05Z"),
+					UpdatedAt: testTime.Format("2006-01-02T15:04:05Z"),
+				},
+			},
+			wantErr: false,
+		},
 		{
 			name: "project not found",
 			opts: nil,
 			setup: func(client *MockGitLabClient, projects *MockProjectsService, issues *MockIssuesService) {
 				client.On("Projects").Return(projects)
-				
+
 				projects.On("GetProject", "test/project", (*gitlab.GetProjectOptions)(nil)).Return(
 					(*gitlab.Project)(nil), (*gitlab.Response)(nil), errors.New("project not found"),
 				)
@@ -307,6 +353,41 @@ func TestApp_ListProjectIssues(t *testing.T) {
 	}
 }
 
+// TestApp_ListProjectIssues_CachesRepeatedCalls asserts that a second ListProjectIssues call with
+// identical opts is served from the response cache rather than hitting the mock client again.
+func TestApp_ListProjectIssues_CachesRepeatedCalls(t *testing.T) {
+	mockClient := &MockGitLabClient{}
+	mockProjects := &MockProjectsService{}
+	mockIssues := &MockIssuesService{}
+
+	mockClient.On("Projects").Return(mockProjects)
+	mockClient.On("Issues").Return(mockIssues)
+
+	mockProjects.On("GetProject", "test/project", (*gitlab.GetProjectOptions)(nil)).Return(
+		&gitlab.Project{ID: 123}, &gitlab.Response{}, nil,
+	)
+
+	expectedOpts := &gitlab.ListProjectIssuesOptions{
+		State:       gitlab.Ptr("opened"),
+		ListOptions: gitlab.ListOptions{PerPage: 100, Page: 1},
+	}
+	mockIssues.On("ListProjectIssues", 123, expectedOpts).Return(
+		[]*gitlab.Issue{{ID: 1, IID: 1, State: "opened", Assignees: []*gitlab.IssueAssignee{}}},
+		&gitlab.Response{}, nil,
+	)
+
+	app := NewWithClient("token", "https://gitlab.com/", mockClient)
+	app.SetLogger(slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelError})))
+
+	_, err := app.ListProjectIssues("test/project", &ListIssuesOptions{State: "opened", Limit: 100})
+	assert.NoError(t, err)
+
+	_, err = app.ListProjectIssues("test/project", &ListIssuesOptions{State: "opened", Limit: 100})
+	assert.NoError(t, err)
+
+	mockIssues.AssertNumberOfCalls(t, "ListProjectIssues", 1)
+}
+
 func TestApp_CreateProjectIssue(t *testing.T) {
 	testTime := time.Now()
 	
@@ -677,7 +758,7 @@ func TestApp_UpdateProjectIssue(t *testing.T) {
 		name      string
 		issueIID  int
 		opts      *UpdateIssueOptions
-		setup     func(*MockGitLabClient, *MockProjectsService, *MockIssuesService)
+		setup     func(*MockGitLabClient, *MockProjectsService, *MockIssuesService, *MockUsersService)
 		want      *Issue
 		wantErr   bool
 	}{
@@ -689,25 +770,35 @@ func TestApp_UpdateProjectIssue(t *testing.T) {
 				Description: "Updated description",
 				State:       "closed",
 				Labels:      []string{"bug", "fixed"},
-				Assignees:   []int{1, 2},
+				Assignees:   []string{"user1", "user2"},
 			},
-			setup: func(client *MockGitLabClient, projects *MockProjectsService, issues *MockIssuesService) {
+			setup: func(client *MockGitLabClient, projects *MockProjectsService, issues *MockIssuesService, users *MockUsersService) {
 				client.On("Projects").Return(projects)
 				client.On("Issues").Return(issues)
-				
+				client.On("Users").Return(users)
+
 				projects.On("GetProject", "test/project", (*gitlab.GetProjectOptions)(nil)).Return(
 					&gitlab.Project{ID: 123}, &gitlab.Response{}, nil,
 				)
-				
+
+				users.On("ListUsers", &gitlab.ListUsersOptions{
+					Username:    gitlab.Ptr("user1"),
+					ListOptions: gitlab.ListOptions{PerPage: 1, Page: 1},
+				}).Return([]*gitlab.User{{ID: 1, Username: "user1"}}, &gitlab.Response{}, nil)
+				users.On("ListUsers", &gitlab.ListUsersOptions{
+					Username:    gitlab.Ptr("user2"),
+					ListOptions: gitlab.ListOptions{PerPage: 1, Page: 1},
+				}).Return([]*gitlab.User{{ID: 2, Username: "user2"}}, &gitlab.Response{}, nil)
+
 				expectedLabels := gitlab.LabelOptions([]string{"bug", "fixed"})
 				expectedOpts := &gitlab.UpdateIssueOptions{
 					Title:       gitlab.Ptr("Updated Title"),
 					Description: gitlab.Ptr("Updated description"),
 					StateEvent:  gitlab.Ptr("closed"),
 					Labels:      &expectedLabels,
-					AssigneeIDs: &[]int{1, 2},
+					AssigneeIDs: &[]int64{1, 2},
 				}
-				
+
 				issues.On("UpdateIssue", 123, 10, expectedOpts).Return(
 					&gitlab.Issue{
 						ID:          3,
@@ -748,7 +839,7 @@ func TestApp_UpdateProjectIssue(t *testing.T) {
 			opts: &UpdateIssueOptions{
 				Title: "Just updating title",
 			},
-			setup: func(client *MockGitLabClient, projects *MockProjectsService, issues *MockIssuesService) {
+			setup: func(client *MockGitLabClient, projects *MockProjectsService, issues *MockIssuesService, _ *MockUsersService) {
 				client.On("Projects").Return(projects)
 				client.On("Issues").Return(issues)
 				
@@ -792,7 +883,7 @@ func TestApp_UpdateProjectIssue(t *testing.T) {
 			name:     "invalid issue IID",
 			issueIID: 0,
 			opts:     &UpdateIssueOptions{Title: "Test"},
-			setup:    func(*MockGitLabClient, *MockProjectsService, *MockIssuesService) {},
+			setup:    func(*MockGitLabClient, *MockProjectsService, *MockIssuesService, *MockUsersService) {},
 			want:     nil,
 			wantErr:  true,
 		},
@@ -800,7 +891,7 @@ func TestApp_UpdateProjectIssue(t *testing.T) {
 			name:     "nil options",
 			issueIID: 1,
 			opts:     nil,
-			setup:    func(*MockGitLabClient, *MockProjectsService, *MockIssuesService) {},
+			setup:    func(*MockGitLabClient, *MockProjectsService, *MockIssuesService, *MockUsersService) {},
 			want:     nil,
 			wantErr:  true,
 		},
@@ -808,7 +899,7 @@ func TestApp_UpdateProjectIssue(t *testing.T) {
 			name:     "project not found",
 			issueIID: 1,
 			opts:     &UpdateIssueOptions{Title: "Test"},
-			setup: func(client *MockGitLabClient, projects *MockProjectsService, issues *MockIssuesService) {
+			setup: func(client *MockGitLabClient, projects *MockProjectsService, issues *MockIssuesService, _ *MockUsersService) {
 				client.On("Projects").Return(projects)
 				
 				projects.On("GetProject", "test/project", (*gitlab.GetProjectOptions)(nil)).Return(
@@ -822,7 +913,7 @@ func TestApp_UpdateProjectIssue(t *testing.T) {
 			name:     "update fails",
 			issueIID: 1,
 			opts:     &UpdateIssueOptions{Title: "Test"},
-			setup: func(client *MockGitLabClient, projects *MockProjectsService, issues *MockIssuesService) {
+			setup: func(client *MockGitLabClient, projects *MockProjectsService, issues *MockIssuesService, _ *MockUsersService) {
 				client.On("Projects").Return(projects)
 				client.On("Issues").Return(issues)
 				
@@ -848,8 +939,9 @@ func TestApp_UpdateProjectIssue(t *testing.T) {
 			mockClient := &MockGitLabClient{}
 			mockProjects := &MockProjectsService{}
 			mockIssues := &MockIssuesService{}
+			mockUsers := &MockUsersService{}
 			
-			tt.setup(mockClient, mockProjects, mockIssues)
+			tt.setup(mockClient, mockProjects, mockIssues, mockUsers)
 
 			app := NewWithClient("token", "https://gitlab.com/", mockClient)
 			app.SetLogger(slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelError})))
@@ -867,9 +959,43 @@ func TestApp_UpdateProjectIssue(t *testing.T) {
 			mockClient.AssertExpectations(t)
 			mockProjects.AssertExpectations(t)
 			mockIssues.AssertExpectations(t)
+			mockUsers.AssertExpectations(t)
 		})
 	}
 }
+
+// TestApp_UpdateIssueLabels tests that UpdateIssueLabels forwards only the Labels field to
+// UpdateProjectIssue, leaving everything else untouched.
+func TestApp_UpdateIssueLabels(t *testing.T) {
+	mockClient := &MockGitLabClient{}
+	mockProjects := &MockProjectsService{}
+	mockIssues := &MockIssuesService{}
+
+	mockClient.On("Projects").Return(mockProjects)
+	mockClient.On("Issues").Return(mockIssues)
+
+	mockProjects.On("GetProject", "test/project", (*gitlab.GetProjectOptions)(nil)).Return(
+		&gitlab.Project{ID: 123}, &gitlab.Response{}, nil,
+	)
+
+	addLabels := gitlab.LabelOptions{"urgent"}
+	expectedOpts := &gitlab.UpdateIssueOptions{AddLabels: &addLabels}
+	mockIssues.On("UpdateIssue", 123, 1, expectedOpts).Return(
+		&gitlab.Issue{ID: 1, IID: 1, Assignees: []*gitlab.IssueAssignee{}}, &gitlab.Response{}, nil,
+	)
+
+	app := NewWithClient("token", "https://gitlab.com/", mockClient)
+	app.SetLogger(slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelError})))
+
+	result, err := app.UpdateIssueLabels("test/project", 1, []string{"+urgent"})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), result.IID)
+
+	mockClient.AssertExpectations(t)
+	mockProjects.AssertExpectations(t)
+	mockIssues.AssertExpectations(t)
+}
+
 func TestApp_AddIssueNote(t *testing.T) {
 	testTime := time.Now()
 	
@@ -1019,6 +1145,68 @@ func TestApp_AddIssueNote_InvalidIssueIID(t *testing.T) {
 	assert.Nil(t, got)
 }
 
+// TestApp_GetProjectIssue tests the App.GetProjectIssue method.
+func TestApp_GetProjectIssue(t *testing.T) {
+	tests := []struct {
+		name     string
+		issueIID int64
+		setup    func(*MockGitLabClient, *MockProjectsService, *MockIssuesService)
+		wantErr  bool
+		errType  error
+	}{
+		{
+			name:     "invalid issue iid",
+			issueIID: 0,
+			setup:    func(_ *MockGitLabClient, _ *MockProjectsService, _ *MockIssuesService) {},
+			wantErr:  true,
+			errType:  ErrInvalidIssueIID,
+		},
+		{
+			name:     "success",
+			issueIID: 5,
+			setup: func(client *MockGitLabClient, projects *MockProjectsService, issues *MockIssuesService) {
+				client.On("Projects").Return(projects)
+				client.On("Issues").Return(issues)
+
+				projects.On("GetProject", "test/project", (*gitlab.GetProjectOptions)(nil)).Return(
+					&gitlab.Project{ID: 7}, &gitlab.Response{}, nil,
+				)
+
+				issues.On("GetIssue", int64(7), 5).Return(
+					&gitlab.Issue{IID: 5, Title: "Fix bug"}, &gitlab.Response{}, nil,
+				)
+			},
+			wantErr: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := new(MockGitLabClient)
+			mockProjects := new(MockProjectsService)
+			mockIssues := new(MockIssuesService)
+			tt.setup(mockClient, mockProjects, mockIssues)
+
+			app := NewWithClient("token", "https://gitlab.com/", mockClient)
+
+			got, err := app.GetProjectIssue("test/project", tt.issueIID)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				if tt.errType != nil {
+					assert.ErrorIs(t, err, tt.errType)
+				}
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, got)
+			assert.Equal(t, int64(5), got.IID)
+			assert.Equal(t, "Fix bug", got.Title)
+		})
+	}
+}
+
 func TestApp_CreateProjectMergeRequest(t *testing.T) {
 	testTime := time.Now()
 	
@@ -1476,6 +1664,67 @@ func TestApp_CreateProjectMergeRequest_WithUsernameResolution(t *testing.T) {
 	}
 }
 
+// TestApp_CreateProjectMergeRequest_RelatedIssue tests that RelatedIssue copies the issue's
+// labels, milestone, and assignees into the create options per the CopyIssue* flags, and appends
+// a "Closes #NNN" line to the description.
+func TestApp_CreateProjectMergeRequest_RelatedIssue(t *testing.T) {
+	mockClient := &MockGitLabClient{}
+	mockProjects := &MockProjectsService{}
+	mockMRs := &MockMergeRequestsService{}
+	mockIssues := &MockIssuesService{}
+
+	mockClient.On("Projects").Return(mockProjects)
+	mockClient.On("MergeRequests").Return(mockMRs)
+	mockClient.On("Issues").Return(mockIssues)
+
+	mockProjects.On("GetProject", "test/project", (*gitlab.GetProjectOptions)(nil)).Return(
+		&gitlab.Project{ID: 123}, &gitlab.Response{}, nil,
+	)
+
+	mockIssues.On("GetIssue", int64(123), 42).Return(
+		&gitlab.Issue{
+			IID:       42,
+			Labels:    gitlab.Labels{"bug"},
+			Milestone: &gitlab.Milestone{ID: 10},
+			Assignees: []*gitlab.IssueAssignee{{ID: 7}},
+		}, &gitlab.Response{}, nil,
+	)
+
+	expectedLabels := gitlab.LabelOptions([]string{"bug"})
+	expectedAssignees := []int64{7}
+	expectedOpts := &gitlab.CreateMergeRequestOptions{
+		Title:              gitlab.Ptr("Fix the bug"),
+		SourceBranch:       gitlab.Ptr("feature-branch"),
+		TargetBranch:       gitlab.Ptr("main"),
+		Labels:             &expectedLabels,
+		MilestoneID:        gitlab.Ptr(int64(10)),
+		AssigneeIDs:        &expectedAssignees,
+		Description:        gitlab.Ptr("Closes #42"),
+		RemoveSourceBranch: gitlab.Ptr(false),
+	}
+
+	createdMR := &gitlab.MergeRequest{BasicMergeRequest: gitlab.BasicMergeRequest{ID: 1, IID: 100}}
+	mockMRs.On("CreateMergeRequest", int64(123), expectedOpts).Return(createdMR, &gitlab.Response{}, nil)
+
+	app := NewWithClient("token", "https://gitlab.com/", mockClient)
+
+	got, err := app.CreateProjectMergeRequest("test/project", &CreateMergeRequestOptions{
+		SourceBranch:       "feature-branch",
+		TargetBranch:       "main",
+		Title:              "Fix the bug",
+		RelatedIssue:       "#42",
+		CopyIssueLabels:    true,
+		CopyIssueMilestone: true,
+		CopyIssueAssignees: true,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(100), got.IID)
+	mockClient.AssertExpectations(t)
+	mockIssues.AssertExpectations(t)
+	mockMRs.AssertExpectations(t)
+}
+
 func TestApp_GetProjectDescription(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -1827,23 +2076,24 @@ func TestApp_UpdateProjectTopics(t *testing.T) {
 			wantErr: false,
 		},
 		{
-			name:        "clear all topics",
+			name:        "empty diff leaves existing topics unchanged",
 			projectPath: "test/project",
 			topics:      []string{},
 			setup: func(client *MockGitLabClient, projects *MockProjectsService) {
 				client.On("Projects").Return(projects).Times(2)
-				
+
 				// First call to get project ID
 				projects.On("GetProject", "test/project", (*gitlab.GetProjectOptions)(nil)).Return(
 					&gitlab.Project{
-						ID:   123,
-						Name: "Test Project",
-						Path: "project",
+						ID:     123,
+						Name:   "Test Project",
+						Path:   "project",
+						Topics: []string{"golang", "api"},
 					}, &gitlab.Response{}, nil,
 				)
-				
-				// Second call to update project with empty topics
-				expectedTopics := []string{}
+
+				// Second call to update project, re-sending the unchanged topics
+				expectedTopics := []string{"golang", "api"}
 				expectedOpts := &gitlab.EditProjectOptions{
 					Topics: &expectedTopics,
 				}
@@ -1853,7 +2103,7 @@ func TestApp_UpdateProjectTopics(t *testing.T) {
 						Name:        "Test Project",
 						Path:        "project",
 						Description: "Test description",
-						Topics:      []string{},
+						Topics:      []string{"golang", "api"},
 					}, &gitlab.Response{}, nil,
 				)
 			},
@@ -1862,7 +2112,124 @@ func TestApp_UpdateProjectTopics(t *testing.T) {
 				Name:        "Test Project",
 				Path:        "project",
 				Description: "Test description",
-				Topics:      []string{},
+				Topics:      []string{"golang", "api"},
+			},
+			wantErr: false,
+		},
+		{
+			name:        "add and remove merge into the existing topics",
+			projectPath: "test/project",
+			topics:      []string{"+api", "-legacy"},
+			setup: func(client *MockGitLabClient, projects *MockProjectsService) {
+				client.On("Projects").Return(projects).Times(2)
+
+				projects.On("GetProject", "test/project", (*gitlab.GetProjectOptions)(nil)).Return(
+					&gitlab.Project{
+						ID:     123,
+						Name:   "Test Project",
+						Path:   "project",
+						Topics: []string{"go", "cli", "legacy"},
+					}, &gitlab.Response{}, nil,
+				)
+
+				expectedTopics := []string{"go", "cli", "api"}
+				expectedOpts := &gitlab.EditProjectOptions{
+					Topics: &expectedTopics,
+				}
+				projects.On("EditProject", 123, expectedOpts).Return(
+					&gitlab.Project{
+						ID:          123,
+						Name:        "Test Project",
+						Path:        "project",
+						Description: "Test description",
+						Topics:      []string{"go", "cli", "api"},
+					}, &gitlab.Response{}, nil,
+				)
+			},
+			want: &ProjectInfo{
+				ID:          123,
+				Name:        "Test Project",
+				Path:        "project",
+				Description: "Test description",
+				Topics:      []string{"go", "cli", "api"},
+			},
+			wantErr: false,
+		},
+		{
+			name:        "remove-only diff preserves the other existing topics",
+			projectPath: "test/project",
+			topics:      []string{"-legacy"},
+			setup: func(client *MockGitLabClient, projects *MockProjectsService) {
+				client.On("Projects").Return(projects).Times(2)
+
+				projects.On("GetProject", "test/project", (*gitlab.GetProjectOptions)(nil)).Return(
+					&gitlab.Project{
+						ID:     123,
+						Name:   "Test Project",
+						Path:   "project",
+						Topics: []string{"go", "cli", "legacy"},
+					}, &gitlab.Response{}, nil,
+				)
+
+				expectedTopics := []string{"go", "cli"}
+				expectedOpts := &gitlab.EditProjectOptions{
+					Topics: &expectedTopics,
+				}
+				projects.On("EditProject", 123, expectedOpts).Return(
+					&gitlab.Project{
+						ID:          123,
+						Name:        "Test Project",
+						Path:        "project",
+						Description: "Test description",
+						Topics:      []string{"go", "cli"},
+					}, &gitlab.Response{}, nil,
+				)
+			},
+			want: &ProjectInfo{
+				ID:          123,
+				Name:        "Test Project",
+				Path:        "project",
+				Description: "Test description",
+				Topics:      []string{"go", "cli"},
+			},
+			wantErr: false,
+		},
+		{
+			name:        "a bare replace token wins over a mixed add token",
+			projectPath: "test/project",
+			topics:      []string{"prod", "+extra"},
+			setup: func(client *MockGitLabClient, projects *MockProjectsService) {
+				client.On("Projects").Return(projects).Times(2)
+
+				projects.On("GetProject", "test/project", (*gitlab.GetProjectOptions)(nil)).Return(
+					&gitlab.Project{
+						ID:     123,
+						Name:   "Test Project",
+						Path:   "project",
+						Topics: []string{"go", "cli"},
+					}, &gitlab.Response{}, nil,
+				)
+
+				expectedTopics := []string{"prod"}
+				expectedOpts := &gitlab.EditProjectOptions{
+					Topics: &expectedTopics,
+				}
+				projects.On("EditProject", 123, expectedOpts).Return(
+					&gitlab.Project{
+						ID:          123,
+						Name:        "Test Project",
+						Path:        "project",
+						Description: "Test description",
+						Topics:      []string{"prod"},
+					}, &gitlab.Response{}, nil,
+				)
+			},
+			want: &ProjectInfo{
+				ID:          123,
+				Name:        "Test Project",
+				Path:        "project",
+				Description: "Test description",
+				Topics:      []string{"prod"},
 			},
 			wantErr: false,
 		},
@@ -1923,7 +2290,7 @@ func TestApp_UpdateProjectTopics(t *testing.T) {
 			app.SetLogger(slog.New(slog.NewTextHandler(os.Stderr, nil)))
 			
 			got, err := app.UpdateProjectTopics(tc.projectPath, tc.topics)
-			
+
 			if tc.wantErr {
 				require.Error(t, err)
 				if tc.errMsg != "" {
@@ -1931,12 +2298,55 @@ func TestApp_UpdateProjectTopics(t *testing.T) {
 				}
 				return
 			}
-			
+
 			require.NoError(t, err)
 			assert.Equal(t, tc.want, got)
-			
+
 			mockClient.AssertExpectations(t)
 			mockProjects.AssertExpectations(t)
 		})
 	}
 }
+
+// TestApp_ResolveUserIdentifiers_CurrentUser tests that "@me" resolves to the authenticated
+// user's own ID, via a single CurrentUser call regardless of how many times it's referenced.
+func TestApp_ResolveUserIdentifiers_CurrentUser(t *testing.T) {
+	mockClient := &MockGitLabClient{}
+	mockUsers := &MockUsersService{}
+
+	mockClient.On("Users").Return(mockUsers)
+	mockUsers.On("CurrentUser").Return(&gitlab.User{ID: 42}, &gitlab.Response{}, nil).Once()
+
+	app := NewWithClient("token", "https://gitlab.com/", mockClient)
+	app.SetLogger(slog.New(slog.NewTextHandler(os.Stderr, nil)))
+
+	got, err := app.resolveUserIdentifiers([]any{"@me", "@me"})
+
+	require.NoError(t, err)
+	assert.Equal(t, []int64{42, 42}, got)
+	mockUsers.AssertExpectations(t)
+}
+
+// TestApp_ResolveUserIdentifiers_GroupMembers tests that "group:<full-path>" expands to the IDs
+// of every member of that group.
+func TestApp_ResolveUserIdentifiers_GroupMembers(t *testing.T) {
+	mockClient := &MockGitLabClient{}
+	mockGroups := &MockGroupsService{}
+
+	mockClient.On("Groups").Return(mockGroups)
+	mockGroups.On("GetGroup", "my-group", (*gitlab.GetGroupOptions)(nil)).Return(
+		&gitlab.Group{ID: 9}, &gitlab.Response{}, nil,
+	)
+	mockGroups.On("ListGroupMembers", int64(9), (*gitlab.ListGroupMembersOptions)(nil)).Return(
+		[]*gitlab.GroupMember{{ID: 1}, {ID: 2}}, &gitlab.Response{}, nil,
+	)
+
+	app := NewWithClient("token", "https://gitlab.com/", mockClient)
+	app.SetLogger(slog.New(slog.NewTextHandler(os.Stderr, nil)))
+
+	got, err := app.resolveUserIdentifiers([]any{"group:my-group"})
+
+	require.NoError(t, err)
+	assert.Equal(t, []int64{1, 2}, got)
+	mockGroups.AssertExpectations(t)
+}
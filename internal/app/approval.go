@@ -0,0 +1,335 @@
+package app
+
+import (
+	"context"
+	"errors"
+
+	"github.com/sgaunet/gitlab-mcp/internal/gitlaberr"
+	"gitlab.com/gitlab-org/api/client-go"
+)
+
+// ErrApprovalActionsDisabled is returned by the merge request approval write endpoints (approve,
+// unapprove, change approval configuration, approval rule CRUD) when the App's
+// AllowApprovalActions flag is off, which is the default. Set GITLAB_ALLOW_APPROVAL_ACTIONS=true
+// (or call SetAllowApprovalActions) to let the assistant cast approvals and edit approval rules.
+var ErrApprovalActionsDisabled = errors.New("approval actions are disabled; set GITLAB_ALLOW_APPROVAL_ACTIONS=true to enable")
+
+// ErrApprovalRuleIDRequired is returned when an approval rule operation is requested without a
+// valid rule ID.
+var ErrApprovalRuleIDRequired = errors.New("approval rule id must be a positive integer")
+
+// ErrApprovalRuleNameRequired is returned when creating an approval rule without a name.
+var ErrApprovalRuleNameRequired = errors.New("approval rule name is required")
+
+// MergeRequestApprovalStatus reports who has approved a merge request and how many approvals its
+// rules require in total, as of the moment it was fetched. It does not list the users whose
+// approval is still outstanding.
+type MergeRequestApprovalStatus struct {
+	ApprovalsRequired int64    `json:"approvals_required"`
+	Approved          bool     `json:"approved"`
+	ApprovedBy        []string `json:"approved_by"`
+}
+
+// ApprovalRule represents a project-level merge request approval rule.
+type ApprovalRule struct {
+	ID                int64    `json:"id"`
+	Name              string   `json:"name"`
+	ApprovalsRequired int64    `json:"approvals_required"`
+	Eligible          []string `json:"eligible_approvers"`
+}
+
+// ChangeApprovalConfigurationOptions contains options for changing a merge request's approval
+// configuration. ApprovalsRequired is an optional pointer so that it is only sent to GitLab
+// when the caller set it, leaving the existing configuration unchanged otherwise.
+type ChangeApprovalConfigurationOptions struct {
+	ApprovalsRequired *int64
+}
+
+// ApprovalRuleOptions contains options for creating or updating a project-level approval rule.
+type ApprovalRuleOptions struct {
+	Name              string
+	ApprovalsRequired int64
+	UserIDs           []int64
+	GroupIDs          []int64
+}
+
+// convertApprovalState converts a GitLab merge request approval state into our
+// MergeRequestApprovalStatus struct, aggregating across every rule on the merge request.
+func convertApprovalState(state *gitlab.MergeRequestApprovalState) MergeRequestApprovalStatus {
+	result := MergeRequestApprovalStatus{Approved: true}
+
+	for _, rule := range state.Rules {
+		result.ApprovalsRequired += int64(rule.ApprovalsRequired)
+		if !rule.Approved {
+			result.Approved = false
+		}
+		for _, approver := range rule.ApprovedBy {
+			result.ApprovedBy = append(result.ApprovedBy, approver.Username)
+		}
+	}
+
+	return result
+}
+
+// convertApprovalRule converts a GitLab project approval rule into our ApprovalRule struct.
+func convertApprovalRule(rule *gitlab.ProjectApprovalRule) ApprovalRule {
+	result := ApprovalRule{
+		ID:                int64(rule.ID),
+		Name:              rule.Name,
+		ApprovalsRequired: int64(rule.ApprovalsRequired),
+	}
+
+	for _, user := range rule.Users {
+		result.Eligible = append(result.Eligible, user.Username)
+	}
+
+	return result
+}
+
+// checkApprovalActionsAllowed returns ErrApprovalActionsDisabled unless the App's
+// AllowApprovalActions flag has been explicitly enabled.
+func (a *App) checkApprovalActionsAllowed() error {
+	if !a.AllowApprovalActions {
+		return ErrApprovalActionsDisabled
+	}
+	return nil
+}
+
+// GetMergeRequestApprovals reports a merge request's approval state: whether every rule is
+// satisfied, the summed approvals required, and the usernames that have approved.
+func (a *App) GetMergeRequestApprovals(projectPath string, mrIID int64) (*MergeRequestApprovalStatus, error) {
+	if mrIID <= 0 {
+		return nil, ErrInvalidMergeRequestIID
+	}
+
+	a.logger.Debug("Getting merge request approval status", "project_path", projectPath, "mr_iid", mrIID)
+
+	project, err := a.getProjectCached(projectPath)
+	if err != nil {
+		a.logger.Error("Failed to get project", "error", err, "project_path", projectPath)
+		return nil, err
+	}
+
+	state, _, err := retryCall(a, context.Background(), "GetApprovalState",
+		func() (*gitlab.MergeRequestApprovalState, *gitlab.Response, error) {
+			return a.client.MergeRequestApprovals().GetApprovalState(project.ID, mrIID)
+		})
+	if err != nil {
+		a.logger.Error("Failed to get merge request approval status", "error", err, "project_id", project.ID, "mr_iid", mrIID)
+		return nil, gitlaberr.Classify(err, "failed to get merge request approval status")
+	}
+
+	result := convertApprovalState(state)
+	a.logger.Info("Successfully retrieved merge request approval status", "mr_iid", mrIID, "approved", result.Approved)
+	return &result, nil
+}
+
+// UnapproveProjectMergeRequest withdraws the authenticated user's approval of a merge request.
+func (a *App) UnapproveProjectMergeRequest(projectPath string, mrIID int64) error {
+	if err := a.checkApprovalActionsAllowed(); err != nil {
+		return err
+	}
+	if mrIID <= 0 {
+		return ErrInvalidMergeRequestIID
+	}
+
+	a.logger.Debug("Unapproving merge request", "project_path", projectPath, "mr_iid", mrIID)
+
+	project, err := a.getProjectCached(projectPath)
+	if err != nil {
+		a.logger.Error("Failed to get project", "error", err, "project_path", projectPath)
+		return err
+	}
+
+	if _, err := a.client.MergeRequestApprovals().UnapproveMergeRequest(project.ID, mrIID); err != nil {
+		a.logger.Error("Failed to unapprove merge request", "error", err, "project_id", project.ID, "mr_iid", mrIID)
+		return gitlaberr.Classify(err, "failed to unapprove merge request")
+	}
+
+	a.logger.Info("Successfully unapproved merge request", "mr_iid", mrIID, "project_id", project.ID)
+	return nil
+}
+
+// ChangeMergeRequestApprovalConfiguration adjusts a merge request's approvals-required count.
+func (a *App) ChangeMergeRequestApprovalConfiguration(
+	projectPath string, mrIID int64, opts *ChangeApprovalConfigurationOptions,
+) (*MergeRequestApprovalStatus, error) {
+	if err := a.checkApprovalActionsAllowed(); err != nil {
+		return nil, err
+	}
+	if mrIID <= 0 {
+		return nil, ErrInvalidMergeRequestIID
+	}
+	if opts == nil {
+		opts = &ChangeApprovalConfigurationOptions{}
+	}
+
+	a.logger.Debug("Changing merge request approval configuration", "project_path", projectPath, "mr_iid", mrIID)
+
+	project, err := a.getProjectCached(projectPath)
+	if err != nil {
+		a.logger.Error("Failed to get project", "error", err, "project_path", projectPath)
+		return nil, err
+	}
+
+	changeOpts := &gitlab.ChangeMergeRequestApprovalConfigurationOptions{
+		ApprovalsRequired: opts.ApprovalsRequired,
+	}
+
+	approvals, _, err := retryCall(a, context.Background(), "ChangeApprovalConfiguration",
+		func() (*gitlab.MergeRequestApprovals, *gitlab.Response, error) {
+			return a.client.MergeRequestApprovals().ChangeApprovalConfiguration(project.ID, mrIID, changeOpts)
+		})
+	if err != nil {
+		a.logger.Error("Failed to change merge request approval configuration",
+			"error", err, "project_id", project.ID, "mr_iid", mrIID)
+		return nil, gitlaberr.Classify(err, "failed to change merge request approval configuration")
+	}
+
+	a.logger.Info("Successfully changed merge request approval configuration", "mr_iid", mrIID, "project_id", project.ID)
+	return &MergeRequestApprovalStatus{ApprovalsRequired: approvals.ApprovalsRequired}, nil
+}
+
+// ListProjectApprovalRules lists the project-level merge request approval rules.
+func (a *App) ListProjectApprovalRules(projectPath string) ([]ApprovalRule, error) {
+	a.logger.Debug("Listing project approval rules", "project_path", projectPath)
+
+	project, err := a.getProjectCached(projectPath)
+	if err != nil {
+		a.logger.Error("Failed to get project", "error", err, "project_path", projectPath)
+		return nil, err
+	}
+
+	rules, _, err := retryCall(a, context.Background(), "GetProjectApprovalRules",
+		func() ([]*gitlab.ProjectApprovalRule, *gitlab.Response, error) {
+			return a.client.MergeRequestApprovals().GetProjectApprovalRules(project.ID, nil)
+		})
+	if err != nil {
+		a.logger.Error("Failed to list project approval rules", "error", err, "project_id", project.ID)
+		return nil, gitlaberr.Classify(err, "failed to list project approval rules")
+	}
+
+	result := make([]ApprovalRule, 0, len(rules))
+	for _, rule := range rules {
+		result = append(result, convertApprovalRule(rule))
+	}
+
+	a.logger.Info("Successfully listed project approval rules", "count", len(result), "project_id", project.ID)
+	return result, nil
+}
+
+// CreateProjectApprovalRule creates a new project-level merge request approval rule.
+func (a *App) CreateProjectApprovalRule(projectPath string, opts *ApprovalRuleOptions) (*ApprovalRule, error) {
+	if err := a.checkApprovalActionsAllowed(); err != nil {
+		return nil, err
+	}
+	if opts == nil || opts.Name == "" {
+		return nil, ErrApprovalRuleNameRequired
+	}
+
+	a.logger.Debug("Creating project approval rule", "project_path", projectPath, "name", opts.Name)
+
+	project, err := a.getProjectCached(projectPath)
+	if err != nil {
+		a.logger.Error("Failed to get project", "error", err, "project_path", projectPath)
+		return nil, err
+	}
+
+	createOpts := &gitlab.CreateProjectLevelRuleOptions{
+		Name:              &opts.Name,
+		ApprovalsRequired: &opts.ApprovalsRequired,
+	}
+	if len(opts.UserIDs) > 0 {
+		createOpts.UserIDs = &opts.UserIDs
+	}
+	if len(opts.GroupIDs) > 0 {
+		createOpts.GroupIDs = &opts.GroupIDs
+	}
+
+	rule, _, err := retryCall(a, context.Background(), "CreateProjectApprovalRule",
+		func() (*gitlab.ProjectApprovalRule, *gitlab.Response, error) {
+			return a.client.MergeRequestApprovals().CreateProjectApprovalRule(project.ID, createOpts)
+		})
+	if err != nil {
+		a.logger.Error("Failed to create project approval rule", "error", err, "project_id", project.ID, "name", opts.Name)
+		return nil, gitlaberr.Classify(err, "failed to create project approval rule")
+	}
+
+	result := convertApprovalRule(rule)
+	a.logger.Info("Successfully created project approval rule", "id", result.ID, "project_id", project.ID)
+	return &result, nil
+}
+
+// UpdateProjectApprovalRule updates an existing project-level merge request approval rule.
+func (a *App) UpdateProjectApprovalRule(projectPath string, ruleID int64, opts *ApprovalRuleOptions) (*ApprovalRule, error) {
+	if err := a.checkApprovalActionsAllowed(); err != nil {
+		return nil, err
+	}
+	if ruleID <= 0 {
+		return nil, ErrApprovalRuleIDRequired
+	}
+	if opts == nil {
+		opts = &ApprovalRuleOptions{}
+	}
+
+	a.logger.Debug("Updating project approval rule", "project_path", projectPath, "rule_id", ruleID)
+
+	project, err := a.getProjectCached(projectPath)
+	if err != nil {
+		a.logger.Error("Failed to get project", "error", err, "project_path", projectPath)
+		return nil, err
+	}
+
+	updateOpts := &gitlab.UpdateProjectLevelRuleOptions{}
+	if opts.Name != "" {
+		updateOpts.Name = &opts.Name
+	}
+	if opts.ApprovalsRequired != 0 {
+		updateOpts.ApprovalsRequired = &opts.ApprovalsRequired
+	}
+	if len(opts.UserIDs) > 0 {
+		updateOpts.UserIDs = &opts.UserIDs
+	}
+	if len(opts.GroupIDs) > 0 {
+		updateOpts.GroupIDs = &opts.GroupIDs
+	}
+
+	rule, _, err := retryCall(a, context.Background(), "UpdateProjectApprovalRule",
+		func() (*gitlab.ProjectApprovalRule, *gitlab.Response, error) {
+			return a.client.MergeRequestApprovals().UpdateProjectApprovalRule(project.ID, int(ruleID), updateOpts)
+		})
+	if err != nil {
+		a.logger.Error("Failed to update project approval rule", "error", err, "project_id", project.ID, "rule_id", ruleID)
+		return nil, gitlaberr.Classify(err, "failed to update project approval rule")
+	}
+
+	result := convertApprovalRule(rule)
+	a.logger.Info("Successfully updated project approval rule", "id", result.ID, "project_id", project.ID)
+	return &result, nil
+}
+
+// DeleteProjectApprovalRule deletes a project-level merge request approval rule.
+func (a *App) DeleteProjectApprovalRule(projectPath string, ruleID int64) error {
+	if err := a.checkApprovalActionsAllowed(); err != nil {
+		return err
+	}
+	if ruleID <= 0 {
+		return ErrApprovalRuleIDRequired
+	}
+
+	a.logger.Debug("Deleting project approval rule", "project_path", projectPath, "rule_id", ruleID)
+
+	project, err := a.getProjectCached(projectPath)
+	if err != nil {
+		a.logger.Error("Failed to get project", "error", err, "project_path", projectPath)
+		return err
+	}
+
+	if _, err := a.client.MergeRequestApprovals().DeleteProjectApprovalRule(project.ID, int(ruleID)); err != nil {
+		a.logger.Error("Failed to delete project approval rule", "error", err, "project_id", project.ID, "rule_id", ruleID)
+		return gitlaberr.Classify(err, "failed to delete project approval rule")
+	}
+
+	a.logger.Info("Successfully deleted project approval rule", "rule_id", ruleID, "project_id", project.ID)
+	return nil
+}
@@ -0,0 +1,210 @@
+package app
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gitlab.com/gitlab-org/api/client-go"
+)
+
+// TestApp_GetMergeRequestApprovals tests the App.GetMergeRequestApprovals method.
+func TestApp_GetMergeRequestApprovals(t *testing.T) {
+	tests := []struct {
+		name    string
+		mrIID   int64
+		setup   func(*MockGitLabClient, *MockProjectsService, *MockMergeRequestApprovalsService)
+		want    *MergeRequestApprovalStatus
+		wantErr bool
+		errMsg  string
+	}{
+		{
+			name:  "happy path",
+			mrIID: 5,
+			setup: func(client *MockGitLabClient, projects *MockProjectsService, approvals *MockMergeRequestApprovalsService) {
+				client.On("Projects").Return(projects)
+				client.On("MergeRequestApprovals").Return(approvals)
+				projects.On("GetProject", "test/project", (*gitlab.GetProjectOptions)(nil)).Return(
+					&gitlab.Project{ID: 7}, &gitlab.Response{}, nil,
+				)
+				approvals.On("GetApprovalState", int64(7), int64(5)).Return(
+					&gitlab.MergeRequestApprovalState{
+						Rules: []*gitlab.MergeRequestApprovalRule{
+							{
+								ApprovalsRequired: 2,
+								Approved:          false,
+								ApprovedBy:        []*gitlab.BasicUser{{Username: "alice"}},
+							},
+						},
+					}, &gitlab.Response{}, nil,
+				)
+			},
+			want: &MergeRequestApprovalStatus{
+				ApprovalsRequired: 2,
+				Approved:          false,
+				ApprovedBy:        []string{"alice"},
+			},
+		},
+		{
+			name:    "invalid IID",
+			mrIID:   0,
+			setup:   func(*MockGitLabClient, *MockProjectsService, *MockMergeRequestApprovalsService) {},
+			wantErr: true,
+			errMsg:  "merge request",
+		},
+		{
+			name:  "project not found",
+			mrIID: 5,
+			setup: func(client *MockGitLabClient, projects *MockProjectsService, _ *MockMergeRequestApprovalsService) {
+				client.On("Projects").Return(projects)
+				projects.On("GetProject", "test/project", (*gitlab.GetProjectOptions)(nil)).Return(
+					(*gitlab.Project)(nil), (*gitlab.Response)(nil), errors.New("404 Project Not Found"),
+				)
+			},
+			wantErr: true,
+		},
+		{
+			name:  "API error",
+			mrIID: 5,
+			setup: func(client *MockGitLabClient, projects *MockProjectsService, approvals *MockMergeRequestApprovalsService) {
+				client.On("Projects").Return(projects)
+				client.On("MergeRequestApprovals").Return(approvals)
+				projects.On("GetProject", "test/project", (*gitlab.GetProjectOptions)(nil)).Return(
+					&gitlab.Project{ID: 7}, &gitlab.Response{}, nil,
+				)
+				approvals.On("GetApprovalState", int64(7), int64(5)).Return(
+					(*gitlab.MergeRequestApprovalState)(nil), (*gitlab.Response)(nil), errors.New("boom"),
+				)
+			},
+			wantErr: true,
+			errMsg:  "failed to get merge request approval status",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mockClient := new(MockGitLabClient)
+			mockProjects := new(MockProjectsService)
+			mockApprovals := new(MockMergeRequestApprovalsService)
+
+			tc.setup(mockClient, mockProjects, mockApprovals)
+
+			a := newTestAppForMergeRequest(mockClient)
+
+			got, err := a.GetMergeRequestApprovals("test/project", tc.mrIID)
+
+			if tc.wantErr {
+				require.Error(t, err)
+				if tc.errMsg != "" {
+					assert.Contains(t, err.Error(), tc.errMsg)
+				}
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+			mockClient.AssertExpectations(t)
+			mockProjects.AssertExpectations(t)
+			mockApprovals.AssertExpectations(t)
+		})
+	}
+}
+
+// TestApp_UnapproveProjectMergeRequest tests the App.UnapproveProjectMergeRequest method.
+func TestApp_UnapproveProjectMergeRequest(t *testing.T) {
+	tests := []struct {
+		name    string
+		mrIID   int64
+		allow   bool
+		setup   func(*MockGitLabClient, *MockProjectsService, *MockMergeRequestApprovalsService)
+		wantErr bool
+		errMsg  string
+	}{
+		{
+			name:  "happy path",
+			mrIID: 5,
+			allow: true,
+			setup: func(client *MockGitLabClient, projects *MockProjectsService, approvals *MockMergeRequestApprovalsService) {
+				client.On("Projects").Return(projects)
+				client.On("MergeRequestApprovals").Return(approvals)
+				projects.On("GetProject", "test/project", (*gitlab.GetProjectOptions)(nil)).Return(
+					&gitlab.Project{ID: 7}, &gitlab.Response{}, nil,
+				)
+				approvals.On("UnapproveMergeRequest", int64(7), int64(5)).Return(&gitlab.Response{}, nil)
+			},
+		},
+		{
+			name:    "approval actions disabled",
+			mrIID:   5,
+			allow:   false,
+			setup:   func(*MockGitLabClient, *MockProjectsService, *MockMergeRequestApprovalsService) {},
+			wantErr: true,
+			errMsg:  "approval actions are disabled",
+		},
+		{
+			name:    "invalid IID",
+			mrIID:   0,
+			allow:   true,
+			setup:   func(*MockGitLabClient, *MockProjectsService, *MockMergeRequestApprovalsService) {},
+			wantErr: true,
+		},
+		{
+			name:  "project not found",
+			mrIID: 5,
+			allow: true,
+			setup: func(client *MockGitLabClient, projects *MockProjectsService, _ *MockMergeRequestApprovalsService) {
+				client.On("Projects").Return(projects)
+				projects.On("GetProject", "test/project", (*gitlab.GetProjectOptions)(nil)).Return(
+					(*gitlab.Project)(nil), (*gitlab.Response)(nil), errors.New("404 Project Not Found"),
+				)
+			},
+			wantErr: true,
+		},
+		{
+			name:  "API error",
+			mrIID: 5,
+			allow: true,
+			setup: func(client *MockGitLabClient, projects *MockProjectsService, approvals *MockMergeRequestApprovalsService) {
+				client.On("Projects").Return(projects)
+				client.On("MergeRequestApprovals").Return(approvals)
+				projects.On("GetProject", "test/project", (*gitlab.GetProjectOptions)(nil)).Return(
+					&gitlab.Project{ID: 7}, &gitlab.Response{}, nil,
+				)
+				approvals.On("UnapproveMergeRequest", int64(7), int64(5)).Return(
+					(*gitlab.Response)(nil), errors.New("boom"),
+				)
+			},
+			wantErr: true,
+			errMsg:  "failed to unapprove merge request",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mockClient := new(MockGitLabClient)
+			mockProjects := new(MockProjectsService)
+			mockApprovals := new(MockMergeRequestApprovalsService)
+
+			tc.setup(mockClient, mockProjects, mockApprovals)
+
+			a := newTestAppForMergeRequest(mockClient)
+			a.SetAllowApprovalActions(tc.allow)
+
+			err := a.UnapproveProjectMergeRequest("test/project", tc.mrIID)
+
+			if tc.wantErr {
+				require.Error(t, err)
+				if tc.errMsg != "" {
+					assert.Contains(t, err.Error(), tc.errMsg)
+				}
+				return
+			}
+
+			require.NoError(t, err)
+			mockClient.AssertExpectations(t)
+			mockProjects.AssertExpectations(t)
+			mockApprovals.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,250 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/sgaunet/gitlab-mcp/internal/gitlaberr"
+	"gitlab.com/gitlab-org/api/client-go"
+)
+
+// defaultCacheTTL is the TTL applied to cached GitLab API lookups when none is specified.
+const defaultCacheTTL = 30 * time.Second
+
+// issuesListCacheTTL and labelsListCacheTTL are the per-endpoint TTLs applied to cached
+// ListProjectIssues and ListProjectLabels results respectively. Issues churn far more often than
+// labels within a project, so they get a shorter TTL.
+const (
+	issuesListCacheTTL = 15 * time.Second
+	labelsListCacheTTL = 2 * time.Minute
+)
+
+// processLifetimeCacheTTL is used for values that never change for the life of the credential
+// behind a's client, such as the authenticated user's own ID. It is long enough to effectively
+// never expire within a single MCP server run.
+const processLifetimeCacheTTL = 24 * time.Hour
+
+// currentUserCacheKey is the cache key under which the authenticated user's ID is stored.
+const currentUserCacheKey = "current_user_id"
+
+// CacheEntry is a single cached value together with any ETag captured from the GitLab response.
+// The ETag is carried for observability and for a future conditional (If-None-Match) reissue;
+// today's GitLabClient interfaces don't thread custom request headers through to the API calls
+// this package wraps, so a stored ETag is not yet replayed on the next request - only the TTL is
+// enforced.
+type CacheEntry struct {
+	Value any
+	ETag  string
+}
+
+// Cache is a pluggable key/value store used to avoid redundant GitLab API round-trips for
+// lookups that repeat within a short window, such as resolving the same group or project path
+// across several MCP tool calls in one session.
+type Cache interface {
+	Get(key string) (CacheEntry, bool)
+	Set(key string, entry CacheEntry, ttl time.Duration)
+	Delete(key string)
+}
+
+// etagFromResponse extracts the ETag header from a GitLab API response, if present.
+func etagFromResponse(resp *gitlab.Response) string {
+	if resp == nil || resp.Response == nil {
+		return ""
+	}
+	return resp.Header.Get("ETag")
+}
+
+// getGroupCached resolves a group by path, serving from cache when available.
+func (a *App) getGroupCached(groupPath string) (*gitlab.Group, error) {
+	key := "group:" + groupPath
+
+	if cached, ok := a.cache.Get(key); ok {
+		if group, ok := cached.Value.(*gitlab.Group); ok {
+			a.logger.Debug("Cache hit", "key", key)
+			return group, nil
+		}
+	}
+	a.logger.Debug("Cache miss", "key", key)
+
+	group, resp, err := retryCall(a, context.Background(), "GetGroup", func() (*gitlab.Group, *gitlab.Response, error) {
+		return a.client.Groups().GetGroup(groupPath, nil)
+	})
+	if err != nil {
+		return nil, gitlaberr.Classify(err, "failed to get group")
+	}
+
+	a.cache.Set(key, CacheEntry{Value: group, ETag: etagFromResponse(resp)}, defaultCacheTTL)
+	return group, nil
+}
+
+// getProjectCached resolves a project by path, serving from cache when available.
+func (a *App) getProjectCached(projectPath string) (*gitlab.Project, error) {
+	key := "project:" + projectPath
+
+	if cached, ok := a.cache.Get(key); ok {
+		if project, ok := cached.Value.(*gitlab.Project); ok {
+			a.logger.Debug("Cache hit", "key", key)
+			return project, nil
+		}
+	}
+	a.logger.Debug("Cache miss", "key", key)
+
+	project, resp, err := retryCall(a, context.Background(), "GetProject", func() (*gitlab.Project, *gitlab.Response, error) {
+		return a.client.Projects().GetProject(projectPath, nil)
+	})
+	if err != nil {
+		return nil, gitlaberr.Classify(err, "failed to get project")
+	}
+
+	a.cache.Set(key, CacheEntry{Value: project, ETag: etagFromResponse(resp)}, defaultCacheTTL)
+	return project, nil
+}
+
+// getGroupEpicsCached lists a group's epics for the given listOpts, serving from cache when
+// available. The cache key incorporates every field of listOpts that affects the result set.
+func (a *App) getGroupEpicsCached(groupID int64, listOpts *gitlab.ListGroupEpicsOptions) ([]*gitlab.Epic, error) {
+	state := ""
+	if listOpts.State != nil {
+		state = *listOpts.State
+	}
+	key := fmt.Sprintf("group_epics:%d:%s:%d", groupID, state, listOpts.PerPage)
+
+	if cached, ok := a.cache.Get(key); ok {
+		if epics, ok := cached.Value.([]*gitlab.Epic); ok {
+			a.logger.Debug("Cache hit", "key", key)
+			return epics, nil
+		}
+	}
+	a.logger.Debug("Cache miss", "key", key)
+
+	epics, resp, err := retryCall(a, context.Background(), "ListGroupEpics", func() ([]*gitlab.Epic, *gitlab.Response, error) {
+		return a.client.Epics().ListGroupEpics(groupID, listOpts)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	a.cache.Set(key, CacheEntry{Value: epics, ETag: etagFromResponse(resp)}, defaultCacheTTL)
+	return epics, nil
+}
+
+// getIssueCached resolves a single issue by project ID and IID, serving from cache when available.
+func (a *App) getIssueCached(projectID interface{}, issueIID int) (*gitlab.Issue, error) {
+	key := fmt.Sprintf("issue:%v:%d", projectID, issueIID)
+
+	if cached, ok := a.cache.Get(key); ok {
+		if issue, ok := cached.Value.(*gitlab.Issue); ok {
+			a.logger.Debug("Cache hit", "key", key)
+			return issue, nil
+		}
+	}
+	a.logger.Debug("Cache miss", "key", key)
+
+	issue, resp, err := retryCall(a, context.Background(), "GetIssue", func() (*gitlab.Issue, *gitlab.Response, error) {
+		return a.client.Issues().GetIssue(projectID, issueIID)
+	})
+	if err != nil {
+		return nil, gitlaberr.Classify(err, "failed to get issue")
+	}
+
+	a.cache.Set(key, CacheEntry{Value: issue, ETag: etagFromResponse(resp)}, defaultCacheTTL)
+	return issue, nil
+}
+
+// getCurrentUserIDCached resolves the authenticated user's own ID via the GitLab "current user"
+// endpoint, caching the result for the life of the process so repeated "@me" references don't
+// issue a new API call each time.
+func (a *App) getCurrentUserIDCached() (int64, error) {
+	if cached, ok := a.cache.Get(currentUserCacheKey); ok {
+		if id, ok := cached.Value.(int64); ok {
+			a.logger.Debug("Cache hit", "key", currentUserCacheKey)
+			return id, nil
+		}
+	}
+	a.logger.Debug("Cache miss", "key", currentUserCacheKey)
+
+	user, _, err := retryCall(a, context.Background(), "GetCurrentUser", func() (*gitlab.User, *gitlab.Response, error) {
+		return a.client.Users().CurrentUser()
+	})
+	if err != nil {
+		return 0, gitlaberr.Classify(err, "failed to get current user")
+	}
+
+	a.cache.Set(currentUserCacheKey, CacheEntry{Value: user.ID}, processLifetimeCacheTTL)
+	return user.ID, nil
+}
+
+// getGroupMemberIDsCached lists the user IDs of a group's members, serving from cache when
+// available.
+func (a *App) getGroupMemberIDsCached(groupPath string) ([]int64, error) {
+	key := "group_members:" + groupPath
+
+	if cached, ok := a.cache.Get(key); ok {
+		if ids, ok := cached.Value.([]int64); ok {
+			a.logger.Debug("Cache hit", "key", key)
+			return ids, nil
+		}
+	}
+	a.logger.Debug("Cache miss", "key", key)
+
+	group, err := a.getGroupCached(groupPath)
+	if err != nil {
+		return nil, err
+	}
+
+	members, _, err := retryCall(a, context.Background(), "ListGroupMembers",
+		func() ([]*gitlab.GroupMember, *gitlab.Response, error) {
+			return a.client.Groups().ListGroupMembers(group.ID, nil)
+		})
+	if err != nil {
+		return nil, gitlaberr.Classify(err, "failed to list group members")
+	}
+
+	ids := make([]int64, 0, len(members))
+	for _, member := range members {
+		ids = append(ids, member.ID)
+	}
+
+	a.cache.Set(key, CacheEntry{Value: ids}, defaultCacheTTL)
+	return ids, nil
+}
+
+// pagedListCacheEntry bundles a cached page-walk result - the items plus the pagination metadata
+// a caller's options struct is normally populated with - so a cache hit can stand in for the
+// live call without losing Truncated/NextPage/TotalItems.
+type pagedListCacheEntry[T any] struct {
+	Items      []T
+	Truncated  bool
+	NextPage   int64
+	TotalItems int64
+}
+
+// issuesListCacheKey builds a deterministic cache key for a ListProjectIssues call from every
+// option that affects the result set.
+func issuesListCacheKey(projectID int64, opts *ListIssuesOptions, listOpts *gitlab.ListProjectIssuesOptions) string {
+	labels := ""
+	if listOpts.Labels != nil {
+		labels = strings.Join(*listOpts.Labels, ",")
+	}
+	iterationID := int64(0)
+	if listOpts.IterationID != nil {
+		iterationID = *listOpts.IterationID
+	}
+	milestone := ""
+	if listOpts.Milestone != nil {
+		milestone = *listOpts.Milestone
+	}
+	return fmt.Sprintf("list_issues:%d:%s:%s:%d:%s:%d:%d:%d:%t:%d:%t",
+		projectID, opts.State, labels, iterationID, milestone,
+		listOpts.Page, listOpts.PerPage, opts.Limit, opts.IncludeGroupIssues, opts.MaxItems, opts.ListAll)
+}
+
+// labelsListCacheKey builds a deterministic cache key for a ListProjectLabels call from every
+// option that affects the result set.
+func labelsListCacheKey(projectID int64, opts *ListLabelsOptions, listOpts *gitlab.ListLabelsOptions) string {
+	return fmt.Sprintf("list_labels:%d:%t:%t:%s:%d:%d:%d:%d:%t",
+		projectID, opts.WithCounts, opts.IncludeAncestorGroups, opts.Search,
+		listOpts.Page, listOpts.PerPage, opts.Limit, opts.MaxItems, opts.ListAll)
+}
@@ -1,8 +1,9 @@
 package app
 
 import (
+	"bytes"
 	"fmt"
-	
+
 	"gitlab.com/gitlab-org/api/client-go"
 )
 
@@ -30,7 +31,7 @@ func (g *GitLabClientWrapper) Issues() IssuesService {
 
 // Labels returns the Labels service.
 func (g *GitLabClientWrapper) Labels() LabelsService {
-	return &LabelsServiceWrapper{service: g.client.Labels}
+	return &LabelsServiceWrapper{service: g.client.Labels, groupService: g.client.GroupLabels}
 }
 
 // Users returns the Users service.
@@ -43,16 +44,86 @@ func (g *GitLabClientWrapper) Notes() NotesService {
 	return &NotesServiceWrapper{service: g.client.Notes}
 }
 
+// Discussions returns the Discussions service.
+func (g *GitLabClientWrapper) Discussions() DiscussionsService {
+	return &DiscussionsServiceWrapper{service: g.client.Discussions}
+}
+
+// TimeStats returns the TimeStats service.
+func (g *GitLabClientWrapper) TimeStats() TimeStatsService {
+	return &TimeStatsServiceWrapper{issues: g.client.Issues, mergeRequests: g.client.MergeRequests}
+}
+
 // MergeRequests returns the MergeRequests service.
 func (g *GitLabClientWrapper) MergeRequests() MergeRequestsService {
 	return &MergeRequestsServiceWrapper{service: g.client.MergeRequests}
 }
 
+// MergeRequestApprovals returns the MergeRequestApprovals service.
+func (g *GitLabClientWrapper) MergeRequestApprovals() MergeRequestApprovalsService {
+	return &MergeRequestApprovalsServiceWrapper{service: g.client.MergeRequestApprovals}
+}
+
 // Milestones returns the Milestones service.
 func (g *GitLabClientWrapper) Milestones() MilestonesService {
 	return &MilestonesServiceWrapper{service: g.client.Milestones}
 }
 
+// Groups returns the Groups service.
+func (g *GitLabClientWrapper) Groups() GroupsService {
+	return &GroupsServiceWrapper{service: g.client.Groups, membersService: g.client.GroupMembers}
+}
+
+// Repositories returns the Repositories service.
+func (g *GitLabClientWrapper) Repositories() RepositoriesService {
+	return &RepositoriesServiceWrapper{service: g.client.Repositories}
+}
+
+// Namespaces returns the Namespaces service.
+func (g *GitLabClientWrapper) Namespaces() NamespacesService {
+	return &NamespacesServiceWrapper{service: g.client.Namespaces}
+}
+
+// Epics returns the Epics service.
+func (g *GitLabClientWrapper) Epics() EpicsService {
+	return &EpicsServiceWrapper{service: g.client.Epics}
+}
+
+// EpicIssues returns the EpicIssues service.
+func (g *GitLabClientWrapper) EpicIssues() EpicIssuesService {
+	return &EpicIssuesServiceWrapper{service: g.client.EpicIssues}
+}
+
+// EpicBoards returns the EpicBoards service.
+func (g *GitLabClientWrapper) EpicBoards() EpicBoardsService {
+	return &EpicBoardsServiceWrapper{service: g.client.GroupEpicBoards}
+}
+
+// Iterations returns the Iterations service.
+func (g *GitLabClientWrapper) Iterations() IterationsService {
+	return &IterationsServiceWrapper{service: g.client.GroupIterations}
+}
+
+// Pipelines returns the Pipelines service.
+func (g *GitLabClientWrapper) Pipelines() PipelinesService {
+	return &PipelinesServiceWrapper{service: g.client.Pipelines}
+}
+
+// Jobs returns the Jobs service.
+func (g *GitLabClientWrapper) Jobs() JobsService {
+	return &JobsServiceWrapper{service: g.client.Jobs}
+}
+
+// ProjectHooks returns the ProjectHooks service.
+func (g *GitLabClientWrapper) ProjectHooks() ProjectHooksService {
+	return &ProjectHooksServiceWrapper{service: g.client.Projects}
+}
+
+// Releases returns the Releases service.
+func (g *GitLabClientWrapper) Releases() ReleasesService {
+	return &ReleasesServiceWrapper{service: g.client.Releases}
+}
+
 // ProjectsServiceWrapper wraps the real Projects service.
 type ProjectsServiceWrapper struct {
 	service gitlab.ProjectsServiceInterface
@@ -108,8 +179,8 @@ func (i *IssuesServiceWrapper) CreateIssue(
 }
 
 func (i *IssuesServiceWrapper) UpdateIssue(
-	pid interface{}, 
-	issue int, 
+	pid interface{},
+	issue int,
 	opt *gitlab.UpdateIssueOptions,
 ) (*gitlab.Issue, *gitlab.Response, error) {
 	updatedIssue, resp, err := i.service.UpdateIssue(pid, issue, opt)
@@ -119,13 +190,92 @@ func (i *IssuesServiceWrapper) UpdateIssue(
 	return updatedIssue, resp, nil
 }
 
+func (i *IssuesServiceWrapper) GetIssue(
+	pid interface{},
+	issue int,
+) (*gitlab.Issue, *gitlab.Response, error) {
+	gotIssue, resp, err := i.service.GetIssue(pid, issue)
+	if err != nil {
+		return nil, nil, fmt.Errorf("gitlab client: %w", err)
+	}
+	return gotIssue, resp, nil
+}
+
+func (i *IssuesServiceWrapper) DeleteIssue(pid interface{}, issue int) (*gitlab.Response, error) {
+	resp, err := i.service.DeleteIssue(pid, issue)
+	if err != nil {
+		return nil, fmt.Errorf("gitlab client: %w", err)
+	}
+	return resp, nil
+}
+
+func (i *IssuesServiceWrapper) MoveIssue(
+	pid interface{},
+	issue int,
+	opt *gitlab.MoveIssueOptions,
+) (*gitlab.Issue, *gitlab.Response, error) {
+	movedIssue, resp, err := i.service.MoveIssue(pid, issue, opt)
+	if err != nil {
+		return nil, nil, fmt.Errorf("gitlab client: %w", err)
+	}
+	return movedIssue, resp, nil
+}
+
+func (i *IssuesServiceWrapper) SubscribeToIssue(pid interface{}, issue int) (*gitlab.Issue, *gitlab.Response, error) {
+	subscribedIssue, resp, err := i.service.SubscribeToIssue(pid, issue)
+	if err != nil {
+		return nil, nil, fmt.Errorf("gitlab client: %w", err)
+	}
+	return subscribedIssue, resp, nil
+}
+
+func (i *IssuesServiceWrapper) UnsubscribeFromIssue(
+	pid interface{},
+	issue int,
+) (*gitlab.Issue, *gitlab.Response, error) {
+	unsubscribedIssue, resp, err := i.service.UnsubscribeFromIssue(pid, issue)
+	if err != nil {
+		return nil, nil, fmt.Errorf("gitlab client: %w", err)
+	}
+	return unsubscribedIssue, resp, nil
+}
+
+func (i *IssuesServiceWrapper) CreateTodo(pid interface{}, issue int) (*gitlab.Todo, *gitlab.Response, error) {
+	todo, resp, err := i.service.CreateTodo(pid, issue)
+	if err != nil {
+		return nil, nil, fmt.Errorf("gitlab client: %w", err)
+	}
+	return todo, resp, nil
+}
+
+func (i *IssuesServiceWrapper) ListGroupIssues(
+	gid interface{},
+	opt *gitlab.ListGroupIssuesOptions,
+	options ...gitlab.RequestOptionFunc,
+) ([]*gitlab.Issue, *gitlab.Response, error) {
+	issues, resp, err := i.service.ListGroupIssues(gid, opt, options...)
+	if err != nil {
+		return nil, nil, fmt.Errorf("gitlab client: %w", err)
+	}
+	return issues, resp, nil
+}
+
+func (i *IssuesServiceWrapper) ListIssues(opt *gitlab.ListIssuesOptions) ([]*gitlab.Issue, *gitlab.Response, error) {
+	issues, resp, err := i.service.ListIssues(opt)
+	if err != nil {
+		return nil, nil, fmt.Errorf("gitlab client: %w", err)
+	}
+	return issues, resp, nil
+}
+
 // LabelsServiceWrapper wraps the real Labels service.
 type LabelsServiceWrapper struct {
-	service gitlab.LabelsServiceInterface
+	service      gitlab.LabelsServiceInterface
+	groupService gitlab.GroupLabelsServiceInterface
 }
 
 func (l *LabelsServiceWrapper) ListLabels(
-	pid interface{}, 
+	pid interface{},
 	opt *gitlab.ListLabelsOptions,
 ) ([]*gitlab.Label, *gitlab.Response, error) {
 	labels, resp, err := l.service.ListLabels(pid, opt)
@@ -135,6 +285,17 @@ func (l *LabelsServiceWrapper) ListLabels(
 	return labels, resp, nil
 }
 
+func (l *LabelsServiceWrapper) ListGroupLabels(
+	gid interface{},
+	opt *gitlab.ListGroupLabelsOptions,
+) ([]*gitlab.Label, *gitlab.Response, error) {
+	labels, resp, err := l.groupService.ListGroupLabels(gid, opt)
+	if err != nil {
+		return nil, nil, fmt.Errorf("gitlab client: %w", err)
+	}
+	return labels, resp, nil
+}
+
 // UsersServiceWrapper wraps the real Users service.
 type UsersServiceWrapper struct {
 	service gitlab.UsersServiceInterface
@@ -162,8 +323,8 @@ type NotesServiceWrapper struct {
 }
 
 func (n *NotesServiceWrapper) CreateIssueNote(
-	pid interface{}, 
-	issue int, 
+	pid interface{},
+	issue int,
 	opt *gitlab.CreateIssueNoteOptions,
 ) (*gitlab.Note, *gitlab.Response, error) {
 	note, resp, err := n.service.CreateIssueNote(pid, issue, opt)
@@ -173,13 +334,291 @@ func (n *NotesServiceWrapper) CreateIssueNote(
 	return note, resp, nil
 }
 
+func (n *NotesServiceWrapper) ListIssueNotes(
+	pid interface{},
+	issue int,
+	opt *gitlab.ListIssueNotesOptions,
+) ([]*gitlab.Note, *gitlab.Response, error) {
+	notes, resp, err := n.service.ListIssueNotes(pid, issue, opt)
+	if err != nil {
+		return nil, nil, fmt.Errorf("gitlab client: %w", err)
+	}
+	return notes, resp, nil
+}
+
+func (n *NotesServiceWrapper) GetIssueNote(pid interface{}, issue int, note int) (*gitlab.Note, *gitlab.Response, error) {
+	gotNote, resp, err := n.service.GetIssueNote(pid, issue, note)
+	if err != nil {
+		return nil, nil, fmt.Errorf("gitlab client: %w", err)
+	}
+	return gotNote, resp, nil
+}
+
+func (n *NotesServiceWrapper) UpdateIssueNote(
+	pid interface{},
+	issue int,
+	note int,
+	opt *gitlab.UpdateIssueNoteOptions,
+) (*gitlab.Note, *gitlab.Response, error) {
+	updatedNote, resp, err := n.service.UpdateIssueNote(pid, issue, note, opt)
+	if err != nil {
+		return nil, nil, fmt.Errorf("gitlab client: %w", err)
+	}
+	return updatedNote, resp, nil
+}
+
+func (n *NotesServiceWrapper) DeleteIssueNote(pid interface{}, issue int, note int) (*gitlab.Response, error) {
+	resp, err := n.service.DeleteIssueNote(pid, issue, note)
+	if err != nil {
+		return nil, fmt.Errorf("gitlab client: %w", err)
+	}
+	return resp, nil
+}
+
+// DiscussionsServiceWrapper wraps the real Discussions service.
+type DiscussionsServiceWrapper struct {
+	service gitlab.DiscussionsServiceInterface
+}
+
+func (d *DiscussionsServiceWrapper) ListMergeRequestDiscussions(
+	pid interface{},
+	mergeRequest int64,
+	opt *gitlab.ListMergeRequestDiscussionsOptions,
+) ([]*gitlab.Discussion, *gitlab.Response, error) {
+	discussions, resp, err := d.service.ListMergeRequestDiscussions(pid, mergeRequest, opt)
+	if err != nil {
+		return nil, nil, fmt.Errorf("gitlab client: %w", err)
+	}
+	return discussions, resp, nil
+}
+
+func (d *DiscussionsServiceWrapper) GetMergeRequestDiscussion(
+	pid interface{},
+	mergeRequest int64,
+	discussion string,
+) (*gitlab.Discussion, *gitlab.Response, error) {
+	disc, resp, err := d.service.GetMergeRequestDiscussion(pid, mergeRequest, discussion)
+	if err != nil {
+		return nil, nil, fmt.Errorf("gitlab client: %w", err)
+	}
+	return disc, resp, nil
+}
+
+func (d *DiscussionsServiceWrapper) CreateMergeRequestDiscussion(
+	pid interface{},
+	mergeRequest int64,
+	opt *gitlab.CreateMergeRequestDiscussionOptions,
+) (*gitlab.Discussion, *gitlab.Response, error) {
+	disc, resp, err := d.service.CreateMergeRequestDiscussion(pid, mergeRequest, opt)
+	if err != nil {
+		return nil, nil, fmt.Errorf("gitlab client: %w", err)
+	}
+	return disc, resp, nil
+}
+
+func (d *DiscussionsServiceWrapper) AddMergeRequestDiscussionNote(
+	pid interface{},
+	mergeRequest int64,
+	discussion string,
+	opt *gitlab.AddMergeRequestDiscussionNoteOptions,
+) (*gitlab.Note, *gitlab.Response, error) {
+	note, resp, err := d.service.AddMergeRequestDiscussionNote(pid, mergeRequest, discussion, opt)
+	if err != nil {
+		return nil, nil, fmt.Errorf("gitlab client: %w", err)
+	}
+	return note, resp, nil
+}
+
+func (d *DiscussionsServiceWrapper) ResolveMergeRequestDiscussion(
+	pid interface{},
+	mergeRequest int64,
+	discussion string,
+	opt *gitlab.ResolveMergeRequestDiscussionOptions,
+) (*gitlab.Discussion, *gitlab.Response, error) {
+	disc, resp, err := d.service.ResolveMergeRequestDiscussion(pid, mergeRequest, discussion, opt)
+	if err != nil {
+		return nil, nil, fmt.Errorf("gitlab client: %w", err)
+	}
+	return disc, resp, nil
+}
+
+func (d *DiscussionsServiceWrapper) ListIssueDiscussions(
+	pid interface{},
+	issue int,
+	opt *gitlab.ListIssueDiscussionsOptions,
+) ([]*gitlab.Discussion, *gitlab.Response, error) {
+	discussions, resp, err := d.service.ListIssueDiscussions(pid, issue, opt)
+	if err != nil {
+		return nil, nil, fmt.Errorf("gitlab client: %w", err)
+	}
+	return discussions, resp, nil
+}
+
+func (d *DiscussionsServiceWrapper) GetIssueDiscussion(
+	pid interface{},
+	issue int,
+	discussion string,
+) (*gitlab.Discussion, *gitlab.Response, error) {
+	disc, resp, err := d.service.GetIssueDiscussion(pid, issue, discussion)
+	if err != nil {
+		return nil, nil, fmt.Errorf("gitlab client: %w", err)
+	}
+	return disc, resp, nil
+}
+
+func (d *DiscussionsServiceWrapper) CreateIssueDiscussion(
+	pid interface{},
+	issue int,
+	opt *gitlab.CreateIssueDiscussionOptions,
+) (*gitlab.Discussion, *gitlab.Response, error) {
+	disc, resp, err := d.service.CreateIssueDiscussion(pid, issue, opt)
+	if err != nil {
+		return nil, nil, fmt.Errorf("gitlab client: %w", err)
+	}
+	return disc, resp, nil
+}
+
+func (d *DiscussionsServiceWrapper) AddIssueDiscussionNote(
+	pid interface{},
+	issue int,
+	discussion string,
+	opt *gitlab.AddIssueDiscussionNoteOptions,
+) (*gitlab.Note, *gitlab.Response, error) {
+	note, resp, err := d.service.AddIssueDiscussionNote(pid, issue, discussion, opt)
+	if err != nil {
+		return nil, nil, fmt.Errorf("gitlab client: %w", err)
+	}
+	return note, resp, nil
+}
+
+// TimeStatsServiceWrapper wraps the real Issues and MergeRequests services to provide a single
+// time-tracking surface for both resource kinds.
+type TimeStatsServiceWrapper struct {
+	issues        gitlab.IssuesServiceInterface
+	mergeRequests gitlab.MergeRequestsServiceInterface
+}
+
+func (t *TimeStatsServiceWrapper) SetIssueTimeEstimate(
+	pid interface{},
+	issue int,
+	opt *gitlab.SetTimeEstimateOptions,
+) (*gitlab.TimeStats, *gitlab.Response, error) {
+	stats, resp, err := t.issues.SetTimeEstimate(pid, issue, opt)
+	if err != nil {
+		return nil, nil, fmt.Errorf("gitlab client: %w", err)
+	}
+	return stats, resp, nil
+}
+
+func (t *TimeStatsServiceWrapper) ResetIssueTimeEstimate(
+	pid interface{},
+	issue int,
+) (*gitlab.TimeStats, *gitlab.Response, error) {
+	stats, resp, err := t.issues.ResetTimeEstimate(pid, issue)
+	if err != nil {
+		return nil, nil, fmt.Errorf("gitlab client: %w", err)
+	}
+	return stats, resp, nil
+}
+
+func (t *TimeStatsServiceWrapper) AddIssueSpentTime(
+	pid interface{},
+	issue int,
+	opt *gitlab.AddSpentTimeOptions,
+) (*gitlab.TimeStats, *gitlab.Response, error) {
+	stats, resp, err := t.issues.AddSpentTime(pid, issue, opt)
+	if err != nil {
+		return nil, nil, fmt.Errorf("gitlab client: %w", err)
+	}
+	return stats, resp, nil
+}
+
+func (t *TimeStatsServiceWrapper) ResetIssueSpentTime(
+	pid interface{},
+	issue int,
+) (*gitlab.TimeStats, *gitlab.Response, error) {
+	stats, resp, err := t.issues.ResetSpentTime(pid, issue)
+	if err != nil {
+		return nil, nil, fmt.Errorf("gitlab client: %w", err)
+	}
+	return stats, resp, nil
+}
+
+func (t *TimeStatsServiceWrapper) GetIssueTimeStats(
+	pid interface{},
+	issue int,
+) (*gitlab.TimeStats, *gitlab.Response, error) {
+	stats, resp, err := t.issues.GetTimeSpent(pid, issue)
+	if err != nil {
+		return nil, nil, fmt.Errorf("gitlab client: %w", err)
+	}
+	return stats, resp, nil
+}
+
+func (t *TimeStatsServiceWrapper) SetMergeRequestTimeEstimate(
+	pid interface{},
+	mergeRequest int64,
+	opt *gitlab.SetTimeEstimateOptions,
+) (*gitlab.TimeStats, *gitlab.Response, error) {
+	stats, resp, err := t.mergeRequests.SetTimeEstimate(pid, mergeRequest, opt)
+	if err != nil {
+		return nil, nil, fmt.Errorf("gitlab client: %w", err)
+	}
+	return stats, resp, nil
+}
+
+func (t *TimeStatsServiceWrapper) ResetMergeRequestTimeEstimate(
+	pid interface{},
+	mergeRequest int64,
+) (*gitlab.TimeStats, *gitlab.Response, error) {
+	stats, resp, err := t.mergeRequests.ResetTimeEstimate(pid, mergeRequest)
+	if err != nil {
+		return nil, nil, fmt.Errorf("gitlab client: %w", err)
+	}
+	return stats, resp, nil
+}
+
+func (t *TimeStatsServiceWrapper) AddMergeRequestSpentTime(
+	pid interface{},
+	mergeRequest int64,
+	opt *gitlab.AddSpentTimeOptions,
+) (*gitlab.TimeStats, *gitlab.Response, error) {
+	stats, resp, err := t.mergeRequests.AddSpentTime(pid, mergeRequest, opt)
+	if err != nil {
+		return nil, nil, fmt.Errorf("gitlab client: %w", err)
+	}
+	return stats, resp, nil
+}
+
+func (t *TimeStatsServiceWrapper) ResetMergeRequestSpentTime(
+	pid interface{},
+	mergeRequest int64,
+) (*gitlab.TimeStats, *gitlab.Response, error) {
+	stats, resp, err := t.mergeRequests.ResetSpentTime(pid, mergeRequest)
+	if err != nil {
+		return nil, nil, fmt.Errorf("gitlab client: %w", err)
+	}
+	return stats, resp, nil
+}
+
+func (t *TimeStatsServiceWrapper) GetMergeRequestTimeStats(
+	pid interface{},
+	mergeRequest int64,
+) (*gitlab.TimeStats, *gitlab.Response, error) {
+	stats, resp, err := t.mergeRequests.GetTimeSpent(pid, mergeRequest)
+	if err != nil {
+		return nil, nil, fmt.Errorf("gitlab client: %w", err)
+	}
+	return stats, resp, nil
+}
+
 // MergeRequestsServiceWrapper wraps the real MergeRequests service.
 type MergeRequestsServiceWrapper struct {
 	service gitlab.MergeRequestsServiceInterface
 }
 
 func (m *MergeRequestsServiceWrapper) CreateMergeRequest(
-	pid interface{}, 
+	pid interface{},
 	opt *gitlab.CreateMergeRequestOptions,
 ) (*gitlab.MergeRequest, *gitlab.Response, error) {
 	mr, resp, err := m.service.CreateMergeRequest(pid, opt)
@@ -189,13 +628,258 @@ func (m *MergeRequestsServiceWrapper) CreateMergeRequest(
 	return mr, resp, nil
 }
 
+func (m *MergeRequestsServiceWrapper) ListProjectMergeRequests(
+	pid interface{},
+	opt *gitlab.ListProjectMergeRequestsOptions,
+) ([]*gitlab.MergeRequest, *gitlab.Response, error) {
+	mrs, resp, err := m.service.ListProjectMergeRequests(pid, opt)
+	if err != nil {
+		return nil, nil, fmt.Errorf("gitlab client: %w", err)
+	}
+	return mrs, resp, nil
+}
+
+func (m *MergeRequestsServiceWrapper) ListGroupMergeRequests(
+	gid interface{},
+	opt *gitlab.ListGroupMergeRequestsOptions,
+) ([]*gitlab.MergeRequest, *gitlab.Response, error) {
+	mrs, resp, err := m.service.ListGroupMergeRequests(gid, opt)
+	if err != nil {
+		return nil, nil, fmt.Errorf("gitlab client: %w", err)
+	}
+	return mrs, resp, nil
+}
+
+func (m *MergeRequestsServiceWrapper) GetMergeRequest(
+	pid interface{},
+	mergeRequest int64,
+	opt *gitlab.GetMergeRequestsOptions,
+) (*gitlab.MergeRequest, *gitlab.Response, error) {
+	mr, resp, err := m.service.GetMergeRequest(pid, mergeRequest, opt)
+	if err != nil {
+		return nil, nil, fmt.Errorf("gitlab client: %w", err)
+	}
+	return mr, resp, nil
+}
+
+func (m *MergeRequestsServiceWrapper) GetMergeRequestChanges(
+	pid interface{},
+	mergeRequest int64,
+	opt *gitlab.GetMergeRequestChangesOptions,
+) (*gitlab.MergeRequest, *gitlab.Response, error) {
+	mr, resp, err := m.service.GetMergeRequestChanges(pid, mergeRequest, opt)
+	if err != nil {
+		return nil, nil, fmt.Errorf("gitlab client: %w", err)
+	}
+	return mr, resp, nil
+}
+
+func (m *MergeRequestsServiceWrapper) GetMergeRequestCommits(
+	pid interface{},
+	mergeRequest int64,
+	opt *gitlab.GetMergeRequestCommitsOptions,
+) ([]*gitlab.Commit, *gitlab.Response, error) {
+	commits, resp, err := m.service.GetMergeRequestCommits(pid, mergeRequest, opt)
+	if err != nil {
+		return nil, nil, fmt.Errorf("gitlab client: %w", err)
+	}
+	return commits, resp, nil
+}
+
+func (m *MergeRequestsServiceWrapper) UpdateMergeRequest(
+	pid interface{},
+	mergeRequest int64,
+	opt *gitlab.UpdateMergeRequestOptions,
+) (*gitlab.MergeRequest, *gitlab.Response, error) {
+	mr, resp, err := m.service.UpdateMergeRequest(pid, mergeRequest, opt)
+	if err != nil {
+		return nil, nil, fmt.Errorf("gitlab client: %w", err)
+	}
+	return mr, resp, nil
+}
+
+func (m *MergeRequestsServiceWrapper) AcceptMergeRequest(
+	pid interface{},
+	mergeRequest int64,
+	opt *gitlab.AcceptMergeRequestOptions,
+) (*gitlab.MergeRequest, *gitlab.Response, error) {
+	mr, resp, err := m.service.AcceptMergeRequest(pid, mergeRequest, opt)
+	if err != nil {
+		return nil, nil, fmt.Errorf("gitlab client: %w", err)
+	}
+	return mr, resp, nil
+}
+
+func (m *MergeRequestsServiceWrapper) CancelMergeWhenPipelineSucceeds(
+	pid interface{},
+	mergeRequest int64,
+) (*gitlab.MergeRequest, *gitlab.Response, error) {
+	mr, resp, err := m.service.CancelMergeWhenPipelineSucceeds(pid, mergeRequest)
+	if err != nil {
+		return nil, nil, fmt.Errorf("gitlab client: %w", err)
+	}
+	return mr, resp, nil
+}
+
+func (m *MergeRequestsServiceWrapper) ListMergeRequestPipelines(
+	pid interface{},
+	mergeRequest int64,
+) ([]*gitlab.PipelineInfo, *gitlab.Response, error) {
+	pipelines, resp, err := m.service.ListMergeRequestPipelines(pid, mergeRequest)
+	if err != nil {
+		return nil, nil, fmt.Errorf("gitlab client: %w", err)
+	}
+	return pipelines, resp, nil
+}
+
+func (m *MergeRequestsServiceWrapper) DeleteMergeRequest(
+	pid interface{},
+	mergeRequest int64,
+) (*gitlab.Response, error) {
+	resp, err := m.service.DeleteMergeRequest(pid, mergeRequest)
+	if err != nil {
+		return nil, fmt.Errorf("gitlab client: %w", err)
+	}
+	return resp, nil
+}
+
+func (m *MergeRequestsServiceWrapper) CreateMergeRequestNote(
+	pid interface{},
+	mergeRequest int64,
+	opt *gitlab.CreateMergeRequestNoteOptions,
+) (*gitlab.Note, *gitlab.Response, error) {
+	note, resp, err := m.service.CreateMergeRequestNote(pid, mergeRequest, opt)
+	if err != nil {
+		return nil, nil, fmt.Errorf("gitlab client: %w", err)
+	}
+	return note, resp, nil
+}
+
+func (m *MergeRequestsServiceWrapper) ListMergeRequestNotes(
+	pid interface{},
+	mergeRequest int64,
+	opt *gitlab.ListMergeRequestNotesOptions,
+) ([]*gitlab.Note, *gitlab.Response, error) {
+	notes, resp, err := m.service.ListMergeRequestNotes(pid, mergeRequest, opt)
+	if err != nil {
+		return nil, nil, fmt.Errorf("gitlab client: %w", err)
+	}
+	return notes, resp, nil
+}
+
+func (m *MergeRequestsServiceWrapper) RebaseMergeRequest(
+	pid interface{}, mergeRequest int64, opt *gitlab.RebaseMergeRequestOptions,
+) (*gitlab.Response, error) {
+	resp, err := m.service.RebaseMergeRequest(pid, mergeRequest, opt)
+	if err != nil {
+		return nil, fmt.Errorf("gitlab client: %w", err)
+	}
+	return resp, nil
+}
+
+// MergeRequestApprovalsServiceWrapper wraps the real MergeRequestApprovals service.
+type MergeRequestApprovalsServiceWrapper struct {
+	service gitlab.MergeRequestApprovalsServiceInterface
+}
+
+func (m *MergeRequestApprovalsServiceWrapper) ApproveMergeRequest(
+	pid interface{},
+	mergeRequest int64,
+	opt *gitlab.ApproveMergeRequestOptions,
+) (*gitlab.MergeRequestApprovals, *gitlab.Response, error) {
+	approvals, resp, err := m.service.ApproveMergeRequest(pid, mergeRequest, opt)
+	if err != nil {
+		return nil, nil, fmt.Errorf("gitlab client: %w", err)
+	}
+	return approvals, resp, nil
+}
+
+func (m *MergeRequestApprovalsServiceWrapper) UnapproveMergeRequest(
+	pid interface{},
+	mergeRequest int64,
+) (*gitlab.Response, error) {
+	resp, err := m.service.UnapproveMergeRequest(pid, mergeRequest)
+	if err != nil {
+		return nil, fmt.Errorf("gitlab client: %w", err)
+	}
+	return resp, nil
+}
+
+func (m *MergeRequestApprovalsServiceWrapper) GetApprovalState(
+	pid interface{},
+	mergeRequest int64,
+) (*gitlab.MergeRequestApprovalState, *gitlab.Response, error) {
+	state, resp, err := m.service.GetApprovalState(pid, mergeRequest)
+	if err != nil {
+		return nil, nil, fmt.Errorf("gitlab client: %w", err)
+	}
+	return state, resp, nil
+}
+
+func (m *MergeRequestApprovalsServiceWrapper) ChangeApprovalConfiguration(
+	pid interface{},
+	mergeRequest int64,
+	opt *gitlab.ChangeMergeRequestApprovalConfigurationOptions,
+) (*gitlab.MergeRequestApprovals, *gitlab.Response, error) {
+	approvals, resp, err := m.service.ChangeApprovalConfiguration(pid, mergeRequest, opt)
+	if err != nil {
+		return nil, nil, fmt.Errorf("gitlab client: %w", err)
+	}
+	return approvals, resp, nil
+}
+
+func (m *MergeRequestApprovalsServiceWrapper) GetProjectApprovalRules(
+	pid interface{},
+	opt *gitlab.GetProjectApprovalRulesListsOptions,
+) ([]*gitlab.ProjectApprovalRule, *gitlab.Response, error) {
+	rules, resp, err := m.service.GetProjectApprovalRules(pid, opt)
+	if err != nil {
+		return nil, nil, fmt.Errorf("gitlab client: %w", err)
+	}
+	return rules, resp, nil
+}
+
+func (m *MergeRequestApprovalsServiceWrapper) CreateProjectApprovalRule(
+	pid interface{},
+	opt *gitlab.CreateProjectLevelRuleOptions,
+) (*gitlab.ProjectApprovalRule, *gitlab.Response, error) {
+	rule, resp, err := m.service.CreateProjectApprovalRule(pid, opt)
+	if err != nil {
+		return nil, nil, fmt.Errorf("gitlab client: %w", err)
+	}
+	return rule, resp, nil
+}
+
+func (m *MergeRequestApprovalsServiceWrapper) UpdateProjectApprovalRule(
+	pid interface{},
+	approvalRuleID int,
+	opt *gitlab.UpdateProjectLevelRuleOptions,
+) (*gitlab.ProjectApprovalRule, *gitlab.Response, error) {
+	rule, resp, err := m.service.UpdateProjectApprovalRule(pid, approvalRuleID, opt)
+	if err != nil {
+		return nil, nil, fmt.Errorf("gitlab client: %w", err)
+	}
+	return rule, resp, nil
+}
+
+func (m *MergeRequestApprovalsServiceWrapper) DeleteProjectApprovalRule(
+	pid interface{},
+	approvalRuleID int,
+) (*gitlab.Response, error) {
+	resp, err := m.service.DeleteProjectApprovalRule(pid, approvalRuleID)
+	if err != nil {
+		return nil, fmt.Errorf("gitlab client: %w", err)
+	}
+	return resp, nil
+}
+
 // MilestonesServiceWrapper wraps the real Milestones service.
 type MilestonesServiceWrapper struct {
 	service gitlab.MilestonesServiceInterface
 }
 
 func (m *MilestonesServiceWrapper) ListMilestones(
-	pid interface{}, 
+	pid interface{},
 	opt *gitlab.ListMilestonesOptions,
 ) ([]*gitlab.Milestone, *gitlab.Response, error) {
 	milestones, resp, err := m.service.ListMilestones(pid, opt)
@@ -203,4 +887,396 @@ func (m *MilestonesServiceWrapper) ListMilestones(
 		return nil, nil, fmt.Errorf("gitlab client: %w", err)
 	}
 	return milestones, resp, nil
-}
\ No newline at end of file
+}
+
+func (m *MilestonesServiceWrapper) CreateMilestone(
+	pid interface{},
+	opt *gitlab.CreateMilestoneOptions,
+) (*gitlab.Milestone, *gitlab.Response, error) {
+	milestone, resp, err := m.service.CreateMilestone(pid, opt)
+	if err != nil {
+		return nil, nil, fmt.Errorf("gitlab client: %w", err)
+	}
+	return milestone, resp, nil
+}
+
+func (m *MilestonesServiceWrapper) UpdateMilestone(
+	pid interface{},
+	milestone int,
+	opt *gitlab.UpdateMilestoneOptions,
+) (*gitlab.Milestone, *gitlab.Response, error) {
+	result, resp, err := m.service.UpdateMilestone(pid, milestone, opt)
+	if err != nil {
+		return nil, nil, fmt.Errorf("gitlab client: %w", err)
+	}
+	return result, resp, nil
+}
+
+func (m *MilestonesServiceWrapper) DeleteMilestone(pid interface{}, milestone int) (*gitlab.Response, error) {
+	resp, err := m.service.DeleteMilestone(pid, milestone)
+	if err != nil {
+		return nil, fmt.Errorf("gitlab client: %w", err)
+	}
+	return resp, nil
+}
+
+// GroupsServiceWrapper wraps the real Groups service.
+type GroupsServiceWrapper struct {
+	service        gitlab.GroupsServiceInterface
+	membersService gitlab.GroupMembersServiceInterface
+}
+
+func (g *GroupsServiceWrapper) GetGroup(
+	gid interface{},
+	opt *gitlab.GetGroupOptions,
+) (*gitlab.Group, *gitlab.Response, error) {
+	group, resp, err := g.service.GetGroup(gid, opt)
+	if err != nil {
+		return nil, nil, fmt.Errorf("gitlab client: %w", err)
+	}
+	return group, resp, nil
+}
+
+func (g *GroupsServiceWrapper) ListGroupProjects(
+	gid interface{},
+	opt *gitlab.ListGroupProjectsOptions,
+) ([]*gitlab.Project, *gitlab.Response, error) {
+	projects, resp, err := g.service.ListGroupProjects(gid, opt)
+	if err != nil {
+		return nil, nil, fmt.Errorf("gitlab client: %w", err)
+	}
+	return projects, resp, nil
+}
+
+func (g *GroupsServiceWrapper) ListGroupMembers(
+	gid interface{},
+	opt *gitlab.ListGroupMembersOptions,
+) ([]*gitlab.GroupMember, *gitlab.Response, error) {
+	members, resp, err := g.membersService.ListGroupMembers(gid, opt)
+	if err != nil {
+		return nil, nil, fmt.Errorf("gitlab client: %w", err)
+	}
+	return members, resp, nil
+}
+
+// RepositoriesServiceWrapper wraps the real Repositories service.
+type RepositoriesServiceWrapper struct {
+	service gitlab.RepositoriesServiceInterface
+}
+
+func (r *RepositoriesServiceWrapper) Compare(
+	pid interface{},
+	opt *gitlab.CompareOptions,
+) (*gitlab.Compare, *gitlab.Response, error) {
+	compare, resp, err := r.service.Compare(pid, opt)
+	if err != nil {
+		return nil, nil, fmt.Errorf("gitlab client: %w", err)
+	}
+	return compare, resp, nil
+}
+
+// NamespacesServiceWrapper wraps the real Namespaces service.
+type NamespacesServiceWrapper struct {
+	service gitlab.NamespacesServiceInterface
+}
+
+func (n *NamespacesServiceWrapper) GetNamespace(
+	id interface{},
+	options ...gitlab.RequestOptionFunc,
+) (*gitlab.Namespace, *gitlab.Response, error) {
+	namespace, resp, err := n.service.GetNamespace(id, options...)
+	if err != nil {
+		return nil, nil, fmt.Errorf("gitlab client: %w", err)
+	}
+	return namespace, resp, nil
+}
+
+// EpicsServiceWrapper wraps the real Epics service.
+type EpicsServiceWrapper struct {
+	service gitlab.EpicsServiceInterface
+}
+
+func (e *EpicsServiceWrapper) ListGroupEpics(
+	gid interface{},
+	opt *gitlab.ListGroupEpicsOptions,
+) ([]*gitlab.Epic, *gitlab.Response, error) {
+	epics, resp, err := e.service.ListGroupEpics(gid, opt)
+	if err != nil {
+		return nil, nil, fmt.Errorf("gitlab client: %w", err)
+	}
+	return epics, resp, nil
+}
+
+func (e *EpicsServiceWrapper) GetEpic(gid interface{}, epic int64) (*gitlab.Epic, *gitlab.Response, error) {
+	gotEpic, resp, err := e.service.GetEpic(gid, epic)
+	if err != nil {
+		return nil, nil, fmt.Errorf("gitlab client: %w", err)
+	}
+	return gotEpic, resp, nil
+}
+
+func (e *EpicsServiceWrapper) CreateEpic(
+	gid interface{},
+	opt *gitlab.CreateEpicOptions,
+) (*gitlab.Epic, *gitlab.Response, error) {
+	epic, resp, err := e.service.CreateEpic(gid, opt)
+	if err != nil {
+		return nil, nil, fmt.Errorf("gitlab client: %w", err)
+	}
+	return epic, resp, nil
+}
+
+func (e *EpicsServiceWrapper) UpdateEpic(
+	gid interface{},
+	epic int64,
+	opt *gitlab.UpdateEpicOptions,
+) (*gitlab.Epic, *gitlab.Response, error) {
+	updatedEpic, resp, err := e.service.UpdateEpic(gid, epic, opt)
+	if err != nil {
+		return nil, nil, fmt.Errorf("gitlab client: %w", err)
+	}
+	return updatedEpic, resp, nil
+}
+
+func (e *EpicsServiceWrapper) DeleteEpic(gid interface{}, epic int64) (*gitlab.Response, error) {
+	resp, err := e.service.DeleteEpic(gid, epic)
+	if err != nil {
+		return nil, fmt.Errorf("gitlab client: %w", err)
+	}
+	return resp, nil
+}
+
+// EpicIssuesServiceWrapper wraps the real EpicIssues service.
+type EpicIssuesServiceWrapper struct {
+	service gitlab.EpicIssuesServiceInterface
+}
+
+func (e *EpicIssuesServiceWrapper) AssignEpicIssue(
+	gid interface{},
+	epic int64,
+	issue int64,
+) (*gitlab.EpicIssueAssignment, *gitlab.Response, error) {
+	assignment, resp, err := e.service.AssignEpicIssue(gid, epic, issue)
+	if err != nil {
+		return nil, nil, fmt.Errorf("gitlab client: %w", err)
+	}
+	return assignment, resp, nil
+}
+
+func (e *EpicIssuesServiceWrapper) ListEpicIssues(
+	gid interface{},
+	epic int64,
+) ([]*gitlab.EpicIssueAssignment, *gitlab.Response, error) {
+	assignments, resp, err := e.service.ListEpicIssues(gid, epic)
+	if err != nil {
+		return nil, nil, fmt.Errorf("gitlab client: %w", err)
+	}
+	return assignments, resp, nil
+}
+
+// EpicBoardsServiceWrapper wraps the real EpicBoards service.
+type EpicBoardsServiceWrapper struct {
+	service gitlab.GroupEpicBoardsServiceInterface
+}
+
+func (e *EpicBoardsServiceWrapper) ListGroupEpicBoards(
+	gid interface{},
+	opt *gitlab.ListGroupEpicBoardsOptions,
+) ([]*gitlab.GroupEpicBoard, *gitlab.Response, error) {
+	boards, resp, err := e.service.ListGroupEpicBoards(gid, opt)
+	if err != nil {
+		return nil, nil, fmt.Errorf("gitlab client: %w", err)
+	}
+	return boards, resp, nil
+}
+
+func (e *EpicBoardsServiceWrapper) GetGroupEpicBoard(
+	gid interface{},
+	board int64,
+) (*gitlab.GroupEpicBoard, *gitlab.Response, error) {
+	gotBoard, resp, err := e.service.GetGroupEpicBoard(gid, board)
+	if err != nil {
+		return nil, nil, fmt.Errorf("gitlab client: %w", err)
+	}
+	return gotBoard, resp, nil
+}
+
+// IterationsServiceWrapper wraps the real GroupIterations service.
+type IterationsServiceWrapper struct {
+	service gitlab.GroupIterationsServiceInterface
+}
+
+func (it *IterationsServiceWrapper) ListGroupIterations(
+	gid interface{},
+	opt *gitlab.ListGroupIterationsOptions,
+) ([]*gitlab.GroupIteration, *gitlab.Response, error) {
+	iterations, resp, err := it.service.ListGroupIterations(gid, opt)
+	if err != nil {
+		return nil, nil, fmt.Errorf("gitlab client: %w", err)
+	}
+	return iterations, resp, nil
+}
+
+// PipelinesServiceWrapper wraps the real Pipelines service.
+type PipelinesServiceWrapper struct {
+	service gitlab.PipelinesServiceInterface
+}
+
+func (p *PipelinesServiceWrapper) ListProjectPipelines(
+	pid interface{},
+	opt *gitlab.ListProjectPipelinesOptions,
+) ([]*gitlab.PipelineInfo, *gitlab.Response, error) {
+	pipelines, resp, err := p.service.ListProjectPipelines(pid, opt)
+	if err != nil {
+		return nil, nil, fmt.Errorf("gitlab client: %w", err)
+	}
+	return pipelines, resp, nil
+}
+
+func (p *PipelinesServiceWrapper) GetPipeline(pid interface{}, pipeline int64) (*gitlab.Pipeline, *gitlab.Response, error) {
+	gotPipeline, resp, err := p.service.GetPipeline(pid, pipeline)
+	if err != nil {
+		return nil, nil, fmt.Errorf("gitlab client: %w", err)
+	}
+	return gotPipeline, resp, nil
+}
+
+func (p *PipelinesServiceWrapper) CreatePipeline(
+	pid interface{},
+	opt *gitlab.CreatePipelineOptions,
+) (*gitlab.Pipeline, *gitlab.Response, error) {
+	pipeline, resp, err := p.service.CreatePipeline(pid, opt)
+	if err != nil {
+		return nil, nil, fmt.Errorf("gitlab client: %w", err)
+	}
+	return pipeline, resp, nil
+}
+
+func (p *PipelinesServiceWrapper) RetryPipelineBuild(
+	pid interface{},
+	pipeline int64,
+) (*gitlab.Pipeline, *gitlab.Response, error) {
+	retried, resp, err := p.service.RetryPipelineBuild(pid, pipeline)
+	if err != nil {
+		return nil, nil, fmt.Errorf("gitlab client: %w", err)
+	}
+	return retried, resp, nil
+}
+
+func (p *PipelinesServiceWrapper) CancelPipelineBuild(
+	pid interface{},
+	pipeline int64,
+) (*gitlab.Pipeline, *gitlab.Response, error) {
+	canceled, resp, err := p.service.CancelPipelineBuild(pid, pipeline)
+	if err != nil {
+		return nil, nil, fmt.Errorf("gitlab client: %w", err)
+	}
+	return canceled, resp, nil
+}
+
+func (p *PipelinesServiceWrapper) GetPipelineVariables(
+	pid interface{},
+	pipeline int64,
+) ([]*gitlab.PipelineVariable, *gitlab.Response, error) {
+	variables, resp, err := p.service.GetPipelineVariables(pid, pipeline)
+	if err != nil {
+		return nil, nil, fmt.Errorf("gitlab client: %w", err)
+	}
+	return variables, resp, nil
+}
+
+func (p *PipelinesServiceWrapper) GetLatestPipeline(
+	pid interface{},
+	opt *gitlab.GetLatestPipelineOptions,
+) (*gitlab.Pipeline, *gitlab.Response, error) {
+	pipeline, resp, err := p.service.GetLatestPipeline(pid, opt)
+	if err != nil {
+		return nil, nil, fmt.Errorf("gitlab client: %w", err)
+	}
+	return pipeline, resp, nil
+}
+
+// JobsServiceWrapper wraps the real Jobs service.
+type JobsServiceWrapper struct {
+	service gitlab.JobsServiceInterface
+}
+
+func (j *JobsServiceWrapper) ListPipelineJobs(
+	pid interface{},
+	pipeline int64,
+	opt *gitlab.ListJobsOptions,
+) ([]*gitlab.Job, *gitlab.Response, error) {
+	jobs, resp, err := j.service.ListPipelineJobs(pid, pipeline, opt)
+	if err != nil {
+		return nil, nil, fmt.Errorf("gitlab client: %w", err)
+	}
+	return jobs, resp, nil
+}
+
+func (j *JobsServiceWrapper) GetTraceFile(pid interface{}, job int64) (*bytes.Reader, *gitlab.Response, error) {
+	trace, resp, err := j.service.GetTraceFile(pid, job)
+	if err != nil {
+		return nil, nil, fmt.Errorf("gitlab client: %w", err)
+	}
+	return trace, resp, nil
+}
+
+func (j *JobsServiceWrapper) CancelJob(pid interface{}, job int64) (*gitlab.Job, *gitlab.Response, error) {
+	result, resp, err := j.service.CancelJob(pid, job)
+	if err != nil {
+		return nil, nil, fmt.Errorf("gitlab client: %w", err)
+	}
+	return result, resp, nil
+}
+
+func (j *JobsServiceWrapper) PlayJob(
+	pid interface{},
+	job int64,
+	opt *gitlab.PlayJobOptions,
+) (*gitlab.Job, *gitlab.Response, error) {
+	result, resp, err := j.service.PlayJob(pid, job, opt)
+	if err != nil {
+		return nil, nil, fmt.Errorf("gitlab client: %w", err)
+	}
+	return result, resp, nil
+}
+
+// ProjectHooksServiceWrapper wraps the real Projects service's webhook operations.
+type ProjectHooksServiceWrapper struct {
+	service gitlab.ProjectsServiceInterface
+}
+
+func (p *ProjectHooksServiceWrapper) AddProjectHook(
+	pid interface{},
+	opt *gitlab.AddProjectHookOptions,
+) (*gitlab.ProjectHook, *gitlab.Response, error) {
+	hook, resp, err := p.service.AddProjectHook(pid, opt)
+	if err != nil {
+		return nil, nil, fmt.Errorf("gitlab client: %w", err)
+	}
+	return hook, resp, nil
+}
+
+func (p *ProjectHooksServiceWrapper) DeleteProjectHook(pid interface{}, hook int64) (*gitlab.Response, error) {
+	resp, err := p.service.DeleteProjectHook(pid, int(hook))
+	if err != nil {
+		return nil, fmt.Errorf("gitlab client: %w", err)
+	}
+	return resp, nil
+}
+
+// ReleasesServiceWrapper wraps the real Releases service.
+type ReleasesServiceWrapper struct {
+	service gitlab.ReleasesServiceInterface
+}
+
+func (r *ReleasesServiceWrapper) ListReleases(
+	pid interface{},
+	opt *gitlab.ListReleasesOptions,
+) ([]*gitlab.Release, *gitlab.Response, error) {
+	releases, resp, err := r.service.ListReleases(pid, opt)
+	if err != nil {
+		return nil, nil, fmt.Errorf("gitlab client: %w", err)
+	}
+	return releases, resp, nil
+}
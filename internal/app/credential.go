@@ -0,0 +1,381 @@
+package app
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"golang.org/x/oauth2"
+	"gitlab.com/gitlab-org/api/client-go"
+	"gopkg.in/yaml.v3"
+)
+
+// Credential types recognized in a credentials file's "type" field.
+const (
+	credentialTypeToken     = "token"
+	credentialTypeOAuth2    = "oauth2"
+	credentialTypeJobToken  = "job_token"
+	credentialTypeAnonymous = "anonymous"
+)
+
+// Auth types recognized by the GITLAB_AUTH_TYPE environment variable, matching glab's
+// NoToken/OAuthToken/PrivateToken distinction.
+const (
+	authTypePrivate = "private"
+	authTypeOAuth   = "oauth"
+)
+
+// Error variables for the credential subsystem.
+var (
+	ErrCredentialTokenRequired = errors.New("credential token is required")
+	ErrUnknownCredentialType   = errors.New("unknown credential type")
+	ErrUnknownAuthType         = errors.New("unknown auth type")
+)
+
+// Credential knows how to produce a configured *gitlab.Client for a given GitLab host and how to
+// refresh itself when its underlying token expires. Implementations: PersonalAccessToken,
+// OAuth2Token, JobToken, Anonymous.
+type Credential interface {
+	// NewClient builds a *gitlab.Client authenticated with this credential against uri, reusing
+	// httpClient so callers keep a single instrumented transport across credentials.
+	NewClient(uri string, httpClient *http.Client) (*gitlab.Client, error)
+	// Refresh renews the credential's token, if it supports renewal. Credentials that never
+	// expire (PersonalAccessToken, JobToken, Anonymous) treat this as a no-op.
+	Refresh(ctx context.Context) error
+	// AuthHeader returns the HTTP header name and current token value this credential
+	// authenticates with, so credentialTransport can re-apply it to every request after Refresh
+	// renews the token, without reconstructing the *gitlab.Client NewClient already built.
+	AuthHeader() (name, value string)
+}
+
+// clientOptions builds the gitlab.ClientOptionFunc slice shared by every Credential
+// implementation: the instrumented HTTP client, plus a base URL override for self-hosted
+// instances.
+func clientOptions(uri string, httpClient *http.Client) []gitlab.ClientOptionFunc {
+	opts := []gitlab.ClientOptionFunc{gitlab.WithHTTPClient(httpClient)}
+	if uri != "" && uri != defaultGitLabURI {
+		opts = append(opts, gitlab.WithBaseURL(uri))
+	}
+	return opts
+}
+
+// PersonalAccessToken authenticates with a GitLab personal (or project/group) access token, sent
+// via the PRIVATE-TOKEN header. It never expires and Refresh is a no-op.
+type PersonalAccessToken struct {
+	Token string
+}
+
+// NewClient implements Credential.
+func (c *PersonalAccessToken) NewClient(uri string, httpClient *http.Client) (*gitlab.Client, error) {
+	client, err := gitlab.NewClient(c.Token, clientOptions(uri, httpClient)...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create GitLab client: %w", err)
+	}
+	return client, nil
+}
+
+// Refresh implements Credential.
+func (c *PersonalAccessToken) Refresh(_ context.Context) error {
+	return nil
+}
+
+// AuthHeader implements Credential.
+func (c *PersonalAccessToken) AuthHeader() (name, value string) {
+	return "PRIVATE-TOKEN", c.Token
+}
+
+// JobToken authenticates using the CI_JOB_TOKEN available inside GitLab CI pipeline jobs. It
+// carries a distinct, scoped permission model from personal access tokens and is sent via the
+// JOB-TOKEN header rather than PRIVATE-TOKEN.
+type JobToken struct {
+	Token string
+}
+
+// NewJobTokenFromEnv builds a JobToken from the CI_JOB_TOKEN environment variable set by the
+// GitLab CI runner inside pipeline jobs.
+func NewJobTokenFromEnv() (*JobToken, error) {
+	token := os.Getenv("CI_JOB_TOKEN")
+	if token == "" {
+		return nil, ErrCredentialTokenRequired
+	}
+	return &JobToken{Token: token}, nil
+}
+
+// NewClient implements Credential.
+func (c *JobToken) NewClient(uri string, httpClient *http.Client) (*gitlab.Client, error) {
+	client, err := gitlab.NewJobClient(c.Token, clientOptions(uri, httpClient)...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create GitLab job client: %w", err)
+	}
+	return client, nil
+}
+
+// Refresh implements Credential.
+func (c *JobToken) Refresh(_ context.Context) error {
+	return nil
+}
+
+// AuthHeader implements Credential.
+func (c *JobToken) AuthHeader() (name, value string) {
+	return "JOB-TOKEN", c.Token
+}
+
+// OAuth2Token authenticates with an OAuth2 access token and, when a refresh token and endpoint
+// are configured, transparently refreshes itself via golang.org/x/oauth2 as the access token
+// expires.
+type OAuth2Token struct {
+	source oauth2.TokenSource
+	token  *oauth2.Token
+}
+
+// NewOAuth2Token wraps token in a Credential that refreshes itself through config's token
+// endpoint once the access token expires.
+func NewOAuth2Token(ctx context.Context, token *oauth2.Token, config *oauth2.Config) *OAuth2Token {
+	return &OAuth2Token{
+		source: config.TokenSource(ctx, token),
+		token:  token,
+	}
+}
+
+// NewClient implements Credential.
+func (c *OAuth2Token) NewClient(uri string, httpClient *http.Client) (*gitlab.Client, error) {
+	client, err := gitlab.NewOAuthClient(c.token.AccessToken, clientOptions(uri, httpClient)...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create GitLab OAuth client: %w", err)
+	}
+	return client, nil
+}
+
+// Refresh implements Credential, exchanging the refresh token for a new access token via the
+// oauth2.TokenSource built in NewOAuth2Token.
+func (c *OAuth2Token) Refresh(_ context.Context) error {
+	refreshed, err := c.source.Token()
+	if err != nil {
+		return fmt.Errorf("failed to refresh OAuth2 token: %w", err)
+	}
+	c.token = refreshed
+	return nil
+}
+
+// AuthHeader implements Credential.
+func (c *OAuth2Token) AuthHeader() (name, value string) {
+	return "Authorization", "Bearer " + c.token.AccessToken
+}
+
+// Anonymous performs unauthenticated, public read-only calls. It is only suitable against public
+// projects and groups, and never refreshes.
+type Anonymous struct{}
+
+// NewClient implements Credential.
+func (c *Anonymous) NewClient(uri string, httpClient *http.Client) (*gitlab.Client, error) {
+	client, err := gitlab.NewClient("", clientOptions(uri, httpClient)...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create anonymous GitLab client: %w", err)
+	}
+	return client, nil
+}
+
+// Refresh implements Credential.
+func (c *Anonymous) Refresh(_ context.Context) error {
+	return nil
+}
+
+// AuthHeader implements Credential. Anonymous sends no auth header at all.
+func (c *Anonymous) AuthHeader() (name, value string) {
+	return "", ""
+}
+
+// ReadTokenFromStdin reads a single personal access token from r (trimmed of surrounding
+// whitespace), for interactive setups where the token should not be persisted to an env var or
+// config file.
+func ReadTokenFromStdin(r io.Reader) (*PersonalAccessToken, error) {
+	scanner := bufio.NewScanner(r)
+	if !scanner.Scan() {
+		if err := scanner.Err(); err != nil {
+			return nil, fmt.Errorf("failed to read token from stdin: %w", err)
+		}
+		return nil, ErrCredentialTokenRequired
+	}
+	token := strings.TrimSpace(scanner.Text())
+	if token == "" {
+		return nil, ErrCredentialTokenRequired
+	}
+	return &PersonalAccessToken{Token: token}, nil
+}
+
+// CredentialStore resolves the Credential to use for a given GitLab host, loaded from a
+// credentials config file and environment variables. Entries are keyed by host so a single
+// store can serve multi-host assistant setups without restarting the server.
+type CredentialStore struct {
+	byHost map[string]Credential
+}
+
+// credentialsFile is the schema of ~/.config/gitlab-mcp/credentials.yaml: a map of GitLab host
+// (e.g. "https://gitlab.com/") to that host's credential.
+type credentialsFile struct {
+	Hosts map[string]credentialFileEntry `yaml:"hosts"`
+}
+
+type credentialFileEntry struct {
+	Type         string `yaml:"type"`
+	Token        string `yaml:"token"`
+	RefreshToken string `yaml:"refresh_token"`
+	ClientID     string `yaml:"client_id"`
+	ClientSecret string `yaml:"client_secret"`
+	TokenURL     string `yaml:"token_url"`
+}
+
+func (e credentialFileEntry) toCredential() (Credential, error) {
+	switch e.Type {
+	case "", credentialTypeToken:
+		if e.Token == "" {
+			return nil, ErrCredentialTokenRequired
+		}
+		return &PersonalAccessToken{Token: e.Token}, nil
+	case credentialTypeJobToken:
+		if e.Token == "" {
+			return nil, ErrCredentialTokenRequired
+		}
+		return &JobToken{Token: e.Token}, nil
+	case credentialTypeOAuth2:
+		if e.Token == "" {
+			return nil, ErrCredentialTokenRequired
+		}
+		config := &oauth2.Config{
+			ClientID:     e.ClientID,
+			ClientSecret: e.ClientSecret,
+			Endpoint:     oauth2.Endpoint{TokenURL: e.TokenURL},
+		}
+		token := &oauth2.Token{AccessToken: e.Token, RefreshToken: e.RefreshToken}
+		return NewOAuth2Token(context.Background(), token, config), nil
+	case credentialTypeAnonymous:
+		return &Anonymous{}, nil
+	default:
+		return nil, fmt.Errorf("%w: %q", ErrUnknownCredentialType, e.Type)
+	}
+}
+
+// DefaultCredentialsFilePath returns ~/.config/gitlab-mcp/credentials.yaml, the default location
+// NewCredentialStore reads per-host credentials from.
+func DefaultCredentialsFilePath() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(home, ".config", "gitlab-mcp", "credentials.yaml")
+}
+
+// NewCredentialStore builds a CredentialStore for defaultHost from, in order of precedence,
+// the CI_JOB_TOKEN and GITLAB_TOKEN environment variables and the per-host entries in the
+// credentials file at configPath. A missing configPath is not an error. Entries in the config
+// file take a host of their own, so a store built for one defaultHost can still resolve
+// credentials for other hosts via CredentialFor.
+//
+// GITLAB_TOKEN is treated as a personal access token unless GITLAB_AUTH_TYPE is set to "oauth",
+// in which case it is sent as an OAuth2 bearer token instead, optionally auto-refreshing via
+// GITLAB_OAUTH_REFRESH_TOKEN, GITLAB_OAUTH_CLIENT_ID, GITLAB_OAUTH_CLIENT_SECRET, and
+// GITLAB_OAUTH_TOKEN_URL when the access token expires - for GitLab instances behind SSO/OIDC
+// that only issue OAuth tokens.
+func NewCredentialStore(defaultHost, configPath string) (*CredentialStore, error) {
+	store := &CredentialStore{byHost: make(map[string]Credential)}
+
+	if configPath != "" {
+		if err := store.loadConfigFile(configPath); err != nil {
+			return nil, err
+		}
+	}
+
+	if token := os.Getenv("CI_JOB_TOKEN"); token != "" {
+		store.byHost[defaultHost] = &JobToken{Token: token}
+		return store, nil
+	}
+
+	if token := os.Getenv("GITLAB_TOKEN"); token != "" {
+		cred, err := credentialFromTokenEnv(token)
+		if err != nil {
+			return nil, err
+		}
+		store.byHost[defaultHost] = cred
+	}
+
+	return store, nil
+}
+
+// credentialFromTokenEnv builds the Credential for GITLAB_TOKEN, selecting between a personal
+// access token and an OAuth2 token per GITLAB_AUTH_TYPE (default "private").
+func credentialFromTokenEnv(token string) (Credential, error) {
+	authType := os.Getenv("GITLAB_AUTH_TYPE")
+	if authType == "" {
+		authType = authTypePrivate
+	}
+
+	switch authType {
+	case authTypePrivate:
+		return &PersonalAccessToken{Token: token}, nil
+	case authTypeOAuth:
+		config := &oauth2.Config{
+			ClientID:     os.Getenv("GITLAB_OAUTH_CLIENT_ID"),
+			ClientSecret: os.Getenv("GITLAB_OAUTH_CLIENT_SECRET"),
+			Endpoint:     oauth2.Endpoint{TokenURL: os.Getenv("GITLAB_OAUTH_TOKEN_URL")},
+		}
+		oauthToken := &oauth2.Token{
+			AccessToken:  token,
+			RefreshToken: os.Getenv("GITLAB_OAUTH_REFRESH_TOKEN"),
+		}
+		return NewOAuth2Token(context.Background(), oauthToken, config), nil
+	default:
+		return nil, fmt.Errorf("%w: %q", ErrUnknownAuthType, authType)
+	}
+}
+
+func (s *CredentialStore) loadConfigFile(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to read credentials file: %w", err)
+	}
+
+	var file credentialsFile
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return fmt.Errorf("failed to parse credentials file: %w", err)
+	}
+
+	for host, entry := range file.Hosts {
+		cred, err := entry.toCredential()
+		if err != nil {
+			return fmt.Errorf("failed to load credential for host %q: %w", host, err)
+		}
+		s.byHost[host] = cred
+	}
+	return nil
+}
+
+// Lookup returns the Credential configured for host and whether one was found.
+func (s *CredentialStore) Lookup(host string) (Credential, bool) {
+	cred, ok := s.byHost[host]
+	return cred, ok
+}
+
+// CredentialFor returns the Credential configured for host, falling back to Anonymous when none
+// was configured so callers can still make public read-only calls against that host.
+func (s *CredentialStore) CredentialFor(host string) Credential {
+	if cred, ok := s.byHost[host]; ok {
+		return cred
+	}
+	return &Anonymous{}
+}
+
+// SetCredential registers cred as the active credential for host, overriding whatever env var or
+// config file entry previously resolved for it (e.g. after an interactive ReadTokenFromStdin
+// prompt).
+func (s *CredentialStore) SetCredential(host string, cred Credential) {
+	s.byHost[host] = cred
+}
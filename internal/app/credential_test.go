@@ -0,0 +1,196 @@
+package app
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestCredentialFileEntry_ToCredential tests that a credentials file entry is converted to the
+// Credential implementation matching its "type" field.
+func TestCredentialFileEntry_ToCredential(t *testing.T) {
+	tests := []struct {
+		name    string
+		entry   credentialFileEntry
+		want    any
+		wantErr error
+	}{
+		{
+			name:  "defaults to a personal access token",
+			entry: credentialFileEntry{Token: "tok"},
+			want:  &PersonalAccessToken{Token: "tok"},
+		},
+		{
+			name:  "explicit token type",
+			entry: credentialFileEntry{Type: credentialTypeToken, Token: "tok"},
+			want:  &PersonalAccessToken{Token: "tok"},
+		},
+		{
+			name:  "job token type",
+			entry: credentialFileEntry{Type: credentialTypeJobToken, Token: "ci-tok"},
+			want:  &JobToken{Token: "ci-tok"},
+		},
+		{
+			name:  "anonymous type ignores token",
+			entry: credentialFileEntry{Type: credentialTypeAnonymous},
+			want:  &Anonymous{},
+		},
+		{
+			name:    "token type without a token is rejected",
+			entry:   credentialFileEntry{Type: credentialTypeToken},
+			wantErr: ErrCredentialTokenRequired,
+		},
+		{
+			name:    "unknown type is rejected",
+			entry:   credentialFileEntry{Type: "bogus", Token: "tok"},
+			wantErr: ErrUnknownCredentialType,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.entry.toCredential()
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+// TestCredentialFileEntry_ToCredential_OAuth2 tests that an oauth2 entry produces an OAuth2Token
+// carrying the configured access token.
+func TestCredentialFileEntry_ToCredential_OAuth2(t *testing.T) {
+	entry := credentialFileEntry{
+		Type:         credentialTypeOAuth2,
+		Token:        "access-tok",
+		RefreshToken: "refresh-tok",
+		ClientID:     "client-id",
+		TokenURL:     "https://gitlab.example.com/oauth/token",
+	}
+
+	got, err := entry.toCredential()
+
+	require.NoError(t, err)
+	oauthCred, ok := got.(*OAuth2Token)
+	require.True(t, ok)
+	assert.Equal(t, "access-tok", oauthCred.token.AccessToken)
+	assert.Equal(t, "refresh-tok", oauthCred.token.RefreshToken)
+}
+
+// TestNewCredentialStore_EnvPrecedence tests that CI_JOB_TOKEN takes precedence over GITLAB_TOKEN
+// when both are set for the default host.
+func TestNewCredentialStore_EnvPrecedence(t *testing.T) {
+	t.Setenv("CI_JOB_TOKEN", "ci-tok")
+	t.Setenv("GITLAB_TOKEN", "pat-tok")
+
+	store, err := NewCredentialStore("https://gitlab.com/", "")
+
+	require.NoError(t, err)
+	cred, ok := store.Lookup("https://gitlab.com/")
+	require.True(t, ok)
+	assert.Equal(t, &JobToken{Token: "ci-tok"}, cred)
+}
+
+// TestNewCredentialStore_GitLabAuthTypeOAuth tests that GITLAB_AUTH_TYPE=oauth sends GITLAB_TOKEN
+// as an OAuth2 bearer token instead of a personal access token.
+func TestNewCredentialStore_GitLabAuthTypeOAuth(t *testing.T) {
+	t.Setenv("CI_JOB_TOKEN", "")
+	t.Setenv("GITLAB_TOKEN", "oauth-access-tok")
+	t.Setenv("GITLAB_AUTH_TYPE", "oauth")
+	t.Setenv("GITLAB_OAUTH_REFRESH_TOKEN", "oauth-refresh-tok")
+
+	store, err := NewCredentialStore("https://gitlab.com/", "")
+
+	require.NoError(t, err)
+	cred, ok := store.Lookup("https://gitlab.com/")
+	require.True(t, ok)
+	oauthCred, ok := cred.(*OAuth2Token)
+	require.True(t, ok)
+	assert.Equal(t, "oauth-access-tok", oauthCred.token.AccessToken)
+	assert.Equal(t, "oauth-refresh-tok", oauthCred.token.RefreshToken)
+}
+
+// TestNewCredentialStore_UnknownAuthType tests that an unrecognized GITLAB_AUTH_TYPE is rejected.
+func TestNewCredentialStore_UnknownAuthType(t *testing.T) {
+	t.Setenv("CI_JOB_TOKEN", "")
+	t.Setenv("GITLAB_TOKEN", "some-tok")
+	t.Setenv("GITLAB_AUTH_TYPE", "bogus")
+
+	_, err := NewCredentialStore("https://gitlab.com/", "")
+
+	require.ErrorIs(t, err, ErrUnknownAuthType)
+}
+
+// TestNewCredentialStore_FromConfigFile tests that per-host entries in a credentials file are
+// loaded and resolvable independently of the default host's env vars.
+func TestNewCredentialStore_FromConfigFile(t *testing.T) {
+	t.Setenv("CI_JOB_TOKEN", "")
+	t.Setenv("GITLAB_TOKEN", "")
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "credentials.yaml")
+	contents := strings.Join([]string{
+		"hosts:",
+		"  https://gitlab.example.com/:",
+		"    type: token",
+		"    token: self-hosted-tok",
+	}, "\n")
+	require.NoError(t, os.WriteFile(path, []byte(contents), 0o600))
+
+	store, err := NewCredentialStore("https://gitlab.com/", path)
+
+	require.NoError(t, err)
+	cred, ok := store.Lookup("https://gitlab.example.com/")
+	require.True(t, ok)
+	assert.Equal(t, &PersonalAccessToken{Token: "self-hosted-tok"}, cred)
+
+	_, ok = store.Lookup("https://gitlab.com/")
+	assert.False(t, ok)
+}
+
+// TestNewCredentialStore_MissingConfigFileIsNotAnError tests that a non-existent credentials
+// file path is treated as "no file configured" rather than an error.
+func TestNewCredentialStore_MissingConfigFileIsNotAnError(t *testing.T) {
+	t.Setenv("CI_JOB_TOKEN", "")
+	t.Setenv("GITLAB_TOKEN", "")
+
+	store, err := NewCredentialStore("https://gitlab.com/", "/nonexistent/credentials.yaml")
+
+	require.NoError(t, err)
+	_, ok := store.Lookup("https://gitlab.com/")
+	assert.False(t, ok)
+}
+
+// TestCredentialStore_CredentialFor_FallsBackToAnonymous tests that an unconfigured host
+// resolves to Anonymous rather than an error, so public read-only calls still work.
+func TestCredentialStore_CredentialFor_FallsBackToAnonymous(t *testing.T) {
+	store := &CredentialStore{byHost: map[string]Credential{}}
+
+	got := store.CredentialFor("https://gitlab.com/")
+
+	assert.Equal(t, &Anonymous{}, got)
+}
+
+// TestReadTokenFromStdin tests that a token is read and trimmed from the given reader.
+func TestReadTokenFromStdin(t *testing.T) {
+	got, err := ReadTokenFromStdin(strings.NewReader("  my-token  \n"))
+
+	require.NoError(t, err)
+	assert.Equal(t, &PersonalAccessToken{Token: "my-token"}, got)
+}
+
+// TestReadTokenFromStdin_Empty tests that a blank line is rejected.
+func TestReadTokenFromStdin_Empty(t *testing.T) {
+	_, err := ReadTokenFromStdin(strings.NewReader("\n"))
+
+	assert.ErrorIs(t, err, ErrCredentialTokenRequired)
+}
@@ -0,0 +1,60 @@
+package app
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// credentialTransport re-applies cred's current auth header to every outgoing request and calls
+// cred.Refresh beforehand, so a renewed OAuth2Token access token reaches GitLab without the
+// caller ever having to rebuild the *gitlab.Client that NewClient baked the original token into.
+// Refresh is cheap to call on every request: credentials that never expire treat it as a no-op,
+// and OAuth2Token only performs an actual token-endpoint round trip once its cached token is near
+// expiry. A 401 response to a request without a body triggers one more Refresh and a single retry
+// with whatever header the credential then reports; requests with a body are not retried, since
+// the body may already have been consumed by the first attempt. That extra Refresh goes through
+// the same expiry check, so it does not renew an OAuth2Token whose cached token has not expired
+// (or has no expiry): a token revoked before its expiry is resent unchanged.
+type credentialTransport struct {
+	next http.RoundTripper
+	cred Credential
+}
+
+// newCredentialTransport wraps next (http.DefaultTransport if nil) with cred's refresh-and-apply
+// behavior.
+func newCredentialTransport(next http.RoundTripper, cred Credential) *credentialTransport {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	return &credentialTransport{next: next, cred: cred}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *credentialTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := t.cred.Refresh(req.Context()); err != nil {
+		return nil, fmt.Errorf("failed to refresh credential: %w", err)
+	}
+	t.applyAuthHeader(req)
+
+	resp, err := t.next.RoundTrip(req)
+	if err != nil || resp.StatusCode != http.StatusUnauthorized || req.Body != nil {
+		return resp, err
+	}
+
+	if refreshErr := t.cred.Refresh(req.Context()); refreshErr != nil {
+		return resp, err
+	}
+	resp.Body.Close()
+	retryReq := req.Clone(req.Context())
+	t.applyAuthHeader(retryReq)
+	return t.next.RoundTrip(retryReq)
+}
+
+// applyAuthHeader sets req's auth header to cred's current token, overriding whatever static
+// header the underlying *gitlab.Client attached when it was constructed.
+func (t *credentialTransport) applyAuthHeader(req *http.Request) {
+	name, value := t.cred.AuthHeader()
+	if name != "" {
+		req.Header.Set(name, value)
+	}
+}
@@ -0,0 +1,127 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gitlab.com/gitlab-org/api/client-go"
+)
+
+// closeTrackingBody is an io.ReadCloser that records whether Close was called, so tests can
+// verify the 401 retry path doesn't leak the first response's body.
+type closeTrackingBody struct {
+	io.Reader
+	closed bool
+}
+
+func (b *closeTrackingBody) Close() error {
+	b.closed = true
+	return nil
+}
+
+// fakeCredential is a minimal Credential double for credentialTransport tests: it counts Refresh
+// calls and reports an auth header whose value changes with every refresh, so tests can tell a
+// refreshed token from a stale one without depending on OAuth2Token's real token endpoint.
+type fakeCredential struct {
+	refreshes int
+}
+
+func (c *fakeCredential) NewClient(string, *http.Client) (*gitlab.Client, error) {
+	return nil, nil
+}
+
+func (c *fakeCredential) Refresh(context.Context) error {
+	c.refreshes++
+	return nil
+}
+
+func (c *fakeCredential) AuthHeader() (name, value string) {
+	return "Authorization", fmt.Sprintf("Bearer tok-%d", c.refreshes)
+}
+
+// roundTripFunc adapts a function to http.RoundTripper.
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// TestCredentialTransport_RefreshesBeforeEveryCall verifies that a single refresh happens before
+// the request reaches the network, and that the refreshed token is what gets sent.
+func TestCredentialTransport_RefreshesBeforeEveryCall(t *testing.T) {
+	cred := &fakeCredential{}
+	var gotAuth string
+	next := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		gotAuth = req.Header.Get("Authorization")
+		return &http.Response{StatusCode: http.StatusOK}, nil
+	})
+
+	transport := newCredentialTransport(next, cred)
+	req, err := http.NewRequest(http.MethodGet, "https://gitlab.example.com/api/v4/projects", nil)
+	require.NoError(t, err)
+
+	resp, err := transport.RoundTrip(req)
+
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, 1, cred.refreshes)
+	assert.Equal(t, "Bearer tok-1", gotAuth)
+}
+
+// TestCredentialTransport_ForcesRefreshAndRetryOn401 verifies that a 401 response triggers
+// exactly one forced refresh and retry, carrying the newly refreshed token.
+func TestCredentialTransport_ForcesRefreshAndRetryOn401(t *testing.T) {
+	cred := &fakeCredential{}
+	var calls int
+	var authHeaders []string
+	unauthorizedBody := &closeTrackingBody{Reader: strings.NewReader("")}
+	next := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		calls++
+		authHeaders = append(authHeaders, req.Header.Get("Authorization"))
+		if calls == 1 {
+			return &http.Response{StatusCode: http.StatusUnauthorized, Body: unauthorizedBody}, nil
+		}
+		return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody}, nil
+	})
+
+	transport := newCredentialTransport(next, cred)
+	req, err := http.NewRequest(http.MethodGet, "https://gitlab.example.com/api/v4/projects", nil)
+	require.NoError(t, err)
+
+	resp, err := transport.RoundTrip(req)
+
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, 2, calls)
+	assert.Equal(t, 2, cred.refreshes)
+	assert.Equal(t, []string{"Bearer tok-1", "Bearer tok-2"}, authHeaders)
+	assert.True(t, unauthorizedBody.closed, "the 401 response's body should be closed before retrying")
+}
+
+// TestCredentialTransport_DoesNotRetryRequestWithBody verifies that a 401 on a request carrying
+// a body is returned as-is, since the body may already be consumed and cannot be safely replayed.
+func TestCredentialTransport_DoesNotRetryRequestWithBody(t *testing.T) {
+	cred := &fakeCredential{}
+	var calls int
+	next := roundTripFunc(func(*http.Request) (*http.Response, error) {
+		calls++
+		return &http.Response{StatusCode: http.StatusUnauthorized}, nil
+	})
+
+	transport := newCredentialTransport(next, cred)
+	req, err := http.NewRequest(http.MethodPost, "https://gitlab.example.com/api/v4/projects", strings.NewReader("{}"))
+	require.NoError(t, err)
+
+	resp, err := transport.RoundTrip(req)
+
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	assert.Equal(t, 1, calls)
+	assert.Equal(t, 1, cred.refreshes)
+}
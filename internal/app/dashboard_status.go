@@ -0,0 +1,172 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/sgaunet/gitlab-mcp/internal/gitlaberr"
+	"gitlab.com/gitlab-org/api/client-go"
+)
+
+// dashboardStatusCacheTTL controls how long GetPipelineStatus/GetMergeRequestStatus results are
+// served from cache before a polling dashboard triggers a fresh GitLab call.
+const dashboardStatusCacheTTL = 30 * time.Second
+
+// DashboardState is a small, dashboard-tile-friendly normalization of GitLab's many pipeline/job
+// states (see normalizeDashboardState), distinct from the coarser "running"/"success"/"failed"/
+// "canceled" states Pipeline.Status already uses elsewhere in this package.
+type DashboardState string
+
+// Dashboard states a polling consumer (e.g. a Monitoror tile) can switch on directly.
+const (
+	DashboardStateSuccess        DashboardState = "SUCCESS"
+	DashboardStateFailed         DashboardState = "FAILED"
+	DashboardStateRunning        DashboardState = "RUNNING"
+	DashboardStateQueued         DashboardState = "QUEUED"
+	DashboardStateCanceled       DashboardState = "CANCELED"
+	DashboardStateActionRequired DashboardState = "ACTION_REQUIRED"
+	DashboardStateWarning        DashboardState = "WARNING"
+	DashboardStateUnknown        DashboardState = "UNKNOWN"
+)
+
+// DashboardStatus is the normalized status shape GetPipelineStatus and GetMergeRequestStatus
+// return, sized for a dashboard tile.
+type DashboardStatus struct {
+	State      DashboardState `json:"state"`
+	Duration   int            `json:"duration_seconds"`
+	Author     string         `json:"author,omitempty"`
+	StartedAt  string         `json:"started_at,omitempty"`
+	FinishedAt string         `json:"finished_at,omitempty"`
+}
+
+// normalizeDashboardState maps a raw GitLab pipeline/job status to a DashboardState.
+func normalizeDashboardState(status string) DashboardState {
+	switch status {
+	case "success":
+		return DashboardStateSuccess
+	case "failed":
+		return DashboardStateFailed
+	case "running", "preparing", "waiting_for_resource":
+		return DashboardStateRunning
+	case "created", "pending", "scheduled":
+		return DashboardStateQueued
+	case "canceled", "canceling":
+		return DashboardStateCanceled
+	case "manual":
+		return DashboardStateActionRequired
+	case "skipped":
+		return DashboardStateWarning
+	default:
+		return DashboardStateUnknown
+	}
+}
+
+// dashboardStatusFromPipeline builds a DashboardStatus from a full GitLab pipeline.
+func dashboardStatusFromPipeline(pipeline *gitlab.Pipeline) DashboardStatus {
+	status := DashboardStatus{
+		State:      normalizeDashboardState(pipeline.Status),
+		Duration:   pipeline.Duration,
+		StartedAt:  formatGitLabTime(pipeline.StartedAt),
+		FinishedAt: formatGitLabTime(pipeline.FinishedAt),
+	}
+	if pipeline.User != nil {
+		status.Author = pipeline.User.Username
+	}
+	return status
+}
+
+// GetPipelineStatus returns the normalized dashboard status of the latest pipeline for ref (or
+// the project's default branch when ref is empty), serving from cache for dashboardStatusCacheTTL
+// so a polling consumer doesn't hammer the GitLab API.
+func (a *App) GetPipelineStatus(projectPath string, ref string) (*DashboardStatus, error) {
+	a.logger.Debug("Getting pipeline dashboard status", "project_path", projectPath, "ref", ref)
+
+	project, err := a.getProjectCached(projectPath)
+	if err != nil {
+		a.logger.Error("Failed to get project", "error", err, "project_path", projectPath)
+		return nil, err
+	}
+
+	cacheKey := fmt.Sprintf("dashboard_pipeline_status:%d:%s", project.ID, ref)
+	if cached, ok := a.cache.Get(cacheKey); ok {
+		if status, ok := cached.Value.(DashboardStatus); ok {
+			a.logger.Debug("Cache hit", "key", cacheKey)
+			return &status, nil
+		}
+	}
+
+	opt := &gitlab.GetLatestPipelineOptions{}
+	if ref != "" {
+		opt.Ref = &ref
+	}
+
+	pipeline, _, err := retryCall(a, context.Background(), "GetLatestPipeline",
+		func() (*gitlab.Pipeline, *gitlab.Response, error) {
+			return a.client.Pipelines().GetLatestPipeline(project.ID, opt)
+		})
+	if err != nil {
+		a.logger.Error("Failed to get latest pipeline", "error", err, "project_id", project.ID, "ref", ref)
+		return nil, gitlaberr.Classify(err, "failed to get latest pipeline")
+	}
+
+	status := dashboardStatusFromPipeline(pipeline)
+	a.cache.Set(cacheKey, CacheEntry{Value: status}, dashboardStatusCacheTTL)
+
+	a.logger.Info("Successfully retrieved pipeline dashboard status", "state", status.State, "project_id", project.ID)
+	return &status, nil
+}
+
+// GetMergeRequestStatus returns the normalized dashboard status of a merge request's most recent
+// pipeline, serving from cache for dashboardStatusCacheTTL. A merge request with no pipelines
+// yields DashboardStateUnknown rather than an error.
+func (a *App) GetMergeRequestStatus(projectPath string, mrIID int64) (*DashboardStatus, error) {
+	a.logger.Debug("Getting merge request dashboard status", "project_path", projectPath, "mr_iid", mrIID)
+
+	project, err := a.getProjectCached(projectPath)
+	if err != nil {
+		a.logger.Error("Failed to get project", "error", err, "project_path", projectPath)
+		return nil, err
+	}
+
+	cacheKey := fmt.Sprintf("dashboard_mr_status:%d:%d", project.ID, mrIID)
+	if cached, ok := a.cache.Get(cacheKey); ok {
+		if status, ok := cached.Value.(DashboardStatus); ok {
+			a.logger.Debug("Cache hit", "key", cacheKey)
+			return &status, nil
+		}
+	}
+
+	pipelines, _, err := retryCall(a, context.Background(), "ListMergeRequestPipelines",
+		func() ([]*gitlab.PipelineInfo, *gitlab.Response, error) {
+			return a.client.MergeRequests().ListMergeRequestPipelines(project.ID, mrIID)
+		})
+	if err != nil {
+		a.logger.Error("Failed to list merge request pipelines", "error", err, "project_id", project.ID, "mr_iid", mrIID)
+		return nil, gitlaberr.Classify(err, "failed to list merge request pipelines")
+	}
+
+	if len(pipelines) == 0 {
+		status := DashboardStatus{State: DashboardStateUnknown}
+		a.cache.Set(cacheKey, CacheEntry{Value: status}, dashboardStatusCacheTTL)
+		return &status, nil
+	}
+
+	// GitLab returns a merge request's pipelines newest first.
+	latest := pipelines[0]
+	pipeline, _, err := retryCall(a, context.Background(), "GetPipeline",
+		func() (*gitlab.Pipeline, *gitlab.Response, error) {
+			return a.client.Pipelines().GetPipeline(project.ID, latest.ID)
+		})
+	if err != nil {
+		a.logger.Error("Failed to get pipeline", "error", err, "project_id", project.ID, "pipeline_id", latest.ID)
+		return nil, gitlaberr.Classify(err, "failed to get pipeline")
+	}
+
+	status := dashboardStatusFromPipeline(pipeline)
+	a.cache.Set(cacheKey, CacheEntry{Value: status}, dashboardStatusCacheTTL)
+
+	a.logger.Info("Successfully retrieved merge request dashboard status",
+		"state", status.State, "project_id", project.ID, "mr_iid", mrIID)
+	return &status, nil
+}
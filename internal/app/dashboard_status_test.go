@@ -0,0 +1,137 @@
+package app
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gitlab.com/gitlab-org/api/client-go"
+)
+
+// TestNormalizeDashboardState tests that normalizeDashboardState buckets raw GitLab pipeline/job
+// statuses into the small enum dashboard tile consumers expect, independent of the HTTP layer.
+func TestNormalizeDashboardState(t *testing.T) {
+	tests := []struct {
+		status string
+		want   DashboardState
+	}{
+		{status: "success", want: DashboardStateSuccess},
+		{status: "failed", want: DashboardStateFailed},
+		{status: "running", want: DashboardStateRunning},
+		{status: "preparing", want: DashboardStateRunning},
+		{status: "waiting_for_resource", want: DashboardStateRunning},
+		{status: "created", want: DashboardStateQueued},
+		{status: "pending", want: DashboardStateQueued},
+		{status: "scheduled", want: DashboardStateQueued},
+		{status: "canceled", want: DashboardStateCanceled},
+		{status: "canceling", want: DashboardStateCanceled},
+		{status: "manual", want: DashboardStateActionRequired},
+		{status: "skipped", want: DashboardStateWarning},
+		{status: "something_unknown", want: DashboardStateUnknown},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.status, func(t *testing.T) {
+			assert.Equal(t, tt.want, normalizeDashboardState(tt.status))
+		})
+	}
+}
+
+// TestApp_GetPipelineStatus_CachesRepeatedCalls verifies that a second call within
+// dashboardStatusCacheTTL is served from cache instead of hitting GitLab again.
+func TestApp_GetPipelineStatus_CachesRepeatedCalls(t *testing.T) {
+	mockClient := &MockGitLabClient{}
+	mockProjects := &MockProjectsService{}
+	mockPipelines := &MockPipelinesService{}
+
+	mockClient.On("Projects").Return(mockProjects)
+	mockClient.On("Pipelines").Return(mockPipelines)
+	mockProjects.On("GetProject", "test/project", (*gitlab.GetProjectOptions)(nil)).Return(
+		&gitlab.Project{ID: 123}, &gitlab.Response{}, nil,
+	)
+
+	started := time.Now()
+	mockPipelines.On("GetLatestPipeline", 123, &gitlab.GetLatestPipelineOptions{Ref: gitlab.Ptr("main")}).Return(
+		&gitlab.Pipeline{
+			Status:    "manual",
+			Duration:  42,
+			StartedAt: &started,
+			User:      &gitlab.BasicUser{Username: "alice"},
+		}, &gitlab.Response{}, nil,
+	).Once()
+
+	a := newTestAppForPipeline(mockClient)
+
+	first, err := a.GetPipelineStatus("test/project", "main")
+	require.NoError(t, err)
+	assert.Equal(t, DashboardStateActionRequired, first.State)
+	assert.Equal(t, 42, first.Duration)
+	assert.Equal(t, "alice", first.Author)
+
+	second, err := a.GetPipelineStatus("test/project", "main")
+	require.NoError(t, err)
+	assert.Equal(t, first, second)
+
+	mockClient.AssertExpectations(t)
+	mockPipelines.AssertExpectations(t)
+}
+
+// TestApp_GetMergeRequestStatus_NoPipelines verifies that a merge request with no pipelines
+// reports DashboardStateUnknown rather than an error.
+func TestApp_GetMergeRequestStatus_NoPipelines(t *testing.T) {
+	mockClient := &MockGitLabClient{}
+	mockProjects := &MockProjectsService{}
+	mockMRs := &MockMergeRequestsService{}
+
+	mockClient.On("Projects").Return(mockProjects)
+	mockClient.On("MergeRequests").Return(mockMRs)
+	mockProjects.On("GetProject", "test/project", (*gitlab.GetProjectOptions)(nil)).Return(
+		&gitlab.Project{ID: 123}, &gitlab.Response{}, nil,
+	)
+	mockMRs.On("ListMergeRequestPipelines", int64(123), int64(5)).Return(
+		[]*gitlab.PipelineInfo{}, &gitlab.Response{}, nil,
+	)
+
+	a := newTestAppForPipeline(mockClient)
+
+	got, err := a.GetMergeRequestStatus("test/project", 5)
+	require.NoError(t, err)
+	assert.Equal(t, DashboardStateUnknown, got.State)
+
+	mockClient.AssertExpectations(t)
+	mockMRs.AssertExpectations(t)
+}
+
+// TestApp_GetMergeRequestStatus_UsesLatestPipeline verifies that the most recent pipeline's full
+// details are fetched and normalized.
+func TestApp_GetMergeRequestStatus_UsesLatestPipeline(t *testing.T) {
+	mockClient := &MockGitLabClient{}
+	mockProjects := &MockProjectsService{}
+	mockMRs := &MockMergeRequestsService{}
+	mockPipelines := &MockPipelinesService{}
+
+	mockClient.On("Projects").Return(mockProjects)
+	mockClient.On("MergeRequests").Return(mockMRs)
+	mockClient.On("Pipelines").Return(mockPipelines)
+	mockProjects.On("GetProject", "test/project", (*gitlab.GetProjectOptions)(nil)).Return(
+		&gitlab.Project{ID: 123}, &gitlab.Response{}, nil,
+	)
+	mockMRs.On("ListMergeRequestPipelines", int64(123), int64(5)).Return(
+		[]*gitlab.PipelineInfo{{ID: 99}, {ID: 98}}, &gitlab.Response{}, nil,
+	)
+	mockPipelines.On("GetPipeline", int64(123), int64(99)).Return(
+		&gitlab.Pipeline{Status: "failed", Duration: 7}, &gitlab.Response{}, nil,
+	)
+
+	a := newTestAppForPipeline(mockClient)
+
+	got, err := a.GetMergeRequestStatus("test/project", 5)
+	require.NoError(t, err)
+	assert.Equal(t, DashboardStateFailed, got.State)
+	assert.Equal(t, 7, got.Duration)
+
+	mockClient.AssertExpectations(t)
+	mockMRs.AssertExpectations(t)
+	mockPipelines.AssertExpectations(t)
+}
@@ -0,0 +1,164 @@
+package app
+
+import "strings"
+
+// DiffSet is the result of parsing a +/- diff list: Add and Remove are applied on top of
+// whatever set already exists on the resource, while Replace (when non-empty) discards the
+// existing set entirely and becomes the new set.
+type DiffSet struct {
+	Add     []string
+	Remove  []string
+	Replace []string
+}
+
+// ParseDiffList parses a list of tokens where each token is a bare value (added to Replace),
+// or prefixed with "+" (added to Add) or "-"/"!" (added to Remove). If any bare token is present,
+// Replace wins for the whole list and any +/- tokens are discarded, since mixing "replace the
+// set" with "adjust the set" has no sensible combined meaning.
+func ParseDiffList(tokens []string) DiffSet {
+	var diff DiffSet
+	for _, token := range tokens {
+		trimmed := strings.TrimSpace(token)
+		switch {
+		case strings.HasPrefix(trimmed, "+"):
+			if value := strings.TrimSpace(trimmed[1:]); value != "" {
+				diff.Add = append(diff.Add, value)
+			}
+		case strings.HasPrefix(trimmed, "-"), strings.HasPrefix(trimmed, "!"):
+			if value := strings.TrimSpace(trimmed[1:]); value != "" {
+				diff.Remove = append(diff.Remove, value)
+			}
+		case trimmed != "":
+			diff.Replace = append(diff.Replace, trimmed)
+		}
+	}
+
+	if len(diff.Replace) > 0 {
+		diff.Add = nil
+		diff.Remove = nil
+	}
+
+	return diff
+}
+
+// ParseLabels parses a label diff list (see ParseDiffList).
+func ParseLabels(tokens []string) DiffSet {
+	return ParseDiffList(tokens)
+}
+
+// ParseAssignees parses an assignee or reviewer diff list of usernames (see ParseDiffList).
+func ParseAssignees(tokens []string) DiffSet {
+	return ParseDiffList(tokens)
+}
+
+// ApplyIDDiff resolves diff against a current set of IDs, returning the resulting set. Replace
+// tokens, when present, are resolved and returned as-is. Otherwise, Add tokens are resolved and
+// appended to current (skipping duplicates) and Remove tokens are resolved and filtered out.
+// resolve is called once per token to turn it into an ID, typically resolving a username.
+func ApplyIDDiff(current []int64, diff DiffSet, resolve func(string) (int64, error)) ([]int64, error) {
+	if len(diff.Replace) > 0 {
+		return resolveIDs(diff.Replace, resolve)
+	}
+	if len(diff.Add) == 0 && len(diff.Remove) == 0 {
+		return current, nil
+	}
+
+	addIDs, err := resolveIDs(diff.Add, resolve)
+	if err != nil {
+		return nil, err
+	}
+	removeIDs, err := resolveIDs(diff.Remove, resolve)
+	if err != nil {
+		return nil, err
+	}
+
+	removeSet := make(map[int64]bool, len(removeIDs))
+	for _, id := range removeIDs {
+		removeSet[id] = true
+	}
+
+	result := make([]int64, 0, len(current)+len(addIDs))
+	seen := make(map[int64]bool, len(current)+len(addIDs))
+	for _, id := range current {
+		if removeSet[id] || seen[id] {
+			continue
+		}
+		seen[id] = true
+		result = append(result, id)
+	}
+	for _, id := range addIDs {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		result = append(result, id)
+	}
+
+	return result, nil
+}
+
+// ApplyStringDiff resolves diff against a current set of plain string values (e.g. project
+// topics), returning the resulting set. Replace tokens, when present, are returned as-is
+// (deduplicated, order preserved). Otherwise, Add values are appended to current (skipping
+// duplicates) and Remove values are filtered out. Unlike ApplyIDDiff, no resolve step is needed
+// since the tokens are already the values being stored.
+func ApplyStringDiff(current []string, diff DiffSet) []string {
+	if len(diff.Replace) > 0 {
+		return dedupStrings(diff.Replace)
+	}
+	if len(diff.Add) == 0 && len(diff.Remove) == 0 {
+		return current
+	}
+
+	removeSet := make(map[string]bool, len(diff.Remove))
+	for _, value := range diff.Remove {
+		removeSet[value] = true
+	}
+
+	result := make([]string, 0, len(current)+len(diff.Add))
+	seen := make(map[string]bool, len(current)+len(diff.Add))
+	for _, value := range current {
+		if removeSet[value] || seen[value] {
+			continue
+		}
+		seen[value] = true
+		result = append(result, value)
+	}
+	for _, value := range diff.Add {
+		if seen[value] {
+			continue
+		}
+		seen[value] = true
+		result = append(result, value)
+	}
+
+	return result
+}
+
+// dedupStrings returns values with later duplicates dropped, preserving the first occurrence's
+// order.
+func dedupStrings(values []string) []string {
+	result := make([]string, 0, len(values))
+	seen := make(map[string]bool, len(values))
+	for _, value := range values {
+		if seen[value] {
+			continue
+		}
+		seen[value] = true
+		result = append(result, value)
+	}
+	return result
+}
+
+// resolveIDs resolves each token to an ID via resolve, preserving order.
+func resolveIDs(tokens []string, resolve func(string) (int64, error)) ([]int64, error) {
+	ids := make([]int64, 0, len(tokens))
+	for _, token := range tokens {
+		id, err := resolve(token)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
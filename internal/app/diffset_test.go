@@ -0,0 +1,115 @@
+package app
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseDiffList(t *testing.T) {
+	tests := []struct {
+		name   string
+		tokens []string
+		want   DiffSet
+	}{
+		{
+			name:   "add and remove",
+			tokens: []string{"+bug", "-needs-triage", "!wontfix"},
+			want:   DiffSet{Add: []string{"bug"}, Remove: []string{"needs-triage", "wontfix"}},
+		},
+		{
+			name:   "bare tokens replace the set",
+			tokens: []string{"bug", "fixed"},
+			want:   DiffSet{Replace: []string{"bug", "fixed"}},
+		},
+		{
+			name:   "a bare token wins over +/- tokens",
+			tokens: []string{"+bug", "fixed"},
+			want:   DiffSet{Replace: []string{"fixed"}},
+		},
+		{
+			name:   "blank and whitespace-only tokens are ignored",
+			tokens: []string{"", "  ", "+", "-"},
+			want:   DiffSet{},
+		},
+		{
+			name:   "empty input",
+			tokens: nil,
+			want:   DiffSet{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ParseDiffList(tt.tokens))
+		})
+	}
+}
+
+func TestApplyIDDiff(t *testing.T) {
+	identity := func(s string) (int64, error) {
+		switch s {
+		case "alice":
+			return 1, nil
+		case "bob":
+			return 2, nil
+		case "carol":
+			return 3, nil
+		default:
+			return 0, errors.New("unknown user: " + s)
+		}
+	}
+
+	t.Run("replace discards current set", func(t *testing.T) {
+		got, err := ApplyIDDiff([]int64{1, 2}, DiffSet{Replace: []string{"carol"}}, identity)
+		require.NoError(t, err)
+		assert.Equal(t, []int64{3}, got)
+	})
+
+	t.Run("add appends without duplicating", func(t *testing.T) {
+		got, err := ApplyIDDiff([]int64{1}, DiffSet{Add: []string{"alice", "bob"}}, identity)
+		require.NoError(t, err)
+		assert.Equal(t, []int64{1, 2}, got)
+	})
+
+	t.Run("remove filters out matching ids", func(t *testing.T) {
+		got, err := ApplyIDDiff([]int64{1, 2, 3}, DiffSet{Remove: []string{"bob"}}, identity)
+		require.NoError(t, err)
+		assert.Equal(t, []int64{1, 3}, got)
+	})
+
+	t.Run("no add or remove returns current set unchanged", func(t *testing.T) {
+		got, err := ApplyIDDiff([]int64{1, 2}, DiffSet{}, identity)
+		require.NoError(t, err)
+		assert.Equal(t, []int64{1, 2}, got)
+	})
+
+	t.Run("resolve error propagates", func(t *testing.T) {
+		_, err := ApplyIDDiff([]int64{1}, DiffSet{Add: []string{"dave"}}, identity)
+		assert.Error(t, err)
+	})
+}
+
+func TestApplyStringDiff(t *testing.T) {
+	t.Run("replace discards current set", func(t *testing.T) {
+		got := ApplyStringDiff([]string{"go", "cli"}, DiffSet{Replace: []string{"prod"}})
+		assert.Equal(t, []string{"prod"}, got)
+	})
+
+	t.Run("add appends without duplicating", func(t *testing.T) {
+		got := ApplyStringDiff([]string{"go"}, DiffSet{Add: []string{"go", "cli"}})
+		assert.Equal(t, []string{"go", "cli"}, got)
+	})
+
+	t.Run("remove filters out matching values", func(t *testing.T) {
+		got := ApplyStringDiff([]string{"go", "cli", "legacy"}, DiffSet{Remove: []string{"legacy"}})
+		assert.Equal(t, []string{"go", "cli"}, got)
+	})
+
+	t.Run("no add or remove returns current set unchanged", func(t *testing.T) {
+		got := ApplyStringDiff([]string{"go", "cli"}, DiffSet{})
+		assert.Equal(t, []string{"go", "cli"}, got)
+	})
+}
@@ -0,0 +1,389 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/sgaunet/gitlab-mcp/internal/gitlaberr"
+	"gitlab.com/gitlab-org/api/client-go"
+)
+
+// ErrDiscussionIDRequired is returned when a discussion operation is requested without a
+// discussion ID.
+var ErrDiscussionIDRequired = errors.New("discussion id is required")
+
+// ErrUsernameRequired is returned when a username is required but was not provided.
+var ErrUsernameRequired = errors.New("username is required")
+
+// DiscussionPosition anchors a new merge request discussion to a specific line of a diff, letting
+// the assistant leave inline review comments instead of only top-level notes.
+type DiscussionPosition struct {
+	BaseSHA  string
+	StartSHA string
+	HeadSHA  string
+	OldPath  string
+	NewPath  string
+	OldLine  int
+	NewLine  int
+}
+
+// CreateMergeRequestDiscussionOptions contains options for starting a new discussion thread on a
+// merge request. Position is optional; when set, the discussion is anchored to a line of the diff.
+type CreateMergeRequestDiscussionOptions struct {
+	Body     string
+	Position *DiscussionPosition
+}
+
+// convertDiscussionPosition builds the GitLab position options for an inline diff comment.
+func convertDiscussionPosition(pos *DiscussionPosition) *gitlab.PositionOptions {
+	if pos == nil {
+		return nil
+	}
+
+	positionType := "text"
+	return &gitlab.PositionOptions{
+		BaseSHA:      &pos.BaseSHA,
+		StartSHA:     &pos.StartSHA,
+		HeadSHA:      &pos.HeadSHA,
+		OldPath:      &pos.OldPath,
+		NewPath:      &pos.NewPath,
+		PositionType: &positionType,
+		OldLine:      &pos.OldLine,
+		NewLine:      &pos.NewLine,
+	}
+}
+
+// GetMergeRequestDiscussion retrieves a single discussion thread on a merge request.
+func (a *App) GetMergeRequestDiscussion(projectPath string, mrIID int64, discussionID string) (*Discussion, error) {
+	if mrIID <= 0 {
+		return nil, ErrInvalidMergeRequestIID
+	}
+	if discussionID == "" {
+		return nil, ErrDiscussionIDRequired
+	}
+
+	a.logger.Debug("Getting merge request discussion",
+		"project_path", projectPath, "mr_iid", mrIID, "discussion_id", discussionID)
+
+	project, err := a.getProjectCached(projectPath)
+	if err != nil {
+		a.logger.Error("Failed to get project", "error", err, "project_path", projectPath)
+		return nil, err
+	}
+
+	discussion, _, err := retryCall(a, context.Background(), "GetMergeRequestDiscussion",
+		func() (*gitlab.Discussion, *gitlab.Response, error) {
+			return a.client.Discussions().GetMergeRequestDiscussion(project.ID, mrIID, discussionID)
+		})
+	if err != nil {
+		a.logger.Error("Failed to get merge request discussion", "error", err, "project_id", project.ID, "mr_iid", mrIID)
+		return nil, gitlaberr.Classify(err, "failed to get merge request discussion")
+	}
+
+	result := convertGitLabDiscussion(discussion)
+	a.logger.Info("Successfully retrieved merge request discussion", "discussion_id", result.ID, "mr_iid", mrIID)
+	return &result, nil
+}
+
+// CreateMergeRequestDiscussion starts a new discussion thread on a merge request, optionally
+// anchored to a line of the diff for an inline review comment.
+func (a *App) CreateMergeRequestDiscussion(
+	projectPath string,
+	mrIID int64,
+	opts *CreateMergeRequestDiscussionOptions,
+) (*Discussion, error) {
+	if mrIID <= 0 {
+		return nil, ErrInvalidMergeRequestIID
+	}
+	if opts == nil || opts.Body == "" {
+		return nil, ErrNoteBodyRequired
+	}
+
+	a.logger.Debug("Creating merge request discussion", "project_path", projectPath, "mr_iid", mrIID)
+
+	project, err := a.getProjectCached(projectPath)
+	if err != nil {
+		a.logger.Error("Failed to get project", "error", err, "project_path", projectPath)
+		return nil, err
+	}
+
+	createOpts := &gitlab.CreateMergeRequestDiscussionOptions{
+		Body:     &opts.Body,
+		Position: convertDiscussionPosition(opts.Position),
+	}
+
+	discussion, _, err := retryCall(a, context.Background(), "CreateMergeRequestDiscussion",
+		func() (*gitlab.Discussion, *gitlab.Response, error) {
+			return a.client.Discussions().CreateMergeRequestDiscussion(project.ID, mrIID, createOpts)
+		})
+	if err != nil {
+		a.logger.Error("Failed to create merge request discussion", "error", err, "project_id", project.ID, "mr_iid", mrIID)
+		return nil, gitlaberr.Classify(err, "failed to create merge request discussion")
+	}
+
+	result := convertGitLabDiscussion(discussion)
+	a.logger.Info("Successfully created merge request discussion", "discussion_id", result.ID, "mr_iid", mrIID)
+	return &result, nil
+}
+
+// AddMergeRequestDiscussionNote replies to an existing discussion thread on a merge request.
+func (a *App) AddMergeRequestDiscussionNote(
+	projectPath string,
+	mrIID int64,
+	discussionID string,
+	body string,
+) (*Note, error) {
+	if mrIID <= 0 {
+		return nil, ErrInvalidMergeRequestIID
+	}
+	if discussionID == "" {
+		return nil, ErrDiscussionIDRequired
+	}
+	if body == "" {
+		return nil, ErrNoteBodyRequired
+	}
+
+	a.logger.Debug("Adding note to merge request discussion",
+		"project_path", projectPath, "mr_iid", mrIID, "discussion_id", discussionID)
+
+	project, err := a.getProjectCached(projectPath)
+	if err != nil {
+		a.logger.Error("Failed to get project", "error", err, "project_path", projectPath)
+		return nil, err
+	}
+
+	addOpts := &gitlab.AddMergeRequestDiscussionNoteOptions{Body: &body}
+
+	note, _, err := retryCall(a, context.Background(), "AddMergeRequestDiscussionNote",
+		func() (*gitlab.Note, *gitlab.Response, error) {
+			return a.client.Discussions().AddMergeRequestDiscussionNote(project.ID, mrIID, discussionID, addOpts)
+		})
+	if err != nil {
+		a.logger.Error("Failed to add merge request discussion note",
+			"error", err, "project_id", project.ID, "mr_iid", mrIID, "discussion_id", discussionID)
+		return nil, gitlaberr.Classify(err, "failed to add merge request discussion note")
+	}
+
+	result := convertGitLabNote(note)
+	a.logger.Info("Successfully added merge request discussion note", "note_id", result.ID, "mr_iid", mrIID)
+	return &result, nil
+}
+
+// ResolveMergeRequestDiscussion marks a discussion thread on a merge request resolved or
+// unresolved.
+func (a *App) ResolveMergeRequestDiscussion(
+	projectPath string,
+	mrIID int64,
+	discussionID string,
+	resolved bool,
+) (*Discussion, error) {
+	if mrIID <= 0 {
+		return nil, ErrInvalidMergeRequestIID
+	}
+	if discussionID == "" {
+		return nil, ErrDiscussionIDRequired
+	}
+
+	a.logger.Debug("Resolving merge request discussion",
+		"project_path", projectPath, "mr_iid", mrIID, "discussion_id", discussionID, "resolved", resolved)
+
+	project, err := a.getProjectCached(projectPath)
+	if err != nil {
+		a.logger.Error("Failed to get project", "error", err, "project_path", projectPath)
+		return nil, err
+	}
+
+	resolveOpts := &gitlab.ResolveMergeRequestDiscussionOptions{Resolved: &resolved}
+
+	discussion, _, err := retryCall(a, context.Background(), "ResolveMergeRequestDiscussion",
+		func() (*gitlab.Discussion, *gitlab.Response, error) {
+			return a.client.Discussions().ResolveMergeRequestDiscussion(project.ID, mrIID, discussionID, resolveOpts)
+		})
+	if err != nil {
+		a.logger.Error("Failed to resolve merge request discussion",
+			"error", err, "project_id", project.ID, "mr_iid", mrIID, "discussion_id", discussionID)
+		return nil, gitlaberr.Classify(err, "failed to resolve merge request discussion")
+	}
+
+	result := convertGitLabDiscussion(discussion)
+	a.logger.Info("Successfully resolved merge request discussion", "discussion_id", result.ID, "mr_iid", mrIID)
+	return &result, nil
+}
+
+// ResolveMergeRequestDiscussionsByAuthor resolves every discussion thread on a merge request
+// whose first note was authored by username, and returns how many resolve calls succeeded.
+// Threads are not checked for being resolvable or already resolved first. This lets the assistant clear an entire reviewer's feedback once it has been
+// addressed, rather than resolving threads one at a time.
+func (a *App) ResolveMergeRequestDiscussionsByAuthor(projectPath string, mrIID int64, username string) (int, error) {
+	if mrIID <= 0 {
+		return 0, ErrInvalidMergeRequestIID
+	}
+	if username == "" {
+		return 0, ErrUsernameRequired
+	}
+
+	a.logger.Debug("Resolving merge request discussions by author",
+		"project_path", projectPath, "mr_iid", mrIID, "username", username)
+
+	discussions, err := a.ListMergeRequestDiscussions(projectPath, mrIID)
+	if err != nil {
+		return 0, err
+	}
+
+	resolved := 0
+	for _, discussion := range discussions {
+		if len(discussion.Notes) == 0 {
+			continue
+		}
+		author, _ := discussion.Notes[0].Author["username"].(string)
+		if author != username {
+			continue
+		}
+
+		if _, err := a.ResolveMergeRequestDiscussion(projectPath, mrIID, discussion.ID, true); err != nil {
+			a.logger.Error("Failed to resolve discussion", "error", err, "discussion_id", discussion.ID, "mr_iid", mrIID)
+			return resolved, fmt.Errorf("failed to resolve discussion %s: %w", discussion.ID, err)
+		}
+		resolved++
+	}
+
+	a.logger.Info("Successfully resolved merge request discussions by author",
+		"count", resolved, "username", username, "mr_iid", mrIID)
+	return resolved, nil
+}
+
+// ListIssueDiscussions lists the discussion threads on an issue.
+func (a *App) ListIssueDiscussions(projectPath string, issueIID int64) ([]Discussion, error) {
+	if issueIID <= 0 {
+		return nil, ErrInvalidIssueIID
+	}
+
+	a.logger.Debug("Listing issue discussions", "project_path", projectPath, "issue_iid", issueIID)
+
+	project, err := a.getProjectCached(projectPath)
+	if err != nil {
+		a.logger.Error("Failed to get project", "error", err, "project_path", projectPath)
+		return nil, err
+	}
+
+	discussions, _, err := retryCall(a, context.Background(), "ListIssueDiscussions",
+		func() ([]*gitlab.Discussion, *gitlab.Response, error) {
+			return a.client.Discussions().ListIssueDiscussions(project.ID, int(issueIID), nil)
+		})
+	if err != nil {
+		a.logger.Error("Failed to list issue discussions", "error", err, "project_id", project.ID, "issue_iid", issueIID)
+		return nil, gitlaberr.Classify(err, "failed to list issue discussions")
+	}
+
+	result := make([]Discussion, 0, len(discussions))
+	for _, discussion := range discussions {
+		result = append(result, convertGitLabDiscussion(discussion))
+	}
+
+	a.logger.Info("Successfully listed issue discussions", "count", len(result), "issue_iid", issueIID)
+	return result, nil
+}
+
+// GetIssueDiscussion retrieves a single discussion thread on an issue.
+func (a *App) GetIssueDiscussion(projectPath string, issueIID int64, discussionID string) (*Discussion, error) {
+	if issueIID <= 0 {
+		return nil, ErrInvalidIssueIID
+	}
+	if discussionID == "" {
+		return nil, ErrDiscussionIDRequired
+	}
+
+	a.logger.Debug("Getting issue discussion",
+		"project_path", projectPath, "issue_iid", issueIID, "discussion_id", discussionID)
+
+	project, err := a.getProjectCached(projectPath)
+	if err != nil {
+		a.logger.Error("Failed to get project", "error", err, "project_path", projectPath)
+		return nil, err
+	}
+
+	discussion, _, err := retryCall(a, context.Background(), "GetIssueDiscussion",
+		func() (*gitlab.Discussion, *gitlab.Response, error) {
+			return a.client.Discussions().GetIssueDiscussion(project.ID, int(issueIID), discussionID)
+		})
+	if err != nil {
+		a.logger.Error("Failed to get issue discussion", "error", err, "project_id", project.ID, "issue_iid", issueIID)
+		return nil, gitlaberr.Classify(err, "failed to get issue discussion")
+	}
+
+	result := convertGitLabDiscussion(discussion)
+	a.logger.Info("Successfully retrieved issue discussion", "discussion_id", result.ID, "issue_iid", issueIID)
+	return &result, nil
+}
+
+// CreateIssueDiscussion starts a new discussion thread on an issue.
+func (a *App) CreateIssueDiscussion(projectPath string, issueIID int64, body string) (*Discussion, error) {
+	if issueIID <= 0 {
+		return nil, ErrInvalidIssueIID
+	}
+	if body == "" {
+		return nil, ErrNoteBodyRequired
+	}
+
+	a.logger.Debug("Creating issue discussion", "project_path", projectPath, "issue_iid", issueIID)
+
+	project, err := a.getProjectCached(projectPath)
+	if err != nil {
+		a.logger.Error("Failed to get project", "error", err, "project_path", projectPath)
+		return nil, err
+	}
+
+	createOpts := &gitlab.CreateIssueDiscussionOptions{Body: &body}
+
+	discussion, _, err := retryCall(a, context.Background(), "CreateIssueDiscussion",
+		func() (*gitlab.Discussion, *gitlab.Response, error) {
+			return a.client.Discussions().CreateIssueDiscussion(project.ID, int(issueIID), createOpts)
+		})
+	if err != nil {
+		a.logger.Error("Failed to create issue discussion", "error", err, "project_id", project.ID, "issue_iid", issueIID)
+		return nil, gitlaberr.Classify(err, "failed to create issue discussion")
+	}
+
+	result := convertGitLabDiscussion(discussion)
+	a.logger.Info("Successfully created issue discussion", "discussion_id", result.ID, "issue_iid", issueIID)
+	return &result, nil
+}
+
+// AddIssueDiscussionNote replies to an existing discussion thread on an issue.
+func (a *App) AddIssueDiscussionNote(projectPath string, issueIID int64, discussionID string, body string) (*Note, error) {
+	if issueIID <= 0 {
+		return nil, ErrInvalidIssueIID
+	}
+	if discussionID == "" {
+		return nil, ErrDiscussionIDRequired
+	}
+	if body == "" {
+		return nil, ErrNoteBodyRequired
+	}
+
+	a.logger.Debug("Adding note to issue discussion",
+		"project_path", projectPath, "issue_iid", issueIID, "discussion_id", discussionID)
+
+	project, err := a.getProjectCached(projectPath)
+	if err != nil {
+		a.logger.Error("Failed to get project", "error", err, "project_path", projectPath)
+		return nil, err
+	}
+
+	addOpts := &gitlab.AddIssueDiscussionNoteOptions{Body: &body}
+
+	note, _, err := retryCall(a, context.Background(), "AddIssueDiscussionNote",
+		func() (*gitlab.Note, *gitlab.Response, error) {
+			return a.client.Discussions().AddIssueDiscussionNote(project.ID, int(issueIID), discussionID, addOpts)
+		})
+	if err != nil {
+		a.logger.Error("Failed to add issue discussion note",
+			"error", err, "project_id", project.ID, "issue_iid", issueIID, "discussion_id", discussionID)
+		return nil, gitlaberr.Classify(err, "failed to add issue discussion note")
+	}
+
+	result := convertGitLabNote(note)
+	a.logger.Info("Successfully added issue discussion note", "note_id", result.ID, "issue_iid", issueIID)
+	return &result, nil
+}
@@ -0,0 +1,217 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gitlab.com/gitlab-org/api/client-go"
+)
+
+// TestApp_GetMergeRequestDiscussion tests the App.GetMergeRequestDiscussion method.
+func TestApp_GetMergeRequestDiscussion(t *testing.T) {
+	mockClient := new(MockGitLabClient)
+	mockProjects := new(MockProjectsService)
+	mockDiscussions := new(MockDiscussionsService)
+
+	mockClient.On("Projects").Return(mockProjects)
+	mockClient.On("Discussions").Return(mockDiscussions)
+
+	mockProjects.On("GetProject", "test/project", (*gitlab.GetProjectOptions)(nil)).Return(
+		&gitlab.Project{ID: 7}, &gitlab.Response{}, nil,
+	)
+
+	mockDiscussions.On("GetMergeRequestDiscussion", int64(7), int64(5), "abc123").Return(
+		&gitlab.Discussion{ID: "abc123", Notes: []*gitlab.Note{{ID: 1, Body: "First note"}}},
+		&gitlab.Response{}, nil,
+	)
+
+	a := newTestAppForMergeRequest(mockClient)
+
+	got, err := a.GetMergeRequestDiscussion("test/project", 5, "abc123")
+
+	require.NoError(t, err)
+	require.NotNil(t, got)
+	assert.Equal(t, "abc123", got.ID)
+	require.Len(t, got.Notes, 1)
+	assert.Equal(t, "First note", got.Notes[0].Body)
+}
+
+// TestApp_GetMergeRequestDiscussion_MissingDiscussionID tests that GetMergeRequestDiscussion
+// rejects an empty discussion ID before calling the GitLab API.
+func TestApp_GetMergeRequestDiscussion_MissingDiscussionID(t *testing.T) {
+	a := newTestAppForMergeRequest(new(MockGitLabClient))
+
+	_, err := a.GetMergeRequestDiscussion("test/project", 5, "")
+
+	assert.ErrorIs(t, err, ErrDiscussionIDRequired)
+}
+
+// TestApp_CreateMergeRequestDiscussion tests the App.CreateMergeRequestDiscussion method.
+func TestApp_CreateMergeRequestDiscussion(t *testing.T) {
+	mockClient := new(MockGitLabClient)
+	mockProjects := new(MockProjectsService)
+	mockDiscussions := new(MockDiscussionsService)
+
+	mockClient.On("Projects").Return(mockProjects)
+	mockClient.On("Discussions").Return(mockDiscussions)
+
+	mockProjects.On("GetProject", "test/project", (*gitlab.GetProjectOptions)(nil)).Return(
+		&gitlab.Project{ID: 7}, &gitlab.Response{}, nil,
+	)
+
+	body := "Needs a test for this case"
+	mockDiscussions.On(
+		"CreateMergeRequestDiscussion",
+		int64(7),
+		int64(5),
+		&gitlab.CreateMergeRequestDiscussionOptions{Body: &body},
+	).Return(
+		&gitlab.Discussion{ID: "def456", Notes: []*gitlab.Note{{ID: 2, Body: body}}},
+		&gitlab.Response{}, nil,
+	)
+
+	a := newTestAppForMergeRequest(mockClient)
+
+	got, err := a.CreateMergeRequestDiscussion("test/project", 5, &CreateMergeRequestDiscussionOptions{Body: body})
+
+	require.NoError(t, err)
+	require.NotNil(t, got)
+	assert.Equal(t, "def456", got.ID)
+}
+
+// TestApp_ResolveMergeRequestDiscussion tests the App.ResolveMergeRequestDiscussion method.
+func TestApp_ResolveMergeRequestDiscussion(t *testing.T) {
+	mockClient := new(MockGitLabClient)
+	mockProjects := new(MockProjectsService)
+	mockDiscussions := new(MockDiscussionsService)
+
+	mockClient.On("Projects").Return(mockProjects)
+	mockClient.On("Discussions").Return(mockDiscussions)
+
+	mockProjects.On("GetProject", "test/project", (*gitlab.GetProjectOptions)(nil)).Return(
+		&gitlab.Project{ID: 7}, &gitlab.Response{}, nil,
+	)
+
+	resolved := true
+	mockDiscussions.On(
+		"ResolveMergeRequestDiscussion",
+		int64(7),
+		int64(5),
+		"abc123",
+		&gitlab.ResolveMergeRequestDiscussionOptions{Resolved: &resolved},
+	).Return(
+		&gitlab.Discussion{ID: "abc123"},
+		&gitlab.Response{}, nil,
+	)
+
+	a := newTestAppForMergeRequest(mockClient)
+
+	got, err := a.ResolveMergeRequestDiscussion("test/project", 5, "abc123", true)
+
+	require.NoError(t, err)
+	require.NotNil(t, got)
+	assert.Equal(t, "abc123", got.ID)
+}
+
+// TestApp_ResolveMergeRequestDiscussionsByAuthor tests that only discussions started by the
+// requested author are resolved.
+func TestApp_ResolveMergeRequestDiscussionsByAuthor(t *testing.T) {
+	mockClient := new(MockGitLabClient)
+	mockProjects := new(MockProjectsService)
+	mockDiscussions := new(MockDiscussionsService)
+
+	mockClient.On("Projects").Return(mockProjects)
+	mockClient.On("Discussions").Return(mockDiscussions)
+
+	mockProjects.On("GetProject", "test/project", (*gitlab.GetProjectOptions)(nil)).Return(
+		&gitlab.Project{ID: 7}, &gitlab.Response{}, nil,
+	)
+
+	mockDiscussions.On("ListMergeRequestDiscussions", int64(7), int64(5), (*gitlab.ListMergeRequestDiscussionsOptions)(nil)).Return(
+		[]*gitlab.Discussion{
+			{ID: "thread-1", Notes: []*gitlab.Note{{ID: 1, Author: gitlab.NoteAuthor{Username: "reviewer"}}}},
+			{ID: "thread-2", Notes: []*gitlab.Note{{ID: 2, Author: gitlab.NoteAuthor{Username: "someone-else"}}}},
+		},
+		&gitlab.Response{}, nil,
+	)
+
+	resolved := true
+	mockDiscussions.On(
+		"ResolveMergeRequestDiscussion",
+		int64(7), int64(5), "thread-1",
+		&gitlab.ResolveMergeRequestDiscussionOptions{Resolved: &resolved},
+	).Return(&gitlab.Discussion{ID: "thread-1"}, &gitlab.Response{}, nil)
+
+	a := newTestAppForMergeRequest(mockClient)
+
+	count, err := a.ResolveMergeRequestDiscussionsByAuthor("test/project", 5, "reviewer")
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+	mockDiscussions.AssertNotCalled(t, "ResolveMergeRequestDiscussion", int64(7), int64(5), "thread-2",
+		&gitlab.ResolveMergeRequestDiscussionOptions{Resolved: &resolved})
+}
+
+// TestApp_ResolveMergeRequestDiscussionsByAuthor_MissingUsername tests that the convenience
+// method rejects an empty username before calling the GitLab API.
+func TestApp_ResolveMergeRequestDiscussionsByAuthor_MissingUsername(t *testing.T) {
+	a := newTestAppForMergeRequest(new(MockGitLabClient))
+
+	_, err := a.ResolveMergeRequestDiscussionsByAuthor("test/project", 5, "")
+
+	assert.ErrorIs(t, err, ErrUsernameRequired)
+}
+
+// TestApp_ListIssueDiscussions tests the App.ListIssueDiscussions method.
+func TestApp_ListIssueDiscussions(t *testing.T) {
+	mockClient := new(MockGitLabClient)
+	mockProjects := new(MockProjectsService)
+	mockDiscussions := new(MockDiscussionsService)
+
+	mockClient.On("Projects").Return(mockProjects)
+	mockClient.On("Discussions").Return(mockDiscussions)
+
+	mockProjects.On("GetProject", "test/project", (*gitlab.GetProjectOptions)(nil)).Return(
+		&gitlab.Project{ID: 7}, &gitlab.Response{}, nil,
+	)
+
+	mockDiscussions.On("ListIssueDiscussions", int64(7), 5, (*gitlab.ListIssueDiscussionsOptions)(nil)).Return(
+		[]*gitlab.Discussion{{ID: "thread-1"}}, &gitlab.Response{}, nil,
+	)
+
+	a := newTestAppForMergeRequest(mockClient)
+
+	got, err := a.ListIssueDiscussions("test/project", 5)
+
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+	assert.Equal(t, "thread-1", got[0].ID)
+}
+
+// TestApp_AddIssueDiscussionNote tests the App.AddIssueDiscussionNote method.
+func TestApp_AddIssueDiscussionNote(t *testing.T) {
+	mockClient := new(MockGitLabClient)
+	mockProjects := new(MockProjectsService)
+	mockDiscussions := new(MockDiscussionsService)
+
+	mockClient.On("Projects").Return(mockProjects)
+	mockClient.On("Discussions").Return(mockDiscussions)
+
+	mockProjects.On("GetProject", "test/project", (*gitlab.GetProjectOptions)(nil)).Return(
+		&gitlab.Project{ID: 7}, &gitlab.Response{}, nil,
+	)
+
+	body := "Following up"
+	mockDiscussions.On(
+		"AddIssueDiscussionNote", int64(7), 5, "thread-1", &gitlab.AddIssueDiscussionNoteOptions{Body: &body},
+	).Return(&gitlab.Note{ID: 3, Body: body}, &gitlab.Response{}, nil)
+
+	a := newTestAppForMergeRequest(mockClient)
+
+	got, err := a.AddIssueDiscussionNote("test/project", 5, "thread-1", body)
+
+	require.NoError(t, err)
+	require.NotNil(t, got)
+	assert.Equal(t, body, got.Body)
+}
@@ -0,0 +1,565 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/sgaunet/gitlab-mcp/internal/gitlaberr"
+	"gitlab.com/gitlab-org/api/client-go"
+)
+
+// maxEpicsPerPage is the GitLab API page size cap for epic listings.
+const maxEpicsPerPage = 100
+
+// Error variables for epic-related static errors.
+var (
+	ErrGroupPathRequired   = errors.New("group path is required")
+	ErrEpicIIDRequired     = errors.New("epic IID must be a positive integer")
+	ErrProjectPathRequired = errors.New("project path is required")
+	ErrIssueNotFound       = errors.New("issue not found")
+	ErrEpicTitleRequired   = errors.New("epic title is required")
+	ErrEpicsTierRequired   = errors.New("epics require a GitLab Premium or Ultimate subscription tier")
+)
+
+// ListEpicsOptions contains options for listing group epics.
+type ListEpicsOptions struct {
+	State string
+	Limit int64
+}
+
+// CreateEpicOptions contains options for creating a group epic.
+type CreateEpicOptions struct {
+	Title       string
+	Description string
+	Labels      []string
+	StartDate   string
+	DueDate     string
+	ParentID    int64
+}
+
+// UpdateEpicOptions contains options for updating a group epic.
+type UpdateEpicOptions struct {
+	Title       string
+	Description string
+	State       string
+	Labels      []string
+	StartDate   string
+	DueDate     string
+}
+
+// AddIssueToEpicOptions contains options for assigning an existing issue to a group epic.
+type AddIssueToEpicOptions struct {
+	GroupPath   string
+	EpicIID     int64
+	ProjectPath string
+	IssueIID    int64
+}
+
+// Epic represents a GitLab epic.
+type Epic struct {
+	ID          int64          `json:"id"`
+	IID         int64          `json:"iid"`
+	GroupID     int64          `json:"group_id"`
+	ParentID    int64          `json:"parent_id,omitempty"`
+	Title       string         `json:"title"`
+	Description string         `json:"description"`
+	State       string         `json:"state"`
+	Labels      []string       `json:"labels"`
+	Author      map[string]any `json:"author,omitempty"`
+	WebURL      string         `json:"web_url"`
+	StartDate   string         `json:"start_date,omitempty"`
+	DueDate     string         `json:"due_date,omitempty"`
+	CreatedAt   string         `json:"created_at"`
+	UpdatedAt   string         `json:"updated_at"`
+}
+
+// EpicIssueAssignment represents an issue assigned to an epic.
+type EpicIssueAssignment struct {
+	ID          int64          `json:"id"`
+	IID         int64          `json:"iid"`
+	EpicID      int64          `json:"epic_id"`
+	EpicIID     int64          `json:"epic_iid"`
+	Title       string         `json:"title"`
+	Description string         `json:"description"`
+	State       string         `json:"state"`
+	WebURL      string         `json:"web_url"`
+	Labels      []string       `json:"labels"`
+	Author      map[string]any `json:"author,omitempty"`
+	Iteration   *Iteration     `json:"iteration,omitempty"`
+}
+
+// EpicNode represents a node in an epic hierarchy tree, including its linked issues and child epics.
+type EpicNode struct {
+	Epic     Epic                  `json:"epic"`
+	Issues   []EpicIssueAssignment `json:"issues"`
+	Children []EpicNode            `json:"children"`
+}
+
+// convertGitLabEpic converts a GitLab epic to our Epic struct.
+func convertGitLabEpic(epic *gitlab.Epic) Epic {
+	result := Epic{
+		ID:          epic.ID,
+		IID:         epic.IID,
+		GroupID:     epic.GroupID,
+		ParentID:    epic.ParentID,
+		Title:       epic.Title,
+		Description: epic.Description,
+		State:       epic.State,
+		Labels:      epic.Labels,
+		WebURL:      epic.WebURL,
+	}
+
+	if epic.Author != nil {
+		result.Author = map[string]any{
+			"id":       epic.Author.ID,
+			"username": epic.Author.Username,
+			"name":     epic.Author.Name,
+		}
+	}
+
+	if epic.StartDate != nil {
+		result.StartDate = epic.StartDate.String()
+	}
+	if epic.DueDate != nil {
+		result.DueDate = epic.DueDate.String()
+	}
+	if epic.CreatedAt != nil {
+		result.CreatedAt = epic.CreatedAt.Format("2006-01-02T15:04:05Z")
+	}
+	if epic.UpdatedAt != nil {
+		result.UpdatedAt = epic.UpdatedAt.Format("2006-01-02T15:04:05Z")
+	}
+
+	return result
+}
+
+// convertGitLabEpicIssueAssignment converts a GitLab epic-issue assignment to our EpicIssueAssignment struct.
+func convertGitLabEpicIssueAssignment(assignment *gitlab.EpicIssueAssignment) EpicIssueAssignment {
+	result := EpicIssueAssignment{
+		Title:       assignment.Issue.Title,
+		Description: assignment.Issue.Description,
+		State:       assignment.Issue.State,
+		WebURL:      assignment.Issue.WebURL,
+		Labels:      assignment.Issue.Labels,
+	}
+
+	if assignment.Issue != nil {
+		result.ID = assignment.Issue.ID
+		result.IID = assignment.Issue.IID
+
+		if assignment.Issue.Author != nil {
+			result.Author = map[string]any{
+				"id":       assignment.Issue.Author.ID,
+				"username": assignment.Issue.Author.Username,
+				"name":     assignment.Issue.Author.Name,
+			}
+		}
+
+		if assignment.Issue.Iteration != nil {
+			iteration := convertGitLabIteration(assignment.Issue.Iteration)
+			result.Iteration = &iteration
+		}
+	}
+
+	if assignment.Epic != nil {
+		result.EpicID = assignment.Epic.ID
+		result.EpicIID = assignment.Epic.IID
+	}
+
+	return result
+}
+
+// wrapEpicsError maps GitLab tier-restriction errors (epics are a Premium/Ultimate feature) to
+// ErrEpicsTierRequired, then falls back to gitlaberr.Classify so callers can use errors.Is
+// against typed errors like gitlaberr.ErrEpicNotFound for every other error.
+func wrapEpicsError(err error, msg string) error {
+	if strings.Contains(err.Error(), "403") {
+		return fmt.Errorf("%w: %s", ErrEpicsTierRequired, err)
+	}
+	return gitlaberr.Classify(err, msg)
+}
+
+// setDefaultEpicOptions sets default values for list epics options.
+func (a *App) setDefaultEpicOptions(opts *ListEpicsOptions) *ListEpicsOptions {
+	if opts == nil {
+		opts = &ListEpicsOptions{}
+	}
+	if opts.State == "" {
+		opts.State = defaultStateOpened
+	}
+	if opts.Limit == 0 {
+		opts.Limit = maxEpicsPerPage
+	}
+	if opts.Limit > maxEpicsPerPage {
+		opts.Limit = maxEpicsPerPage
+	}
+	return opts
+}
+
+// validateAddIssueToEpicOptions validates the required fields for assigning an issue to an epic.
+func (a *App) validateAddIssueToEpicOptions(opts *AddIssueToEpicOptions) error {
+	if opts == nil {
+		return ErrCreateOptionsRequired
+	}
+	if opts.GroupPath == "" {
+		return ErrGroupPathRequired
+	}
+	if opts.EpicIID <= 0 {
+		return ErrEpicIIDRequired
+	}
+	if opts.ProjectPath == "" {
+		return ErrProjectPathRequired
+	}
+	if opts.IssueIID <= 0 {
+		return ErrInvalidIssueIID
+	}
+	return nil
+}
+
+// ListGroupEpics retrieves epics for a given group path.
+func (a *App) ListGroupEpics(groupPath string, opts *ListEpicsOptions) ([]Epic, error) {
+	a.logger.Debug("Listing epics for group", "group_path", groupPath, "options", opts)
+
+	group, err := a.getGroupCached(groupPath)
+	if err != nil {
+		a.logger.Error("Failed to get group", "error", err, "group_path", groupPath)
+		return nil, err
+	}
+
+	opts = a.setDefaultEpicOptions(opts)
+
+	listOpts := &gitlab.ListGroupEpicsOptions{
+		State:       &opts.State,
+		ListOptions: gitlab.ListOptions{PerPage: opts.Limit, Page: 1},
+	}
+
+	epics, err := a.getGroupEpicsCached(group.ID, listOpts)
+	if err != nil {
+		a.logger.Error("Failed to list group epics", "error", err, "group_id", group.ID)
+		return nil, wrapEpicsError(err, "failed to list group epics")
+	}
+
+	a.logger.Debug("Retrieved epics", "count", len(epics), "group_id", group.ID)
+
+	result := make([]Epic, 0, len(epics))
+	for _, epic := range epics {
+		result = append(result, convertGitLabEpic(epic))
+	}
+
+	a.logger.Info("Successfully retrieved group epics", "count", len(result), "group_id", group.ID)
+	return result, nil
+}
+
+// GetEpic retrieves a single epic by group path and epic IID.
+func (a *App) GetEpic(groupPath string, epicIID int64) (*Epic, error) {
+	if epicIID <= 0 {
+		return nil, ErrEpicIIDRequired
+	}
+
+	a.logger.Debug("Getting epic", "group_path", groupPath, "epic_iid", epicIID)
+
+	group, err := a.getGroupCached(groupPath)
+	if err != nil {
+		a.logger.Error("Failed to get group", "error", err, "group_path", groupPath)
+		return nil, err
+	}
+
+	epic, _, err := a.client.Epics().GetEpic(group.ID, epicIID)
+	if err != nil {
+		a.logger.Error("Failed to get epic", "error", err, "group_id", group.ID, "epic_iid", epicIID)
+		return nil, wrapEpicsError(err, "failed to get epic")
+	}
+
+	result := convertGitLabEpic(epic)
+	a.logger.Info("Successfully retrieved epic", "epic_id", result.ID, "group_id", group.ID)
+	return &result, nil
+}
+
+// CreateEpic creates a new epic in a group.
+func (a *App) CreateEpic(groupPath string, opts *CreateEpicOptions) (*Epic, error) {
+	if opts == nil {
+		return nil, ErrCreateOptionsRequired
+	}
+	if opts.Title == "" {
+		return nil, ErrEpicTitleRequired
+	}
+
+	a.logger.Debug("Creating epic", "group_path", groupPath, "title", opts.Title)
+
+	group, err := a.getGroupCached(groupPath)
+	if err != nil {
+		a.logger.Error("Failed to get group", "error", err, "group_path", groupPath)
+		return nil, err
+	}
+
+	createOpts, err := buildCreateEpicOptions(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	epic, _, err := a.client.Epics().CreateEpic(group.ID, createOpts)
+	if err != nil {
+		a.logger.Error("Failed to create epic", "error", err, "group_id", group.ID, "title", opts.Title)
+		return nil, wrapEpicsError(err, "failed to create epic")
+	}
+
+	result := convertGitLabEpic(epic)
+	a.logger.Info("Successfully created epic", "epic_id", result.ID, "group_id", group.ID, "title", result.Title)
+	return &result, nil
+}
+
+// buildCreateEpicOptions builds the GitLab API options for creating an epic.
+func buildCreateEpicOptions(opts *CreateEpicOptions) (*gitlab.CreateEpicOptions, error) {
+	createOpts := &gitlab.CreateEpicOptions{
+		Title: &opts.Title,
+	}
+
+	if opts.Description != "" {
+		createOpts.Description = &opts.Description
+	}
+
+	if len(opts.Labels) > 0 {
+		labels := gitlab.LabelOptions(opts.Labels)
+		createOpts.Labels = &labels
+	}
+
+	if opts.StartDate != "" {
+		startDate, err := gitlab.ParseISOTime(opts.StartDate)
+		if err != nil {
+			return nil, fmt.Errorf("invalid start date: %w", err)
+		}
+		createOpts.StartDate = &startDate
+	}
+
+	if opts.DueDate != "" {
+		dueDate, err := gitlab.ParseISOTime(opts.DueDate)
+		if err != nil {
+			return nil, fmt.Errorf("invalid due date: %w", err)
+		}
+		createOpts.DueDate = &dueDate
+	}
+
+	if opts.ParentID > 0 {
+		createOpts.ParentID = &opts.ParentID
+	}
+
+	return createOpts, nil
+}
+
+// UpdateEpic updates an existing epic.
+func (a *App) UpdateEpic(groupPath string, epicIID int64, opts *UpdateEpicOptions) (*Epic, error) {
+	if epicIID <= 0 {
+		return nil, ErrEpicIIDRequired
+	}
+	if opts == nil {
+		return nil, ErrUpdateOptionsRequired
+	}
+
+	a.logger.Debug("Updating epic", "group_path", groupPath, "epic_iid", epicIID)
+
+	group, err := a.getGroupCached(groupPath)
+	if err != nil {
+		a.logger.Error("Failed to get group", "error", err, "group_path", groupPath)
+		return nil, err
+	}
+
+	updateOpts, err := buildUpdateEpicOptions(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	epic, _, err := a.client.Epics().UpdateEpic(group.ID, epicIID, updateOpts)
+	if err != nil {
+		a.logger.Error("Failed to update epic", "error", err, "group_id", group.ID, "epic_iid", epicIID)
+		return nil, wrapEpicsError(err, "failed to update epic")
+	}
+
+	result := convertGitLabEpic(epic)
+	a.logger.Info("Successfully updated epic", "epic_id", result.ID, "group_id", group.ID)
+	return &result, nil
+}
+
+// buildUpdateEpicOptions builds the GitLab API options for updating an epic.
+func buildUpdateEpicOptions(opts *UpdateEpicOptions) (*gitlab.UpdateEpicOptions, error) {
+	updateOpts := &gitlab.UpdateEpicOptions{}
+
+	if opts.Title != "" {
+		updateOpts.Title = &opts.Title
+	}
+	if opts.Description != "" {
+		updateOpts.Description = &opts.Description
+	}
+	if opts.State != "" {
+		updateOpts.StateEvent = &opts.State
+	}
+	if len(opts.Labels) > 0 {
+		labels := gitlab.LabelOptions(opts.Labels)
+		updateOpts.Labels = &labels
+	}
+
+	if opts.StartDate != "" {
+		startDate, err := gitlab.ParseISOTime(opts.StartDate)
+		if err != nil {
+			return nil, fmt.Errorf("invalid start date: %w", err)
+		}
+		updateOpts.StartDate = &startDate
+	}
+
+	if opts.DueDate != "" {
+		dueDate, err := gitlab.ParseISOTime(opts.DueDate)
+		if err != nil {
+			return nil, fmt.Errorf("invalid due date: %w", err)
+		}
+		updateOpts.DueDate = &dueDate
+	}
+
+	return updateOpts, nil
+}
+
+// DeleteEpic deletes an epic from a group.
+func (a *App) DeleteEpic(groupPath string, epicIID int64) error {
+	if epicIID <= 0 {
+		return ErrEpicIIDRequired
+	}
+
+	a.logger.Debug("Deleting epic", "group_path", groupPath, "epic_iid", epicIID)
+
+	group, err := a.getGroupCached(groupPath)
+	if err != nil {
+		a.logger.Error("Failed to get group", "error", err, "group_path", groupPath)
+		return err
+	}
+
+	if _, err := a.client.Epics().DeleteEpic(group.ID, epicIID); err != nil {
+		a.logger.Error("Failed to delete epic", "error", err, "group_id", group.ID, "epic_iid", epicIID)
+		return wrapEpicsError(err, "failed to delete epic")
+	}
+
+	a.logger.Info("Successfully deleted epic", "group_id", group.ID, "epic_iid", epicIID)
+	return nil
+}
+
+// ListChildEpics retrieves the direct child epics of a given epic.
+func (a *App) ListChildEpics(groupPath string, epicIID int64) ([]Epic, error) {
+	epics, err := a.ListGroupEpics(groupPath, &ListEpicsOptions{State: "all", Limit: maxEpicsPerPage})
+	if err != nil {
+		return nil, err
+	}
+
+	children := make([]Epic, 0)
+	for _, epic := range epics {
+		if epic.ParentID == epicIID {
+			children = append(children, epic)
+		}
+	}
+
+	return children, nil
+}
+
+// AddIssueToEpic assigns an existing issue to a group epic.
+func (a *App) AddIssueToEpic(opts *AddIssueToEpicOptions) (*EpicIssueAssignment, error) {
+	if err := a.validateAddIssueToEpicOptions(opts); err != nil {
+		return nil, err
+	}
+
+	a.logger.Debug("Adding issue to epic",
+		"group_path", opts.GroupPath, "epic_iid", opts.EpicIID,
+		"project_path", opts.ProjectPath, "issue_iid", opts.IssueIID)
+
+	group, err := a.getGroupCached(opts.GroupPath)
+	if err != nil {
+		a.logger.Error("Failed to get group", "error", err, "group_path", opts.GroupPath)
+		return nil, err
+	}
+
+	project, err := a.getProjectCached(opts.ProjectPath)
+	if err != nil {
+		a.logger.Error("Failed to get project", "error", err, "project_path", opts.ProjectPath)
+		return nil, err
+	}
+
+	issue, err := a.getIssueCached(project.ID, int(opts.IssueIID))
+	if err != nil {
+		a.logger.Error("Failed to get issue", "error", err, "project_id", project.ID, "issue_iid", opts.IssueIID)
+		return nil, err
+	}
+	if issue.ID == 0 {
+		return nil, ErrIssueNotFound
+	}
+
+	assignment, _, err := retryCall(a, context.Background(), "AssignEpicIssue",
+		func() (*gitlab.EpicIssueAssignment, *gitlab.Response, error) {
+			return a.client.EpicIssues().AssignEpicIssue(group.ID, opts.EpicIID, issue.ID)
+		})
+	if err != nil {
+		a.logger.Error("Failed to assign issue to epic",
+			"error", err, "group_id", group.ID, "epic_iid", opts.EpicIID)
+		return nil, wrapEpicsError(err, "failed to assign issue to epic")
+	}
+
+	result := convertGitLabEpicIssueAssignment(assignment)
+	a.logger.Info("Successfully added issue to epic",
+		"issue_id", result.ID, "epic_id", result.EpicID, "group_id", group.ID)
+	return &result, nil
+}
+
+// listEpicIssues retrieves the issues linked to a given epic.
+func (a *App) listEpicIssues(groupPath string, epicIID int64) ([]EpicIssueAssignment, error) {
+	group, err := a.getGroupCached(groupPath)
+	if err != nil {
+		return nil, err
+	}
+
+	assignments, _, err := a.client.EpicIssues().ListEpicIssues(group.ID, epicIID)
+	if err != nil {
+		return nil, wrapEpicsError(err, "failed to list epic issues")
+	}
+
+	result := make([]EpicIssueAssignment, 0, len(assignments))
+	for _, assignment := range assignments {
+		result = append(result, convertGitLabEpicIssueAssignment(assignment))
+	}
+	return result, nil
+}
+
+// GetEpicTree recursively walks an epic's parent/child hierarchy and returns it as a nested tree,
+// including the issues linked to each epic, so a client can render a full roadmap in one call.
+func (a *App) GetEpicTree(groupPath string, epicIID int64) (*EpicNode, error) {
+	epic, err := a.GetEpic(groupPath, epicIID)
+	if err != nil {
+		return nil, err
+	}
+
+	return a.buildEpicNode(groupPath, *epic)
+}
+
+// buildEpicNode recursively assembles an EpicNode for the given epic and its descendants.
+func (a *App) buildEpicNode(groupPath string, epic Epic) (*EpicNode, error) {
+	issues, err := a.listEpicIssues(groupPath, epic.IID)
+	if err != nil {
+		return nil, err
+	}
+
+	childEpics, err := a.ListChildEpics(groupPath, epic.IID)
+	if err != nil {
+		return nil, err
+	}
+
+	node := &EpicNode{
+		Epic:     epic,
+		Issues:   issues,
+		Children: make([]EpicNode, 0, len(childEpics)),
+	}
+
+	for _, child := range childEpics {
+		childNode, err := a.buildEpicNode(groupPath, child)
+		if err != nil {
+			return nil, err
+		}
+		node.Children = append(node.Children, *childNode)
+	}
+
+	return node, nil
+}
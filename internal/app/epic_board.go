@@ -0,0 +1,135 @@
+package app
+
+import (
+	"errors"
+
+	"gitlab.com/gitlab-org/api/client-go"
+)
+
+// ErrEpicBoardIDRequired is returned when a group epic board is requested without a board ID.
+var ErrEpicBoardIDRequired = errors.New("epic board ID must be a positive integer")
+
+// GroupEpicBoardList represents a single list (column) on a group epic board, along with the
+// epics currently assigned to it.
+type GroupEpicBoardList struct {
+	ID       int64  `json:"id"`
+	Label    string `json:"label"`
+	Position int64  `json:"position"`
+	ListType string `json:"list_type"`
+	Epics    []Epic `json:"epics"`
+}
+
+// GroupEpicBoard represents a GitLab group epic board.
+type GroupEpicBoard struct {
+	ID    int64                `json:"id"`
+	Name  string               `json:"name"`
+	Lists []GroupEpicBoardList `json:"lists"`
+}
+
+// convertGitLabGroupEpicBoard converts a GitLab group epic board to our GroupEpicBoard struct,
+// bucketing the group's epics into each list by matching label.
+func convertGitLabGroupEpicBoard(board *gitlab.GroupEpicBoard, groupEpics []Epic) GroupEpicBoard {
+	result := GroupEpicBoard{
+		ID:    board.ID,
+		Name:  board.Name,
+		Lists: make([]GroupEpicBoardList, 0, len(board.Lists)),
+	}
+
+	for _, list := range board.Lists {
+		result.Lists = append(result.Lists, convertGitLabGroupEpicBoardList(list, groupEpics))
+	}
+
+	return result
+}
+
+// convertGitLabGroupEpicBoardList converts a GitLab group epic board list and populates it with
+// the epics from groupEpics that carry the list's label.
+func convertGitLabGroupEpicBoardList(list *gitlab.BoardList, groupEpics []Epic) GroupEpicBoardList {
+	result := GroupEpicBoardList{
+		ID:       list.ID,
+		Position: list.Position,
+		ListType: list.ListType,
+		Epics:    make([]Epic, 0),
+	}
+
+	if list.Label != nil {
+		result.Label = list.Label.Name
+		for _, epic := range groupEpics {
+			if containsLabel(epic.Labels, result.Label) {
+				result.Epics = append(result.Epics, epic)
+			}
+		}
+	}
+
+	return result
+}
+
+// containsLabel reports whether labels contains label.
+func containsLabel(labels []string, label string) bool {
+	for _, l := range labels {
+		if l == label {
+			return true
+		}
+	}
+	return false
+}
+
+// ListGroupEpicBoards retrieves the epic boards configured for a group.
+func (a *App) ListGroupEpicBoards(groupPath string) ([]GroupEpicBoard, error) {
+	a.logger.Debug("Listing group epic boards", "group_path", groupPath)
+
+	group, err := a.getGroupCached(groupPath)
+	if err != nil {
+		a.logger.Error("Failed to get group", "error", err, "group_path", groupPath)
+		return nil, err
+	}
+
+	boards, _, err := a.client.EpicBoards().ListGroupEpicBoards(group.ID, nil)
+	if err != nil {
+		a.logger.Error("Failed to list group epic boards", "error", err, "group_id", group.ID)
+		return nil, wrapEpicsError(err, "failed to list group epic boards")
+	}
+
+	groupEpics, err := a.ListGroupEpics(groupPath, &ListEpicsOptions{State: "all", Limit: maxEpicsPerPage})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]GroupEpicBoard, 0, len(boards))
+	for _, board := range boards {
+		result = append(result, convertGitLabGroupEpicBoard(board, groupEpics))
+	}
+
+	a.logger.Info("Successfully retrieved group epic boards", "count", len(result), "group_id", group.ID)
+	return result, nil
+}
+
+// GetGroupEpicBoard retrieves a single epic board, with its list columns and per-list epics.
+func (a *App) GetGroupEpicBoard(groupPath string, boardID int64) (*GroupEpicBoard, error) {
+	if boardID <= 0 {
+		return nil, ErrEpicBoardIDRequired
+	}
+
+	a.logger.Debug("Getting group epic board", "group_path", groupPath, "board_id", boardID)
+
+	group, err := a.getGroupCached(groupPath)
+	if err != nil {
+		a.logger.Error("Failed to get group", "error", err, "group_path", groupPath)
+		return nil, err
+	}
+
+	board, _, err := a.client.EpicBoards().GetGroupEpicBoard(group.ID, boardID)
+	if err != nil {
+		a.logger.Error("Failed to get group epic board", "error", err, "group_id", group.ID, "board_id", boardID)
+		return nil, wrapEpicsError(err, "failed to get group epic board")
+	}
+
+	groupEpics, err := a.ListGroupEpics(groupPath, &ListEpicsOptions{State: "all", Limit: maxEpicsPerPage})
+	if err != nil {
+		return nil, err
+	}
+
+	result := convertGitLabGroupEpicBoard(board, groupEpics)
+	a.logger.Info("Successfully retrieved group epic board", "board_id", result.ID, "group_id", group.ID)
+	return &result, nil
+}
@@ -0,0 +1,134 @@
+package app
+
+import (
+	"log/slog"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gitlab.com/gitlab-org/api/client-go"
+)
+
+// TestConvertGitLabGroupEpicBoard tests the convertGitLabGroupEpicBoard function.
+func TestConvertGitLabGroupEpicBoard(t *testing.T) {
+	groupEpics := []Epic{
+		{ID: 1, IID: 10, Title: "Launch epic", Labels: []string{"todo"}},
+		{ID: 2, IID: 20, Title: "Done epic", Labels: []string{"done"}},
+		{ID: 3, IID: 30, Title: "Unlabeled epic"},
+	}
+
+	board := &gitlab.GroupEpicBoard{
+		ID:   100,
+		Name: "Roadmap",
+		Lists: []*gitlab.BoardList{
+			{
+				ID:       1,
+				Position: 0,
+				ListType: "label",
+				Label:    &gitlab.Label{Name: "todo"},
+			},
+			{
+				ID:       2,
+				Position: 1,
+				ListType: "label",
+				Label:    &gitlab.Label{Name: "done"},
+			},
+		},
+	}
+
+	want := GroupEpicBoard{
+		ID:   100,
+		Name: "Roadmap",
+		Lists: []GroupEpicBoardList{
+			{
+				ID:       1,
+				Label:    "todo",
+				Position: 0,
+				ListType: "label",
+				Epics:    []Epic{groupEpics[0]},
+			},
+			{
+				ID:       2,
+				Label:    "done",
+				Position: 1,
+				ListType: "label",
+				Epics:    []Epic{groupEpics[1]},
+			},
+		},
+	}
+
+	got := convertGitLabGroupEpicBoard(board, groupEpics)
+	assert.Equal(t, want, got)
+}
+
+// TestApp_GetGroupEpicBoard tests the App.GetGroupEpicBoard method.
+func TestApp_GetGroupEpicBoard(t *testing.T) {
+	tests := []struct {
+		name      string
+		groupPath string
+		boardID   int64
+		setup     func(*MockGitLabClient, *MockGroupsService, *MockEpicsService, *MockEpicBoardsService)
+		wantErr   bool
+		errType   error
+	}{
+		{
+			name:      "invalid board id",
+			groupPath: "test/group",
+			boardID:   0,
+			setup:     func(_ *MockGitLabClient, _ *MockGroupsService, _ *MockEpicsService, _ *MockEpicBoardsService) {},
+			wantErr:   true,
+			errType:   ErrEpicBoardIDRequired,
+		},
+		{
+			name:      "success",
+			groupPath: "test/group",
+			boardID:   100,
+			setup: func(client *MockGitLabClient, groups *MockGroupsService, epics *MockEpicsService, boards *MockEpicBoardsService) {
+				client.On("Groups").Return(groups)
+				client.On("EpicBoards").Return(boards)
+				client.On("Epics").Return(epics)
+
+				groups.On("GetGroup", "test/group", (*gitlab.GetGroupOptions)(nil)).Return(
+					&gitlab.Group{ID: 456}, &gitlab.Response{}, nil,
+				)
+
+				boards.On("GetGroupEpicBoard", int64(456), int64(100)).Return(
+					&gitlab.GroupEpicBoard{ID: 100, Name: "Roadmap"}, &gitlab.Response{}, nil,
+				)
+
+				epics.On("ListGroupEpics", int64(456), &gitlab.ListGroupEpicsOptions{
+					State:       gitlab.Ptr("all"),
+					ListOptions: gitlab.ListOptions{PerPage: maxEpicsPerPage, Page: 1},
+				}).Return([]*gitlab.Epic{}, &gitlab.Response{}, nil)
+			},
+			wantErr: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := new(MockGitLabClient)
+			mockGroups := new(MockGroupsService)
+			mockEpics := new(MockEpicsService)
+			mockBoards := new(MockEpicBoardsService)
+			tt.setup(mockClient, mockGroups, mockEpics, mockBoards)
+
+			app := NewWithClient("token", "https://gitlab.com/", mockClient)
+			app.SetLogger(slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelError})))
+
+			got, err := app.GetGroupEpicBoard(tt.groupPath, tt.boardID)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				if tt.errType != nil {
+					assert.ErrorIs(t, err, tt.errType)
+				}
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.NotNil(t, got)
+			assert.Equal(t, int64(100), got.ID)
+		})
+	}
+}
@@ -0,0 +1,161 @@
+package app
+
+import (
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// defaultBulkAssignConcurrency is the default number of workers used by AddIssuesToEpic.
+	defaultBulkAssignConcurrency = 4
+	// maxBulkAssignRetries is the number of retry attempts for a transient per-item failure.
+	maxBulkAssignRetries = 3
+	// bulkAssignBaseBackoff is the base delay for exponential backoff between retries.
+	bulkAssignBaseBackoff = 200 * time.Millisecond
+)
+
+// BulkIssueRef identifies a single issue to be bulk-assigned to an epic.
+type BulkIssueRef struct {
+	ProjectPath string
+	IssueIID    int64
+}
+
+// BulkAddIssuesToEpicOptions contains options for bulk-assigning issues to a single epic.
+type BulkAddIssuesToEpicOptions struct {
+	GroupPath   string
+	EpicIID     int64
+	Issues      []BulkIssueRef
+	Concurrency int
+}
+
+// BulkAssignFailure records an issue that could not be assigned to an epic, along with the error.
+type BulkAssignFailure struct {
+	Input BulkIssueRef
+	Err   error
+}
+
+// BulkAssignResult contains the outcome of a bulk issue-to-epic assignment.
+type BulkAssignResult struct {
+	Successes []EpicIssueAssignment
+	Failures  []BulkAssignFailure
+}
+
+// bulkAssignOutcome is the result of assigning a single issue to an epic.
+type bulkAssignOutcome struct {
+	input      BulkIssueRef
+	assignment *EpicIssueAssignment
+	err        error
+}
+
+// AddIssuesToEpic assigns many issues to a single epic concurrently, using a bounded worker
+// pool (Concurrency workers, default 4) and per-item retry with exponential backoff on
+// transient (429, 500, 502, 503, 504) errors. Unlike AddIssueToEpic, a failure on one item does not abort the
+// others - every outcome is reported in the returned BulkAssignResult.
+func (a *App) AddIssuesToEpic(opts *BulkAddIssuesToEpicOptions) (*BulkAssignResult, error) {
+	if opts == nil {
+		return nil, ErrCreateOptionsRequired
+	}
+	if opts.GroupPath == "" {
+		return nil, ErrGroupPathRequired
+	}
+	if opts.EpicIID <= 0 {
+		return nil, ErrEpicIIDRequired
+	}
+
+	concurrency := opts.Concurrency
+	if concurrency <= 0 {
+		concurrency = defaultBulkAssignConcurrency
+	}
+
+	a.logger.Debug("Bulk assigning issues to epic",
+		"group_path", opts.GroupPath, "epic_iid", opts.EpicIID,
+		"count", len(opts.Issues), "concurrency", concurrency)
+
+	jobs := make(chan BulkIssueRef)
+	outcomes := make(chan bulkAssignOutcome, len(opts.Issues))
+
+	var wg sync.WaitGroup
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for job := range jobs {
+				outcomes <- a.assignIssueToEpicWithRetry(opts.GroupPath, opts.EpicIID, job)
+			}
+		}()
+	}
+
+	go func() {
+		for _, issue := range opts.Issues {
+			jobs <- issue
+		}
+		close(jobs)
+	}()
+
+	go func() {
+		wg.Wait()
+		close(outcomes)
+	}()
+
+	result := &BulkAssignResult{
+		Successes: make([]EpicIssueAssignment, 0, len(opts.Issues)),
+		Failures:  make([]BulkAssignFailure, 0),
+	}
+	for outcome := range outcomes {
+		if outcome.err != nil {
+			result.Failures = append(result.Failures, BulkAssignFailure{Input: outcome.input, Err: outcome.err})
+			continue
+		}
+		result.Successes = append(result.Successes, *outcome.assignment)
+	}
+
+	a.logger.Info("Bulk assign to epic complete",
+		"group_path", opts.GroupPath, "epic_iid", opts.EpicIID,
+		"succeeded", len(result.Successes), "failed", len(result.Failures))
+
+	return result, nil
+}
+
+// assignIssueToEpicWithRetry assigns a single issue to an epic, retrying transient errors with
+// exponential backoff. Validation of the per-item options is handled by AddIssueToEpic via
+// validateAddIssueToEpicOptions.
+func (a *App) assignIssueToEpicWithRetry(groupPath string, epicIID int64, ref BulkIssueRef) bulkAssignOutcome {
+	itemOpts := &AddIssueToEpicOptions{
+		GroupPath:   groupPath,
+		EpicIID:     epicIID,
+		ProjectPath: ref.ProjectPath,
+		IssueIID:    ref.IssueIID,
+	}
+
+	var lastErr error
+	for attempt := 0; attempt <= maxBulkAssignRetries; attempt++ {
+		if attempt > 0 {
+			time.Sleep(bulkAssignBaseBackoff * time.Duration(1<<(attempt-1)))
+		}
+
+		assignment, err := a.AddIssueToEpic(itemOpts)
+		if err == nil {
+			return bulkAssignOutcome{input: ref, assignment: assignment}
+		}
+
+		lastErr = err
+		if !isRetryableAssignError(err) {
+			break
+		}
+	}
+
+	return bulkAssignOutcome{input: ref, err: lastErr}
+}
+
+// isRetryableAssignError reports whether err looks like a transient GitLab API error (429, 500,
+// 502, 503 or 504 in its message) that is worth retrying.
+func isRetryableAssignError(err error) bool {
+	msg := err.Error()
+	for _, code := range []string{"429", "500", "502", "503", "504"} {
+		if strings.Contains(msg, code) {
+			return true
+		}
+	}
+	return false
+}
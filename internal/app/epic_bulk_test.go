@@ -0,0 +1,100 @@
+package app
+
+import (
+	"errors"
+	"log/slog"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gitlab.com/gitlab-org/api/client-go"
+)
+
+// TestApp_AddIssuesToEpic_Validation tests the up-front validation of AddIssuesToEpic.
+func TestApp_AddIssuesToEpic_Validation(t *testing.T) {
+	app := NewWithClient("token", "https://gitlab.com/", &MockGitLabClient{})
+	app.SetLogger(slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelError})))
+
+	_, err := app.AddIssuesToEpic(nil)
+	require.ErrorIs(t, err, ErrCreateOptionsRequired)
+
+	_, err = app.AddIssuesToEpic(&BulkAddIssuesToEpicOptions{EpicIID: 5})
+	require.ErrorIs(t, err, ErrGroupPathRequired)
+
+	_, err = app.AddIssuesToEpic(&BulkAddIssuesToEpicOptions{GroupPath: "test/group"})
+	require.ErrorIs(t, err, ErrEpicIIDRequired)
+}
+
+// TestApp_AddIssuesToEpic_PartialFailure tests that one failing item does not abort the others.
+func TestApp_AddIssuesToEpic_PartialFailure(t *testing.T) {
+	mockClient := &MockGitLabClient{}
+	mockGroups := &MockGroupsService{}
+	mockProjects := &MockProjectsService{}
+	mockIssues := &MockIssuesService{}
+	mockEpicIssues := &MockEpicIssuesService{}
+
+	mockClient.On("Groups").Return(mockGroups)
+	mockClient.On("Projects").Return(mockProjects)
+	mockClient.On("Issues").Return(mockIssues)
+	mockClient.On("EpicIssues").Return(mockEpicIssues)
+
+	mockGroups.On("GetGroup", "test/group", (*gitlab.GetGroupOptions)(nil)).Return(
+		&gitlab.Group{ID: 456}, &gitlab.Response{}, nil,
+	)
+
+	mockProjects.On("GetProject", "test/project-ok", (*gitlab.GetProjectOptions)(nil)).Return(
+		&gitlab.Project{ID: 100}, &gitlab.Response{}, nil,
+	)
+	mockProjects.On("GetProject", "test/project-bad", (*gitlab.GetProjectOptions)(nil)).Return(
+		(*gitlab.Project)(nil), &gitlab.Response{}, errors.New("404 Not Found"),
+	)
+
+	mockIssues.On("GetIssue", int64(100), 10).Return(
+		&gitlab.Issue{ID: 999, IID: 10}, &gitlab.Response{}, nil,
+	)
+
+	mockEpicIssues.On("AssignEpicIssue", int64(456), int64(5), int64(999)).Return(
+		&gitlab.EpicIssueAssignment{
+			Issue: &gitlab.Issue{ID: 999, IID: 10},
+			Epic:  &gitlab.Epic{ID: 1, IID: 5},
+		}, &gitlab.Response{}, nil,
+	)
+
+	app := NewWithClient("token", "https://gitlab.com/", mockClient)
+	app.SetLogger(slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelError})))
+
+	result, err := app.AddIssuesToEpic(&BulkAddIssuesToEpicOptions{
+		GroupPath:   "test/group",
+		EpicIID:     5,
+		Concurrency: 2,
+		Issues: []BulkIssueRef{
+			{ProjectPath: "test/project-ok", IssueIID: 10},
+			{ProjectPath: "test/project-bad", IssueIID: 20},
+		},
+	})
+
+	require.NoError(t, err)
+	assert.Len(t, result.Successes, 1)
+	assert.Len(t, result.Failures, 1)
+	assert.Equal(t, "test/project-bad", result.Failures[0].Input.ProjectPath)
+}
+
+// TestIsRetryableAssignError tests the isRetryableAssignError helper.
+func TestIsRetryableAssignError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{name: "429 is retryable", err: errors.New("429 Too Many Requests"), want: true},
+		{name: "503 is retryable", err: errors.New("503 Service Unavailable"), want: true},
+		{name: "404 is not retryable", err: errors.New("404 Not Found"), want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, isRetryableAssignError(tt.err))
+		})
+	}
+}
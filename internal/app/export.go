@@ -0,0 +1,192 @@
+package app
+
+import (
+	"archive/tar"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/sgaunet/gitlab-mcp/internal/gitlaberr"
+	"gitlab.com/gitlab-org/api/client-go"
+)
+
+// maxExportIssuesPerPage caps how many issues ExportProjectIssues requests per page.
+const maxExportIssuesPerPage = 100
+
+// ExportFormat selects the on-wire encoding ExportProjectIssues writes.
+type ExportFormat string
+
+const (
+	// ExportFormatNDJSON writes one JSON object per line (newline-delimited JSON).
+	ExportFormatNDJSON ExportFormat = "ndjson"
+	// ExportFormatTar writes one JSON file per issue inside a tar archive.
+	ExportFormatTar ExportFormat = "tar"
+)
+
+// ErrUnknownExportFormat is returned when ExportProjectIssues is called with a format other than
+// ExportFormatNDJSON or ExportFormatTar.
+var ErrUnknownExportFormat = errors.New("unknown export format")
+
+// ExportOptions controls which related resources ExportProjectIssues attaches to each issue.
+// A nil *ExportOptions (or the zero value) exports bare issues with no related resources.
+type ExportOptions struct {
+	IncludeNotes      bool
+	IncludeLabels     bool
+	IncludeMilestones bool
+}
+
+// exportedIssue is a single record ExportProjectIssues writes per issue, the issue itself plus
+// whichever related resources ExportOptions requested.
+type exportedIssue struct {
+	Issue
+	Notes []Note `json:"notes,omitempty"`
+}
+
+// ExportProjectIssues streams every issue in a project to w, walking every page of
+// ListProjectIssues until GitLab reports no further page. Unlike the Paginator-backed List*
+// methods, this ignores the usual item/byte caps: an export is expected to capture the project's
+// full history, not a context-window-sized slice of it. format selects NDJSON (one JSON object
+// per line) or a tar archive with one JSON file per issue. opts may be nil, in which case only
+// the bare issue fields are exported.
+func (a *App) ExportProjectIssues(projectPath string, w io.Writer, format ExportFormat, opts *ExportOptions) error {
+	if opts == nil {
+		opts = &ExportOptions{}
+	}
+
+	a.logger.Debug("Exporting project issues", "project_path", projectPath, "format", format, "options", opts)
+
+	project, err := a.getProjectCached(projectPath)
+	if err != nil {
+		a.logger.Error("Failed to get project", "error", err, "project_path", projectPath)
+		return err
+	}
+
+	switch format {
+	case ExportFormatNDJSON:
+		return a.exportProjectIssuesNDJSON(project.ID, projectPath, w, opts)
+	case ExportFormatTar:
+		return a.exportProjectIssuesTar(project.ID, projectPath, w, opts)
+	default:
+		return fmt.Errorf("%w: %q", ErrUnknownExportFormat, format)
+	}
+}
+
+// exportProjectIssuesNDJSON implements ExportProjectIssues for ExportFormatNDJSON.
+func (a *App) exportProjectIssuesNDJSON(projectID int64, projectPath string, w io.Writer, opts *ExportOptions) error {
+	encoder := json.NewEncoder(w)
+	count := 0
+
+	err := a.walkProjectIssuesForExport(projectID, func(issue *gitlab.Issue) error {
+		record, err := a.buildExportedIssue(projectPath, issue, opts)
+		if err != nil {
+			return err
+		}
+		count++
+		return encoder.Encode(record)
+	})
+	if err != nil {
+		return err
+	}
+
+	a.logger.Info("Successfully exported project issues", "count", count, "project_id", projectID, "format", ExportFormatNDJSON)
+	return nil
+}
+
+// exportProjectIssuesTar implements ExportProjectIssues for ExportFormatTar.
+func (a *App) exportProjectIssuesTar(projectID int64, projectPath string, w io.Writer, opts *ExportOptions) error {
+	tw := tar.NewWriter(w)
+	count := 0
+
+	err := a.walkProjectIssuesForExport(projectID, func(issue *gitlab.Issue) error {
+		record, err := a.buildExportedIssue(projectPath, issue, opts)
+		if err != nil {
+			return err
+		}
+
+		data, err := json.MarshalIndent(record, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to marshal issue %d: %w", issue.IID, err)
+		}
+
+		header := &tar.Header{
+			Name: fmt.Sprintf("issue-%d.json", issue.IID),
+			Mode: 0o644,
+			Size: int64(len(data)),
+		}
+		if err := tw.WriteHeader(header); err != nil {
+			return fmt.Errorf("failed to write tar header for issue %d: %w", issue.IID, err)
+		}
+		if _, err := tw.Write(data); err != nil {
+			return fmt.Errorf("failed to write tar entry for issue %d: %w", issue.IID, err)
+		}
+		count++
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := tw.Close(); err != nil {
+		return fmt.Errorf("failed to finalize export tar: %w", err)
+	}
+
+	a.logger.Info("Successfully exported project issues", "count", count, "project_id", projectID, "format", ExportFormatTar)
+	return nil
+}
+
+// walkProjectIssuesForExport calls fn once per issue in a project, walking every page of
+// ListProjectIssues until GitLab's X-Next-Page header reports there is none left. It stops and
+// returns fn's error immediately if fn returns one.
+func (a *App) walkProjectIssuesForExport(projectID int64, fn func(*gitlab.Issue) error) error {
+	listOpts := &gitlab.ListProjectIssuesOptions{
+		ListOptions: gitlab.ListOptions{PerPage: maxExportIssuesPerPage, Page: 1},
+	}
+
+	for {
+		issues, resp, err := retryCall(a, context.Background(), "ListProjectIssues",
+			func() ([]*gitlab.Issue, *gitlab.Response, error) {
+				return a.client.Issues().ListProjectIssues(projectID, listOpts)
+			})
+		if err != nil {
+			a.logger.Error("Failed to list project issues for export", "error", err, "project_id", projectID)
+			return gitlaberr.Classify(err, "failed to list project issues for export")
+		}
+
+		for _, issue := range issues {
+			if err := fn(issue); err != nil {
+				return err
+			}
+		}
+
+		if resp == nil || resp.NextPage == 0 {
+			return nil
+		}
+		listOpts.Page = resp.NextPage
+	}
+}
+
+// buildExportedIssue converts a raw GitLab issue into the record ExportProjectIssues writes,
+// stripping labels/milestone the caller didn't ask for and fetching notes when requested.
+func (a *App) buildExportedIssue(projectPath string, issue *gitlab.Issue, opts *ExportOptions) (exportedIssue, error) {
+	converted := convertGitLabIssue(issue)
+	if !opts.IncludeLabels {
+		converted.Labels = nil
+	}
+	if !opts.IncludeMilestones {
+		converted.Milestone = nil
+	}
+
+	record := exportedIssue{Issue: converted}
+	if opts.IncludeNotes {
+		notes, err := a.ListIssueNotes(projectPath, issue.IID)
+		if err != nil {
+			a.logger.Error("Failed to list issue notes for export", "error", err, "issue_iid", issue.IID)
+			return exportedIssue{}, err
+		}
+		record.Notes = notes
+	}
+
+	return record, nil
+}
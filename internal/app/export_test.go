@@ -0,0 +1,162 @@
+package app
+
+import (
+	"archive/tar"
+	"bytes"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gitlab.com/gitlab-org/api/client-go"
+)
+
+func newTestAppForExport(mockClient *MockGitLabClient) *App {
+	a := NewWithClient("token", "https://gitlab.com/", mockClient)
+	a.SetLogger(slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelError})))
+	return a
+}
+
+// TestApp_ExportProjectIssues_NDJSON verifies that ExportProjectIssues walks every page of
+// issues and writes one JSON object per line.
+func TestApp_ExportProjectIssues_NDJSON(t *testing.T) {
+	mockClient := &MockGitLabClient{}
+	mockProjects := &MockProjectsService{}
+	mockIssues := &MockIssuesService{}
+
+	mockClient.On("Projects").Return(mockProjects)
+	mockClient.On("Issues").Return(mockIssues)
+	mockProjects.On("GetProject", "test/project", (*gitlab.GetProjectOptions)(nil)).Return(
+		&gitlab.Project{ID: 123}, &gitlab.Response{}, nil,
+	)
+
+	page := func(page int) *gitlab.ListProjectIssuesOptions {
+		return &gitlab.ListProjectIssuesOptions{
+			ListOptions: gitlab.ListOptions{PerPage: maxExportIssuesPerPage, Page: page},
+		}
+	}
+	mockIssues.On("ListProjectIssues", 123, page(1)).Return(
+		[]*gitlab.Issue{{ID: 1, IID: 1, Title: "first"}}, pageResponse("2"), nil,
+	)
+	mockIssues.On("ListProjectIssues", 123, page(2)).Return(
+		[]*gitlab.Issue{{ID: 2, IID: 2, Title: "second"}}, pageResponse(""), nil,
+	)
+
+	a := newTestAppForExport(mockClient)
+
+	var buf bytes.Buffer
+	err := a.ExportProjectIssues("test/project", &buf, ExportFormatNDJSON, nil)
+	require.NoError(t, err)
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 2)
+
+	var first exportedIssue
+	require.NoError(t, json.Unmarshal([]byte(lines[0]), &first))
+	assert.Equal(t, "first", first.Title)
+	assert.Nil(t, first.Notes)
+
+	mockClient.AssertExpectations(t)
+	mockProjects.AssertExpectations(t)
+	mockIssues.AssertExpectations(t)
+}
+
+// TestApp_ExportProjectIssues_IncludeNotes verifies that IncludeNotes fetches and attaches each
+// issue's notes.
+func TestApp_ExportProjectIssues_IncludeNotes(t *testing.T) {
+	mockClient := &MockGitLabClient{}
+	mockProjects := &MockProjectsService{}
+	mockIssues := &MockIssuesService{}
+	mockNotes := &MockNotesService{}
+
+	mockClient.On("Projects").Return(mockProjects)
+	mockClient.On("Issues").Return(mockIssues)
+	mockClient.On("Notes").Return(mockNotes)
+	mockProjects.On("GetProject", "test/project", (*gitlab.GetProjectOptions)(nil)).Return(
+		&gitlab.Project{ID: 123}, &gitlab.Response{}, nil,
+	)
+
+	mockIssues.On("ListProjectIssues", 123, &gitlab.ListProjectIssuesOptions{
+		ListOptions: gitlab.ListOptions{PerPage: maxExportIssuesPerPage, Page: 1},
+	}).Return([]*gitlab.Issue{{ID: 1, IID: 1, Title: "first"}}, pageResponse(""), nil)
+	mockNotes.On("ListIssueNotes", int64(123), 1, (*gitlab.ListIssueNotesOptions)(nil)).Return(
+		[]*gitlab.Note{{ID: 1, Body: "a comment"}}, &gitlab.Response{}, nil,
+	)
+
+	a := newTestAppForExport(mockClient)
+
+	var buf bytes.Buffer
+	err := a.ExportProjectIssues("test/project", &buf, ExportFormatNDJSON, &ExportOptions{IncludeNotes: true})
+	require.NoError(t, err)
+
+	var record exportedIssue
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &record))
+	require.Len(t, record.Notes, 1)
+	assert.Equal(t, "a comment", record.Notes[0].Body)
+
+	mockClient.AssertExpectations(t)
+	mockProjects.AssertExpectations(t)
+	mockIssues.AssertExpectations(t)
+	mockNotes.AssertExpectations(t)
+}
+
+// TestApp_ExportProjectIssues_Tar verifies that ExportFormatTar writes one tar entry per issue.
+func TestApp_ExportProjectIssues_Tar(t *testing.T) {
+	mockClient := &MockGitLabClient{}
+	mockProjects := &MockProjectsService{}
+	mockIssues := &MockIssuesService{}
+
+	mockClient.On("Projects").Return(mockProjects)
+	mockClient.On("Issues").Return(mockIssues)
+	mockProjects.On("GetProject", "test/project", (*gitlab.GetProjectOptions)(nil)).Return(
+		&gitlab.Project{ID: 123}, &gitlab.Response{}, nil,
+	)
+	mockIssues.On("ListProjectIssues", 123, &gitlab.ListProjectIssuesOptions{
+		ListOptions: gitlab.ListOptions{PerPage: maxExportIssuesPerPage, Page: 1},
+	}).Return([]*gitlab.Issue{{ID: 1, IID: 7, Title: "tarred"}}, pageResponse(""), nil)
+
+	a := newTestAppForExport(mockClient)
+
+	var buf bytes.Buffer
+	err := a.ExportProjectIssues("test/project", &buf, ExportFormatTar, nil)
+	require.NoError(t, err)
+
+	tr := tar.NewReader(&buf)
+	header, err := tr.Next()
+	require.NoError(t, err)
+	assert.Equal(t, "issue-7.json", header.Name)
+
+	data, err := io.ReadAll(tr)
+	require.NoError(t, err)
+	var record exportedIssue
+	require.NoError(t, json.Unmarshal(data, &record))
+	assert.Equal(t, "tarred", record.Title)
+
+	mockClient.AssertExpectations(t)
+	mockProjects.AssertExpectations(t)
+	mockIssues.AssertExpectations(t)
+}
+
+// TestApp_ExportProjectIssues_UnknownFormat verifies that an unrecognized format is rejected
+// after the project lookup and before any issue is listed.
+func TestApp_ExportProjectIssues_UnknownFormat(t *testing.T) {
+	mockClient := &MockGitLabClient{}
+	mockProjects := &MockProjectsService{}
+
+	mockClient.On("Projects").Return(mockProjects)
+	mockProjects.On("GetProject", "test/project", (*gitlab.GetProjectOptions)(nil)).Return(
+		&gitlab.Project{ID: 123}, &gitlab.Response{}, nil,
+	)
+
+	a := newTestAppForExport(mockClient)
+
+	var buf bytes.Buffer
+	err := a.ExportProjectIssues("test/project", &buf, ExportFormat("csv"), nil)
+
+	require.Error(t, err)
+	assert.ErrorIs(t, err, ErrUnknownExportFormat)
+}
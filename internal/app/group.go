@@ -0,0 +1,333 @@
+package app
+
+import (
+	"context"
+	"errors"
+
+	"github.com/sgaunet/gitlab-mcp/internal/gitlaberr"
+	"gitlab.com/gitlab-org/api/client-go"
+)
+
+// maxGroupProjectsPerPage caps how many projects ListGroupProjects requests in one page.
+const maxGroupProjectsPerPage = 100
+
+// Namespace kinds, as documented for glab's NamespaceKindUser/NamespaceKindGroup constants.
+const (
+	NamespaceKindUser  = "user"
+	NamespaceKindGroup = "group"
+)
+
+// ErrNamespacePathRequired is returned when a namespace lookup is attempted with an empty path.
+var ErrNamespacePathRequired = errors.New("namespace path is required")
+
+// ListGroupProjectsOptions contains options for listing a group's projects.
+type ListGroupProjectsOptions struct {
+	IncludeSubgroups bool
+	Limit            int64
+	ListAll          bool
+	MaxItems         int
+	Truncated        bool
+}
+
+// Namespace represents a GitLab namespace, which may back either a user or a group. Kind
+// distinguishes the two (NamespaceKindUser or NamespaceKindGroup) so callers can tell whether a
+// "group/subgroup" style path actually resolves to a group before fanning out group-scoped calls.
+type Namespace struct {
+	ID       int64  `json:"id"`
+	Name     string `json:"name"`
+	Path     string `json:"path"`
+	Kind     string `json:"kind"`
+	FullPath string `json:"full_path"`
+	WebURL   string `json:"web_url"`
+}
+
+// convertGitLabNamespace converts a GitLab namespace to our Namespace struct.
+func convertGitLabNamespace(namespace *gitlab.Namespace) Namespace {
+	return Namespace{
+		ID:       namespace.ID,
+		Name:     namespace.Name,
+		Path:     namespace.Path,
+		Kind:     namespace.Kind,
+		FullPath: namespace.FullPath,
+		WebURL:   namespace.WebURL,
+	}
+}
+
+// ListGroupProjects retrieves the projects belonging to a group.
+func (a *App) ListGroupProjects(groupPath string, opts *ListGroupProjectsOptions) ([]ProjectInfo, error) {
+	a.logger.Debug("Listing projects for group", "group_path", groupPath, "options", opts)
+
+	group, err := a.getGroupCached(groupPath)
+	if err != nil {
+		a.logger.Error("Failed to get group", "error", err, "group_path", groupPath)
+		return nil, err
+	}
+
+	if opts == nil {
+		opts = &ListGroupProjectsOptions{}
+	}
+	if opts.Limit == 0 {
+		opts.Limit = maxGroupProjectsPerPage
+	}
+	if opts.Limit > maxGroupProjectsPerPage {
+		opts.Limit = maxGroupProjectsPerPage
+	}
+
+	listOpts := &gitlab.ListGroupProjectsOptions{
+		IncludeSubgroups: &opts.IncludeSubgroups,
+		ListOptions:      gitlab.ListOptions{PerPage: opts.Limit, Page: 1},
+	}
+
+	var projects []*gitlab.Project
+	if opts.ListAll {
+		paginator := &Paginator[*gitlab.Project]{
+			MaxItems: opts.MaxItems,
+			Fetch: func(cursor string) ([]*gitlab.Project, *gitlab.Response, error) {
+				listOpts.Page = cursorToPage(cursor, listOpts.Page)
+				return retryCall(a, context.Background(), "ListGroupProjects",
+					func() ([]*gitlab.Project, *gitlab.Response, error) {
+						return a.client.Groups().ListGroupProjects(group.ID, listOpts)
+					})
+			},
+		}
+		result, err := paginator.FetchAll()
+		if err != nil {
+			a.logger.Error("Failed to list group projects", "error", err, "group_id", group.ID)
+			return nil, gitlaberr.Classify(err, "failed to list group projects")
+		}
+		projects = result.Items
+		opts.Truncated = result.Truncated
+	} else {
+		var err error
+		projects, _, err = retryCall(a, context.Background(), "ListGroupProjects",
+			func() ([]*gitlab.Project, *gitlab.Response, error) {
+				return a.client.Groups().ListGroupProjects(group.ID, listOpts)
+			})
+		if err != nil {
+			a.logger.Error("Failed to list group projects", "error", err, "group_id", group.ID)
+			return nil, gitlaberr.Classify(err, "failed to list group projects")
+		}
+	}
+
+	result := make([]ProjectInfo, 0, len(projects))
+	for _, project := range projects {
+		result = append(result, ProjectInfo{
+			ID:          project.ID,
+			Name:        project.Name,
+			Path:        project.Path,
+			Description: project.Description,
+			Topics:      project.Topics,
+		})
+	}
+
+	a.logger.Info("Successfully listed group projects", "count", len(result), "group_id", group.ID)
+	return result, nil
+}
+
+// ListGroupIssues retrieves issues across all projects in a group.
+func (a *App) ListGroupIssues(groupPath string, opts *ListIssuesOptions) ([]Issue, error) {
+	a.logger.Debug("Listing issues for group", "group_path", groupPath, "options", opts)
+
+	group, err := a.getGroupCached(groupPath)
+	if err != nil {
+		a.logger.Error("Failed to get group", "error", err, "group_path", groupPath)
+		return nil, err
+	}
+
+	opts = normalizeListIssuesOptions(opts)
+
+	listOpts := &gitlab.ListGroupIssuesOptions{
+		State:       &opts.State,
+		ListOptions: gitlab.ListOptions{PerPage: cappedPerPage(opts.Limit, maxIssuesPerPage), Page: 1},
+	}
+	if opts.Labels != "" {
+		labelList := parseLabels(opts.Labels)
+		if len(labelList) > 0 {
+			labels := gitlab.LabelOptions(labelList)
+			listOpts.Labels = &labels
+		}
+	}
+	if opts.IterationID == nil && opts.IterationTitle != nil {
+		iterationID, err := a.findIterationByTitle(groupPath, *opts.IterationTitle)
+		if err != nil {
+			a.logger.Error("Failed to resolve iteration", "error", err, "iteration_title", *opts.IterationTitle)
+			return nil, err
+		}
+		opts.IterationID = &iterationID
+	}
+	if opts.IterationID != nil {
+		listOpts.IterationID = opts.IterationID
+	}
+	if opts.MilestoneTitle != "" {
+		listOpts.Milestone = &opts.MilestoneTitle
+	}
+
+	var issues []*gitlab.Issue
+	if opts.ListAll {
+		paginator := &Paginator[*gitlab.Issue]{
+			MaxItems: opts.MaxItems,
+			Fetch: func(cursor string) ([]*gitlab.Issue, *gitlab.Response, error) {
+				listOpts.Page = cursorToPage(cursor, listOpts.Page)
+				return retryCall(a, context.Background(), "ListGroupIssues",
+					func() ([]*gitlab.Issue, *gitlab.Response, error) {
+						return a.client.Issues().ListGroupIssues(group.ID, listOpts)
+					})
+			},
+		}
+		result, err := paginator.FetchAll()
+		if err != nil {
+			a.logger.Error("Failed to list group issues", "error", err, "group_id", group.ID)
+			return nil, gitlaberr.Classify(err, "failed to list group issues")
+		}
+		issues = result.Items
+		opts.Truncated = result.Truncated
+	} else {
+		var lastResp *gitlab.Response
+		paginator := &Paginator[*gitlab.Issue]{
+			MaxItems: int(opts.Limit),
+			Fetch: func(cursor string) ([]*gitlab.Issue, *gitlab.Response, error) {
+				listOpts.Page = cursorToPage(cursor, listOpts.Page)
+				items, resp, err := retryCall(a, context.Background(), "ListGroupIssues",
+					func() ([]*gitlab.Issue, *gitlab.Response, error) {
+						return a.client.Issues().ListGroupIssues(group.ID, listOpts)
+					})
+				lastResp = resp
+				return items, resp, err
+			},
+		}
+		result, err := paginator.FetchAll()
+		if err != nil {
+			a.logger.Error("Failed to list group issues", "error", err, "group_id", group.ID)
+			return nil, gitlaberr.Classify(err, "failed to list group issues")
+		}
+		issues = result.Items
+		opts.Truncated = result.Truncated
+		opts.NextPage, opts.TotalItems = paginationMeta(lastResp)
+	}
+
+	result := make([]Issue, 0, len(issues))
+	for _, issue := range issues {
+		result = append(result, convertGitLabIssue(issue))
+	}
+
+	a.logger.Info("Successfully listed group issues", "count", len(result), "group_id", group.ID)
+	return result, nil
+}
+
+// ListGroupLabels retrieves labels defined at the group level.
+func (a *App) ListGroupLabels(groupPath string, opts *ListLabelsOptions) ([]Label, error) {
+	a.logger.Debug("Listing labels for group", "group_path", groupPath, "options", opts)
+
+	group, err := a.getGroupCached(groupPath)
+	if err != nil {
+		a.logger.Error("Failed to get group", "error", err, "group_path", groupPath)
+		return nil, err
+	}
+
+	if opts == nil {
+		opts = &ListLabelsOptions{Limit: maxLabelsPerPage}
+	}
+	if opts.Limit == 0 {
+		opts.Limit = maxLabelsPerPage
+	}
+	if opts.Limit > defaultMaxPaginatedItems {
+		opts.Limit = defaultMaxPaginatedItems
+	}
+
+	// PerPage is capped at maxLabelsPerPage regardless of Limit; a Limit beyond one page's worth
+	// is satisfied by auto-paginating below.
+	listOpts := &gitlab.ListGroupLabelsOptions{
+		WithCounts:            &opts.WithCounts,
+		IncludeAncestorGroups: &opts.IncludeAncestorGroups,
+		ListOptions:           gitlab.ListOptions{PerPage: cappedPerPage(opts.Limit, maxLabelsPerPage), Page: 1},
+	}
+	if opts.Search != "" {
+		listOpts.Search = &opts.Search
+	}
+
+	var labels []*gitlab.Label
+	if opts.ListAll {
+		paginator := &Paginator[*gitlab.Label]{
+			MaxItems: opts.MaxItems,
+			Fetch: func(cursor string) ([]*gitlab.Label, *gitlab.Response, error) {
+				listOpts.Page = cursorToPage(cursor, listOpts.Page)
+				return retryCall(a, context.Background(), "ListGroupLabels",
+					func() ([]*gitlab.Label, *gitlab.Response, error) {
+						return a.client.Labels().ListGroupLabels(group.ID, listOpts)
+					})
+			},
+		}
+		result, err := paginator.FetchAll()
+		if err != nil {
+			a.logger.Error("Failed to list group labels", "error", err, "group_id", group.ID)
+			return nil, gitlaberr.Classify(err, "failed to list group labels")
+		}
+		labels = result.Items
+		opts.Truncated = result.Truncated
+	} else {
+		var lastResp *gitlab.Response
+		paginator := &Paginator[*gitlab.Label]{
+			MaxItems: int(opts.Limit),
+			Fetch: func(cursor string) ([]*gitlab.Label, *gitlab.Response, error) {
+				listOpts.Page = cursorToPage(cursor, listOpts.Page)
+				items, resp, err := retryCall(a, context.Background(), "ListGroupLabels",
+					func() ([]*gitlab.Label, *gitlab.Response, error) {
+						return a.client.Labels().ListGroupLabels(group.ID, listOpts)
+					})
+				lastResp = resp
+				return items, resp, err
+			},
+		}
+		result, err := paginator.FetchAll()
+		labels = result.Items
+		opts.Truncated = result.Truncated
+		opts.NextPage, opts.TotalItems = paginationMeta(lastResp)
+		if err != nil {
+			a.logger.Error("Failed to list group labels", "error", err, "group_id", group.ID)
+			return nil, gitlaberr.Classify(err, "failed to list group labels")
+		}
+	}
+
+	result := make([]Label, 0, len(labels))
+	for _, label := range labels {
+		result = append(result, Label{
+			ID:                     label.ID,
+			Name:                   label.Name,
+			Color:                  label.Color,
+			TextColor:              label.TextColor,
+			Description:            label.Description,
+			OpenIssuesCount:        label.OpenIssuesCount,
+			ClosedIssuesCount:      label.ClosedIssuesCount,
+			OpenMergeRequestsCount: label.OpenMergeRequestsCount,
+			Subscribed:             label.Subscribed,
+			Priority:               label.Priority,
+			IsProjectLabel:         label.IsProjectLabel,
+		})
+	}
+
+	a.logger.Info("Successfully listed group labels", "count", len(result), "group_id", group.ID)
+	return result, nil
+}
+
+// GetNamespace resolves a "user", "group", or "group/subgroup" style path to its namespace,
+// reporting whether it is a user or a group namespace via Namespace.Kind.
+func (a *App) GetNamespace(namespacePath string) (*Namespace, error) {
+	if namespacePath == "" {
+		return nil, ErrNamespacePathRequired
+	}
+
+	a.logger.Debug("Getting namespace", "namespace_path", namespacePath)
+
+	namespace, _, err := retryCall(a, context.Background(), "GetNamespace",
+		func() (*gitlab.Namespace, *gitlab.Response, error) {
+			return a.client.Namespaces().GetNamespace(namespacePath)
+		})
+	if err != nil {
+		a.logger.Error("Failed to get namespace", "error", err, "namespace_path", namespacePath)
+		return nil, gitlaberr.Classify(err, "failed to get namespace")
+	}
+
+	result := convertGitLabNamespace(namespace)
+	a.logger.Info("Successfully retrieved namespace", "namespace_id", result.ID, "kind", result.Kind)
+	return &result, nil
+}
@@ -0,0 +1,82 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"gitlab.com/gitlab-org/api/client-go"
+)
+
+// ErrProjectPathNotNested is returned when a project path has no group component (i.e. it is not
+// of the form "group/project" or "group/subgroup/project").
+var ErrProjectPathNotNested = errors.New("project path has no group component")
+
+// extractGroupPath returns the group portion of a "group/project" or "group/subgroup/project"
+// style project path, i.e. everything before the final "/".
+func extractGroupPath(projectPath string) (string, error) {
+	idx := strings.LastIndex(projectPath, "/")
+	if idx <= 0 {
+		return "", ErrProjectPathNotNested
+	}
+	return projectPath[:idx], nil
+}
+
+// mergeIssues combines a project's own issues with issues fetched at the group level,
+// deduplicating by issue ID. Group issues that belong to currentProjectID are skipped outright,
+// since they are already covered by projectIssues. Deduplication is by ID only, with the
+// project-level copy always winning ties; it does not compare CreatedAt/UpdatedAt, so a nil
+// timestamp on either side (GitLab omits UpdatedAt on some payloads) cannot affect which copy is
+// kept. Any future merge/dedup logic that does need a timestamp should use effectiveTimestamp
+// rather than dereferencing UpdatedAt directly.
+func mergeIssues(projectIssues, groupIssues []*gitlab.Issue, currentProjectID int64) []*gitlab.Issue {
+	seen := make(map[int64]bool, len(projectIssues)+len(groupIssues))
+	result := make([]*gitlab.Issue, 0, len(projectIssues)+len(groupIssues))
+
+	for _, issue := range projectIssues {
+		if seen[issue.ID] {
+			continue
+		}
+		seen[issue.ID] = true
+		result = append(result, issue)
+	}
+
+	for _, issue := range groupIssues {
+		if issue.ProjectID == currentProjectID || seen[issue.ID] {
+			continue
+		}
+		seen[issue.ID] = true
+		result = append(result, issue)
+	}
+
+	return result
+}
+
+// listGroupIssuesForMerge fetches group-level issues to merge into a project's issue listing. It
+// returns ok=false when the project path has no group component, or when the group-level fetch
+// fails, so that ListProjectIssues can fall back to project-only results.
+func (a *App) listGroupIssuesForMerge(projectPath string, opts *ListIssuesOptions) ([]*gitlab.Issue, bool) {
+	groupPath, err := extractGroupPath(projectPath)
+	if err != nil {
+		a.logger.Debug("Skipping group issues: project path has no group component",
+			"project_path", projectPath)
+		return nil, false
+	}
+
+	groupOpts := &gitlab.ListGroupIssuesOptions{
+		State:       &opts.State,
+		ListOptions: gitlab.ListOptions{PerPage: opts.Limit, Page: 1},
+	}
+
+	groupIssues, _, err := retryCall(a, context.Background(), "ListGroupIssues",
+		func() ([]*gitlab.Issue, *gitlab.Response, error) {
+			return a.client.Issues().ListGroupIssues(groupPath, groupOpts)
+		})
+	if err != nil {
+		a.logger.Debug("Failed to list group issues, falling back to project issues",
+			"error", err, "group_path", groupPath)
+		return nil, false
+	}
+
+	return groupIssues, true
+}
@@ -142,6 +142,18 @@ func TestMergeIssues(t *testing.T) {
 			wantCount:        3,
 			description:      "partial overlap should deduplicate and merge",
 		},
+		{
+			name: "nil timestamps on both sides do not panic",
+			projectIssues: []*gitlab.Issue{
+				{ID: 1, IID: 1, ProjectID: 100, Title: "P1", State: "opened"},
+			},
+			groupIssues: []*gitlab.Issue{
+				{ID: 10, IID: 10, ProjectID: 200, Title: "G1", State: "opened", CreatedAt: &testTime},
+			},
+			currentProjectID: currentProjectID,
+			wantCount:        2,
+			description:      "issues with nil CreatedAt/UpdatedAt are merged like any other, since mergeIssues dedups by ID only",
+		},
 	}
 
 	for _, tt := range tests {
@@ -1,6 +1,8 @@
 package app
 
 import (
+	"bytes"
+
 	"gitlab.com/gitlab-org/api/client-go"
 )
 
@@ -15,11 +17,24 @@ type IssuesService interface {
 	ListProjectIssues(pid interface{}, opt *gitlab.ListProjectIssuesOptions) ([]*gitlab.Issue, *gitlab.Response, error)
 	CreateIssue(pid interface{}, opt *gitlab.CreateIssueOptions) (*gitlab.Issue, *gitlab.Response, error)
 	UpdateIssue(pid interface{}, issue int64, opt *gitlab.UpdateIssueOptions) (*gitlab.Issue, *gitlab.Response, error)
+	GetIssue(pid interface{}, issue int) (*gitlab.Issue, *gitlab.Response, error)
+	DeleteIssue(pid interface{}, issue int) (*gitlab.Response, error)
+	MoveIssue(pid interface{}, issue int, opt *gitlab.MoveIssueOptions) (*gitlab.Issue, *gitlab.Response, error)
+	SubscribeToIssue(pid interface{}, issue int) (*gitlab.Issue, *gitlab.Response, error)
+	UnsubscribeFromIssue(pid interface{}, issue int) (*gitlab.Issue, *gitlab.Response, error)
+	CreateTodo(pid interface{}, issue int) (*gitlab.Todo, *gitlab.Response, error)
+	ListGroupIssues(
+		gid interface{},
+		opt *gitlab.ListGroupIssuesOptions,
+		options ...gitlab.RequestOptionFunc,
+	) ([]*gitlab.Issue, *gitlab.Response, error)
+	ListIssues(opt *gitlab.ListIssuesOptions) ([]*gitlab.Issue, *gitlab.Response, error)
 }
 
 // LabelsService interface for GitLab Labels operations.
 type LabelsService interface {
 	ListLabels(pid interface{}, opt *gitlab.ListLabelsOptions) ([]*gitlab.Label, *gitlab.Response, error)
+	ListGroupLabels(gid interface{}, opt *gitlab.ListGroupLabelsOptions) ([]*gitlab.Label, *gitlab.Response, error)
 }
 
 // UsersService interface for GitLab Users operations.
@@ -31,19 +46,284 @@ type UsersService interface {
 // NotesService interface for GitLab Notes operations.
 type NotesService interface {
 	CreateIssueNote(pid interface{}, issue int64, opt *gitlab.CreateIssueNoteOptions) (*gitlab.Note, *gitlab.Response, error)
+	ListIssueNotes(pid interface{}, issue int, opt *gitlab.ListIssueNotesOptions) ([]*gitlab.Note, *gitlab.Response, error)
+	GetIssueNote(pid interface{}, issue int, note int) (*gitlab.Note, *gitlab.Response, error)
+	UpdateIssueNote(pid interface{}, issue int, note int, opt *gitlab.UpdateIssueNoteOptions) (*gitlab.Note, *gitlab.Response, error)
+	DeleteIssueNote(pid interface{}, issue int, note int) (*gitlab.Response, error)
+}
+
+// DiscussionsService interface for GitLab Discussions operations: threaded, optionally
+// resolvable conversations on merge requests and issues (as opposed to NotesService's
+// single-note comments).
+type DiscussionsService interface {
+	ListMergeRequestDiscussions(
+		pid interface{},
+		mergeRequest int64,
+		opt *gitlab.ListMergeRequestDiscussionsOptions,
+	) ([]*gitlab.Discussion, *gitlab.Response, error)
+	GetMergeRequestDiscussion(
+		pid interface{},
+		mergeRequest int64,
+		discussion string,
+	) (*gitlab.Discussion, *gitlab.Response, error)
+	CreateMergeRequestDiscussion(
+		pid interface{},
+		mergeRequest int64,
+		opt *gitlab.CreateMergeRequestDiscussionOptions,
+	) (*gitlab.Discussion, *gitlab.Response, error)
+	AddMergeRequestDiscussionNote(
+		pid interface{},
+		mergeRequest int64,
+		discussion string,
+		opt *gitlab.AddMergeRequestDiscussionNoteOptions,
+	) (*gitlab.Note, *gitlab.Response, error)
+	ResolveMergeRequestDiscussion(
+		pid interface{},
+		mergeRequest int64,
+		discussion string,
+		opt *gitlab.ResolveMergeRequestDiscussionOptions,
+	) (*gitlab.Discussion, *gitlab.Response, error)
+	ListIssueDiscussions(
+		pid interface{},
+		issue int,
+		opt *gitlab.ListIssueDiscussionsOptions,
+	) ([]*gitlab.Discussion, *gitlab.Response, error)
+	GetIssueDiscussion(
+		pid interface{},
+		issue int,
+		discussion string,
+	) (*gitlab.Discussion, *gitlab.Response, error)
+	CreateIssueDiscussion(
+		pid interface{},
+		issue int,
+		opt *gitlab.CreateIssueDiscussionOptions,
+	) (*gitlab.Discussion, *gitlab.Response, error)
+	AddIssueDiscussionNote(
+		pid interface{},
+		issue int,
+		discussion string,
+		opt *gitlab.AddIssueDiscussionNoteOptions,
+	) (*gitlab.Note, *gitlab.Response, error)
 }
 
 // MergeRequestsService interface for GitLab MergeRequests operations.
 type MergeRequestsService interface {
 	CreateMergeRequest(
-		pid interface{}, 
+		pid interface{},
 		opt *gitlab.CreateMergeRequestOptions,
 	) (*gitlab.MergeRequest, *gitlab.Response, error)
+	ListProjectMergeRequests(
+		pid interface{},
+		opt *gitlab.ListProjectMergeRequestsOptions,
+	) ([]*gitlab.MergeRequest, *gitlab.Response, error)
+	ListGroupMergeRequests(
+		gid interface{},
+		opt *gitlab.ListGroupMergeRequestsOptions,
+	) ([]*gitlab.MergeRequest, *gitlab.Response, error)
+	GetMergeRequest(
+		pid interface{},
+		mergeRequest int64,
+		opt *gitlab.GetMergeRequestsOptions,
+	) (*gitlab.MergeRequest, *gitlab.Response, error)
+	GetMergeRequestChanges(
+		pid interface{},
+		mergeRequest int64,
+		opt *gitlab.GetMergeRequestChangesOptions,
+	) (*gitlab.MergeRequest, *gitlab.Response, error)
+	GetMergeRequestCommits(
+		pid interface{},
+		mergeRequest int64,
+		opt *gitlab.GetMergeRequestCommitsOptions,
+	) ([]*gitlab.Commit, *gitlab.Response, error)
+	UpdateMergeRequest(
+		pid interface{},
+		mergeRequest int64,
+		opt *gitlab.UpdateMergeRequestOptions,
+	) (*gitlab.MergeRequest, *gitlab.Response, error)
+	AcceptMergeRequest(
+		pid interface{},
+		mergeRequest int64,
+		opt *gitlab.AcceptMergeRequestOptions,
+	) (*gitlab.MergeRequest, *gitlab.Response, error)
+	CancelMergeWhenPipelineSucceeds(
+		pid interface{},
+		mergeRequest int64,
+	) (*gitlab.MergeRequest, *gitlab.Response, error)
+	DeleteMergeRequest(pid interface{}, mergeRequest int64) (*gitlab.Response, error)
+	CreateMergeRequestNote(
+		pid interface{},
+		mergeRequest int64,
+		opt *gitlab.CreateMergeRequestNoteOptions,
+	) (*gitlab.Note, *gitlab.Response, error)
+	ListMergeRequestNotes(
+		pid interface{},
+		mergeRequest int64,
+		opt *gitlab.ListMergeRequestNotesOptions,
+	) ([]*gitlab.Note, *gitlab.Response, error)
+	RebaseMergeRequest(pid interface{}, mergeRequest int64, opt *gitlab.RebaseMergeRequestOptions) (*gitlab.Response, error)
+	ListMergeRequestPipelines(
+		pid interface{},
+		mergeRequest int64,
+	) ([]*gitlab.PipelineInfo, *gitlab.Response, error)
+}
+
+// TimeStatsService interface for GitLab time tracking operations, shared by issues and merge
+// requests.
+type TimeStatsService interface {
+	SetIssueTimeEstimate(
+		pid interface{},
+		issue int,
+		opt *gitlab.SetTimeEstimateOptions,
+	) (*gitlab.TimeStats, *gitlab.Response, error)
+	ResetIssueTimeEstimate(pid interface{}, issue int) (*gitlab.TimeStats, *gitlab.Response, error)
+	AddIssueSpentTime(
+		pid interface{},
+		issue int,
+		opt *gitlab.AddSpentTimeOptions,
+	) (*gitlab.TimeStats, *gitlab.Response, error)
+	ResetIssueSpentTime(pid interface{}, issue int) (*gitlab.TimeStats, *gitlab.Response, error)
+	GetIssueTimeStats(pid interface{}, issue int) (*gitlab.TimeStats, *gitlab.Response, error)
+	SetMergeRequestTimeEstimate(
+		pid interface{},
+		mergeRequest int64,
+		opt *gitlab.SetTimeEstimateOptions,
+	) (*gitlab.TimeStats, *gitlab.Response, error)
+	ResetMergeRequestTimeEstimate(pid interface{}, mergeRequest int64) (*gitlab.TimeStats, *gitlab.Response, error)
+	AddMergeRequestSpentTime(
+		pid interface{},
+		mergeRequest int64,
+		opt *gitlab.AddSpentTimeOptions,
+	) (*gitlab.TimeStats, *gitlab.Response, error)
+	ResetMergeRequestSpentTime(pid interface{}, mergeRequest int64) (*gitlab.TimeStats, *gitlab.Response, error)
+	GetMergeRequestTimeStats(pid interface{}, mergeRequest int64) (*gitlab.TimeStats, *gitlab.Response, error)
+}
+
+// MergeRequestApprovalsService interface for GitLab MergeRequest approval operations.
+type MergeRequestApprovalsService interface {
+	ApproveMergeRequest(
+		pid interface{},
+		mergeRequest int64,
+		opt *gitlab.ApproveMergeRequestOptions,
+	) (*gitlab.MergeRequestApprovals, *gitlab.Response, error)
+	UnapproveMergeRequest(pid interface{}, mergeRequest int64) (*gitlab.Response, error)
+	GetApprovalState(pid interface{}, mergeRequest int64) (*gitlab.MergeRequestApprovalState, *gitlab.Response, error)
+	ChangeApprovalConfiguration(
+		pid interface{},
+		mergeRequest int64,
+		opt *gitlab.ChangeMergeRequestApprovalConfigurationOptions,
+	) (*gitlab.MergeRequestApprovals, *gitlab.Response, error)
+	GetProjectApprovalRules(
+		pid interface{},
+		opt *gitlab.GetProjectApprovalRulesListsOptions,
+	) ([]*gitlab.ProjectApprovalRule, *gitlab.Response, error)
+	CreateProjectApprovalRule(
+		pid interface{},
+		opt *gitlab.CreateProjectLevelRuleOptions,
+	) (*gitlab.ProjectApprovalRule, *gitlab.Response, error)
+	UpdateProjectApprovalRule(
+		pid interface{},
+		approvalRuleID int,
+		opt *gitlab.UpdateProjectLevelRuleOptions,
+	) (*gitlab.ProjectApprovalRule, *gitlab.Response, error)
+	DeleteProjectApprovalRule(pid interface{}, approvalRuleID int) (*gitlab.Response, error)
 }
 
 // MilestonesService interface for GitLab Milestones operations.
 type MilestonesService interface {
 	ListMilestones(pid interface{}, opt *gitlab.ListMilestonesOptions) ([]*gitlab.Milestone, *gitlab.Response, error)
+	CreateMilestone(pid interface{}, opt *gitlab.CreateMilestoneOptions) (*gitlab.Milestone, *gitlab.Response, error)
+	UpdateMilestone(
+		pid interface{},
+		milestone int,
+		opt *gitlab.UpdateMilestoneOptions,
+	) (*gitlab.Milestone, *gitlab.Response, error)
+	DeleteMilestone(pid interface{}, milestone int) (*gitlab.Response, error)
+}
+
+// GroupsService interface for GitLab Groups operations.
+type GroupsService interface {
+	GetGroup(gid interface{}, opt *gitlab.GetGroupOptions) (*gitlab.Group, *gitlab.Response, error)
+	ListGroupProjects(gid interface{}, opt *gitlab.ListGroupProjectsOptions) ([]*gitlab.Project, *gitlab.Response, error)
+	ListGroupMembers(gid interface{}, opt *gitlab.ListGroupMembersOptions) ([]*gitlab.GroupMember, *gitlab.Response, error)
+}
+
+// RepositoriesService interface for GitLab Repository operations.
+type RepositoriesService interface {
+	Compare(pid interface{}, opt *gitlab.CompareOptions) (*gitlab.Compare, *gitlab.Response, error)
+}
+
+// NamespacesService interface for GitLab Namespace resolution operations.
+type NamespacesService interface {
+	GetNamespace(id interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Namespace, *gitlab.Response, error)
+}
+
+// EpicsService interface for GitLab Epics operations.
+type EpicsService interface {
+	ListGroupEpics(gid interface{}, opt *gitlab.ListGroupEpicsOptions) ([]*gitlab.Epic, *gitlab.Response, error)
+	GetEpic(gid interface{}, epic int64) (*gitlab.Epic, *gitlab.Response, error)
+	CreateEpic(gid interface{}, opt *gitlab.CreateEpicOptions) (*gitlab.Epic, *gitlab.Response, error)
+	UpdateEpic(gid interface{}, epic int64, opt *gitlab.UpdateEpicOptions) (*gitlab.Epic, *gitlab.Response, error)
+	DeleteEpic(gid interface{}, epic int64) (*gitlab.Response, error)
+}
+
+// EpicIssuesService interface for GitLab Epic-Issue link operations.
+type EpicIssuesService interface {
+	AssignEpicIssue(gid interface{}, epic int64, issue int64) (*gitlab.EpicIssueAssignment, *gitlab.Response, error)
+	ListEpicIssues(gid interface{}, epic int64) ([]*gitlab.EpicIssueAssignment, *gitlab.Response, error)
+}
+
+// EpicBoardsService interface for GitLab Group Epic Board operations.
+type EpicBoardsService interface {
+	ListGroupEpicBoards(
+		gid interface{},
+		opt *gitlab.ListGroupEpicBoardsOptions,
+	) ([]*gitlab.GroupEpicBoard, *gitlab.Response, error)
+	GetGroupEpicBoard(gid interface{}, board int64) (*gitlab.GroupEpicBoard, *gitlab.Response, error)
+}
+
+// IterationsService interface for GitLab Group Iterations operations.
+type IterationsService interface {
+	ListGroupIterations(
+		gid interface{},
+		opt *gitlab.ListGroupIterationsOptions,
+	) ([]*gitlab.GroupIteration, *gitlab.Response, error)
+}
+
+// PipelinesService interface for GitLab Pipelines operations.
+type PipelinesService interface {
+	ListProjectPipelines(
+		pid interface{},
+		opt *gitlab.ListProjectPipelinesOptions,
+	) ([]*gitlab.PipelineInfo, *gitlab.Response, error)
+	GetPipeline(pid interface{}, pipeline int64) (*gitlab.Pipeline, *gitlab.Response, error)
+	CreatePipeline(pid interface{}, opt *gitlab.CreatePipelineOptions) (*gitlab.Pipeline, *gitlab.Response, error)
+	RetryPipelineBuild(pid interface{}, pipeline int64) (*gitlab.Pipeline, *gitlab.Response, error)
+	CancelPipelineBuild(pid interface{}, pipeline int64) (*gitlab.Pipeline, *gitlab.Response, error)
+	GetPipelineVariables(pid interface{}, pipeline int64) ([]*gitlab.PipelineVariable, *gitlab.Response, error)
+	GetLatestPipeline(pid interface{}, opt *gitlab.GetLatestPipelineOptions) (*gitlab.Pipeline, *gitlab.Response, error)
+}
+
+// JobsService interface for GitLab CI/CD Jobs operations.
+type JobsService interface {
+	ListPipelineJobs(
+		pid interface{},
+		pipeline int64,
+		opt *gitlab.ListJobsOptions,
+	) ([]*gitlab.Job, *gitlab.Response, error)
+	GetTraceFile(pid interface{}, job int64) (*bytes.Reader, *gitlab.Response, error)
+	CancelJob(pid interface{}, job int64) (*gitlab.Job, *gitlab.Response, error)
+	PlayJob(pid interface{}, job int64, opt *gitlab.PlayJobOptions) (*gitlab.Job, *gitlab.Response, error)
+}
+
+// ProjectHooksService interface for GitLab project webhook operations.
+type ProjectHooksService interface {
+	AddProjectHook(pid interface{}, opt *gitlab.AddProjectHookOptions) (*gitlab.ProjectHook, *gitlab.Response, error)
+	DeleteProjectHook(pid interface{}, hook int64) (*gitlab.Response, error)
+}
+
+// ReleasesService interface for GitLab Release operations.
+type ReleasesService interface {
+	ListReleases(pid interface{}, opt *gitlab.ListReleasesOptions) ([]*gitlab.Release, *gitlab.Response, error)
 }
 
 // GitLabClient interface that provides access to all GitLab services.
@@ -53,6 +333,20 @@ type GitLabClient interface {
 	Labels() LabelsService
 	Users() UsersService
 	Notes() NotesService
+	Discussions() DiscussionsService
+	TimeStats() TimeStatsService
 	MergeRequests() MergeRequestsService
+	MergeRequestApprovals() MergeRequestApprovalsService
 	Milestones() MilestonesService
-}
\ No newline at end of file
+	Groups() GroupsService
+	Repositories() RepositoriesService
+	Namespaces() NamespacesService
+	Epics() EpicsService
+	EpicIssues() EpicIssuesService
+	EpicBoards() EpicBoardsService
+	Iterations() IterationsService
+	Pipelines() PipelinesService
+	Jobs() JobsService
+	ProjectHooks() ProjectHooksService
+	Releases() ReleasesService
+}
@@ -0,0 +1,369 @@
+package app
+
+import (
+	"context"
+	"errors"
+
+	"github.com/sgaunet/gitlab-mcp/internal/gitlaberr"
+	"gitlab.com/gitlab-org/api/client-go"
+)
+
+// ErrNoteIDRequired is returned when a note operation is requested without a note ID.
+var ErrNoteIDRequired = errors.New("note id is required")
+
+// ErrTargetProjectRequired is returned when MoveProjectIssue is called without a target project.
+var ErrTargetProjectRequired = errors.New("target project id is required")
+
+// Todo represents a GitLab to-do item.
+type Todo struct {
+	ID         int64  `json:"id"`
+	ActionName string `json:"action_name"`
+	TargetType string `json:"target_type"`
+	TargetURL  string `json:"target_url"`
+	Body       string `json:"body"`
+	State      string `json:"state"`
+	CreatedAt  string `json:"created_at"`
+}
+
+// convertGitLabTodo converts a GitLab to-do item to our Todo struct.
+func convertGitLabTodo(todo *gitlab.Todo) Todo {
+	return Todo{
+		ID:         todo.ID,
+		ActionName: string(todo.ActionName),
+		TargetType: string(todo.TargetType),
+		TargetURL:  todo.TargetURL,
+		Body:       todo.Body,
+		State:      todo.State,
+		CreatedAt:  formatGitLabTime(todo.CreatedAt),
+	}
+}
+
+// DeleteProjectIssue deletes an issue from a project.
+func (a *App) DeleteProjectIssue(projectPath string, issueIID int64) error {
+	if issueIID <= 0 {
+		return ErrInvalidIssueIID
+	}
+
+	a.logger.Debug("Deleting issue", "project_path", projectPath, "issue_iid", issueIID)
+
+	project, err := a.getProjectCached(projectPath)
+	if err != nil {
+		a.logger.Error("Failed to get project", "error", err, "project_path", projectPath)
+		return err
+	}
+
+	if _, err := a.client.Issues().DeleteIssue(project.ID, int(issueIID)); err != nil {
+		a.logger.Error("Failed to delete issue", "error", err, "project_id", project.ID, "issue_iid", issueIID)
+		return gitlaberr.Classify(err, "failed to delete issue")
+	}
+
+	a.logger.Info("Successfully deleted issue", "project_id", project.ID, "issue_iid", issueIID)
+	return nil
+}
+
+// MoveProjectIssue moves an issue to a different project, identified by its numeric ID.
+func (a *App) MoveProjectIssue(projectPath string, issueIID int64, targetProjectID int64) (*Issue, error) {
+	if issueIID <= 0 {
+		return nil, ErrInvalidIssueIID
+	}
+	if targetProjectID <= 0 {
+		return nil, ErrTargetProjectRequired
+	}
+
+	a.logger.Debug("Moving issue", "project_path", projectPath, "issue_iid", issueIID,
+		"target_project_id", targetProjectID)
+
+	project, err := a.getProjectCached(projectPath)
+	if err != nil {
+		a.logger.Error("Failed to get project", "error", err, "project_path", projectPath)
+		return nil, err
+	}
+
+	opt := &gitlab.MoveIssueOptions{ToProjectID: gitlab.Ptr(int(targetProjectID))}
+	issue, _, err := retryCall(a, context.Background(), "MoveIssue",
+		func() (*gitlab.Issue, *gitlab.Response, error) {
+			return a.client.Issues().MoveIssue(project.ID, int(issueIID), opt)
+		})
+	if err != nil {
+		a.logger.Error("Failed to move issue", "error", err, "project_id", project.ID, "issue_iid", issueIID)
+		return nil, gitlaberr.Classify(err, "failed to move issue")
+	}
+
+	result := convertGitLabIssue(issue)
+	a.logger.Info("Successfully moved issue", "project_id", project.ID, "issue_iid", issueIID,
+		"target_project_id", targetProjectID)
+	return &result, nil
+}
+
+// SubscribeToIssue subscribes the current user to notifications for an issue.
+func (a *App) SubscribeToIssue(projectPath string, issueIID int64) (*Issue, error) {
+	if issueIID <= 0 {
+		return nil, ErrInvalidIssueIID
+	}
+
+	a.logger.Debug("Subscribing to issue", "project_path", projectPath, "issue_iid", issueIID)
+
+	project, err := a.getProjectCached(projectPath)
+	if err != nil {
+		a.logger.Error("Failed to get project", "error", err, "project_path", projectPath)
+		return nil, err
+	}
+
+	issue, _, err := retryCall(a, context.Background(), "SubscribeToIssue",
+		func() (*gitlab.Issue, *gitlab.Response, error) {
+			return a.client.Issues().SubscribeToIssue(project.ID, int(issueIID))
+		})
+	if err != nil {
+		a.logger.Error("Failed to subscribe to issue", "error", err, "project_id", project.ID, "issue_iid", issueIID)
+		return nil, gitlaberr.Classify(err, "failed to subscribe to issue")
+	}
+
+	result := convertGitLabIssue(issue)
+	a.logger.Info("Successfully subscribed to issue", "project_id", project.ID, "issue_iid", issueIID)
+	return &result, nil
+}
+
+// UnsubscribeFromIssue unsubscribes the current user from notifications for an issue.
+func (a *App) UnsubscribeFromIssue(projectPath string, issueIID int64) (*Issue, error) {
+	if issueIID <= 0 {
+		return nil, ErrInvalidIssueIID
+	}
+
+	a.logger.Debug("Unsubscribing from issue", "project_path", projectPath, "issue_iid", issueIID)
+
+	project, err := a.getProjectCached(projectPath)
+	if err != nil {
+		a.logger.Error("Failed to get project", "error", err, "project_path", projectPath)
+		return nil, err
+	}
+
+	issue, _, err := retryCall(a, context.Background(), "UnsubscribeFromIssue",
+		func() (*gitlab.Issue, *gitlab.Response, error) {
+			return a.client.Issues().UnsubscribeFromIssue(project.ID, int(issueIID))
+		})
+	if err != nil {
+		a.logger.Error("Failed to unsubscribe from issue", "error", err, "project_id", project.ID, "issue_iid", issueIID)
+		return nil, gitlaberr.Classify(err, "failed to unsubscribe from issue")
+	}
+
+	result := convertGitLabIssue(issue)
+	a.logger.Info("Successfully unsubscribed from issue", "project_id", project.ID, "issue_iid", issueIID)
+	return &result, nil
+}
+
+// CreateIssueTodo creates a to-do item for the current user on an issue.
+func (a *App) CreateIssueTodo(projectPath string, issueIID int64) (*Todo, error) {
+	if issueIID <= 0 {
+		return nil, ErrInvalidIssueIID
+	}
+
+	a.logger.Debug("Creating todo for issue", "project_path", projectPath, "issue_iid", issueIID)
+
+	project, err := a.getProjectCached(projectPath)
+	if err != nil {
+		a.logger.Error("Failed to get project", "error", err, "project_path", projectPath)
+		return nil, err
+	}
+
+	todo, _, err := retryCall(a, context.Background(), "CreateTodo",
+		func() (*gitlab.Todo, *gitlab.Response, error) {
+			return a.client.Issues().CreateTodo(project.ID, int(issueIID))
+		})
+	if err != nil {
+		a.logger.Error("Failed to create todo for issue", "error", err, "project_id", project.ID, "issue_iid", issueIID)
+		return nil, gitlaberr.Classify(err, "failed to create todo for issue")
+	}
+
+	result := convertGitLabTodo(todo)
+	a.logger.Info("Successfully created todo for issue", "project_id", project.ID, "issue_iid", issueIID)
+	return &result, nil
+}
+
+// ListInstanceIssues lists issues across the whole GitLab instance that the current user can see.
+func (a *App) ListInstanceIssues(opts *ListIssuesOptions) ([]Issue, error) {
+	a.logger.Debug("Listing instance-wide issues", "options", opts)
+
+	opts = normalizeListIssuesOptions(opts)
+
+	listOpts := &gitlab.ListIssuesOptions{
+		State:       &opts.State,
+		ListOptions: gitlab.ListOptions{PerPage: int(opts.Limit), Page: 1},
+	}
+	if opts.Labels != "" {
+		if labelList := parseLabels(opts.Labels); len(labelList) > 0 {
+			labels := gitlab.LabelOptions(labelList)
+			listOpts.Labels = &labels
+		}
+	}
+	if opts.IterationID != nil {
+		listOpts.IterationID = opts.IterationID
+	}
+
+	var issues []*gitlab.Issue
+	if opts.ListAll {
+		paginator := &Paginator[*gitlab.Issue]{
+			MaxItems: opts.MaxItems,
+			Fetch: func(cursor string) ([]*gitlab.Issue, *gitlab.Response, error) {
+				listOpts.Page = cursorToPage(cursor, listOpts.Page)
+				return retryCall(a, context.Background(), "ListIssues",
+					func() ([]*gitlab.Issue, *gitlab.Response, error) {
+						return a.client.Issues().ListIssues(listOpts)
+					})
+			},
+		}
+		result, err := paginator.FetchAll()
+		if err != nil {
+			a.logger.Error("Failed to list instance-wide issues", "error", err)
+			return nil, gitlaberr.Classify(err, "failed to list instance-wide issues")
+		}
+		issues = result.Items
+		opts.Truncated = result.Truncated
+	} else {
+		var err error
+		issues, _, err = retryCall(a, context.Background(), "ListIssues",
+			func() ([]*gitlab.Issue, *gitlab.Response, error) {
+				return a.client.Issues().ListIssues(listOpts)
+			})
+		if err != nil {
+			a.logger.Error("Failed to list instance-wide issues", "error", err)
+			return nil, gitlaberr.Classify(err, "failed to list instance-wide issues")
+		}
+	}
+
+	result := make([]Issue, 0, len(issues))
+	for _, issue := range issues {
+		result = append(result, convertGitLabIssue(issue))
+	}
+
+	a.logger.Info("Successfully retrieved instance-wide issues", "count", len(result))
+	return result, nil
+}
+
+// ListIssueNotes lists the notes/comments on an issue.
+func (a *App) ListIssueNotes(projectPath string, issueIID int64) ([]Note, error) {
+	if issueIID <= 0 {
+		return nil, ErrInvalidIssueIID
+	}
+
+	a.logger.Debug("Listing issue notes", "project_path", projectPath, "issue_iid", issueIID)
+
+	project, err := a.getProjectCached(projectPath)
+	if err != nil {
+		a.logger.Error("Failed to get project", "error", err, "project_path", projectPath)
+		return nil, err
+	}
+
+	notes, _, err := retryCall(a, context.Background(), "ListIssueNotes",
+		func() ([]*gitlab.Note, *gitlab.Response, error) {
+			return a.client.Notes().ListIssueNotes(project.ID, int(issueIID), nil)
+		})
+	if err != nil {
+		a.logger.Error("Failed to list issue notes", "error", err, "project_id", project.ID, "issue_iid", issueIID)
+		return nil, gitlaberr.Classify(err, "failed to list issue notes")
+	}
+
+	result := make([]Note, 0, len(notes))
+	for _, note := range notes {
+		result = append(result, convertGitLabNote(note))
+	}
+
+	a.logger.Info("Successfully retrieved issue notes", "count", len(result), "project_id", project.ID,
+		"issue_iid", issueIID)
+	return result, nil
+}
+
+// GetIssueNote fetches a single note on an issue by note ID.
+func (a *App) GetIssueNote(projectPath string, issueIID int64, noteID int64) (*Note, error) {
+	if issueIID <= 0 {
+		return nil, ErrInvalidIssueIID
+	}
+	if noteID <= 0 {
+		return nil, ErrNoteIDRequired
+	}
+
+	a.logger.Debug("Getting issue note", "project_path", projectPath, "issue_iid", issueIID, "note_id", noteID)
+
+	project, err := a.getProjectCached(projectPath)
+	if err != nil {
+		a.logger.Error("Failed to get project", "error", err, "project_path", projectPath)
+		return nil, err
+	}
+
+	note, _, err := retryCall(a, context.Background(), "GetIssueNote",
+		func() (*gitlab.Note, *gitlab.Response, error) {
+			return a.client.Notes().GetIssueNote(project.ID, int(issueIID), int(noteID))
+		})
+	if err != nil {
+		a.logger.Error("Failed to get issue note", "error", err, "project_id", project.ID, "issue_iid", issueIID,
+			"note_id", noteID)
+		return nil, gitlaberr.Classify(err, "failed to get issue note")
+	}
+
+	result := convertGitLabNote(note)
+	return &result, nil
+}
+
+// UpdateIssueNote updates the body of an existing note on an issue.
+func (a *App) UpdateIssueNote(projectPath string, issueIID int64, noteID int64, body string) (*Note, error) {
+	if issueIID <= 0 {
+		return nil, ErrInvalidIssueIID
+	}
+	if noteID <= 0 {
+		return nil, ErrNoteIDRequired
+	}
+	if body == "" {
+		return nil, ErrNoteBodyRequired
+	}
+
+	a.logger.Debug("Updating issue note", "project_path", projectPath, "issue_iid", issueIID, "note_id", noteID)
+
+	project, err := a.getProjectCached(projectPath)
+	if err != nil {
+		a.logger.Error("Failed to get project", "error", err, "project_path", projectPath)
+		return nil, err
+	}
+
+	opt := &gitlab.UpdateIssueNoteOptions{Body: &body}
+	note, _, err := retryCall(a, context.Background(), "UpdateIssueNote",
+		func() (*gitlab.Note, *gitlab.Response, error) {
+			return a.client.Notes().UpdateIssueNote(project.ID, int(issueIID), int(noteID), opt)
+		})
+	if err != nil {
+		a.logger.Error("Failed to update issue note", "error", err, "project_id", project.ID, "issue_iid", issueIID,
+			"note_id", noteID)
+		return nil, gitlaberr.Classify(err, "failed to update issue note")
+	}
+
+	result := convertGitLabNote(note)
+	a.logger.Info("Successfully updated issue note", "project_id", project.ID, "issue_iid", issueIID,
+		"note_id", noteID)
+	return &result, nil
+}
+
+// DeleteIssueNote deletes a note from an issue.
+func (a *App) DeleteIssueNote(projectPath string, issueIID int64, noteID int64) error {
+	if issueIID <= 0 {
+		return ErrInvalidIssueIID
+	}
+	if noteID <= 0 {
+		return ErrNoteIDRequired
+	}
+
+	a.logger.Debug("Deleting issue note", "project_path", projectPath, "issue_iid", issueIID, "note_id", noteID)
+
+	project, err := a.getProjectCached(projectPath)
+	if err != nil {
+		a.logger.Error("Failed to get project", "error", err, "project_path", projectPath)
+		return err
+	}
+
+	if _, err := a.client.Notes().DeleteIssueNote(project.ID, int(issueIID), int(noteID)); err != nil {
+		a.logger.Error("Failed to delete issue note", "error", err, "project_id", project.ID, "issue_iid", issueIID,
+			"note_id", noteID)
+		return gitlaberr.Classify(err, "failed to delete issue note")
+	}
+
+	a.logger.Info("Successfully deleted issue note", "project_id", project.ID, "issue_iid", issueIID,
+		"note_id", noteID)
+	return nil
+}
@@ -0,0 +1,47 @@
+package app
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// ErrInvalidIssueRef is returned when a string passed to ParseIssueRef is not a recognized issue
+// reference.
+var ErrInvalidIssueRef = errors.New("not a valid issue reference")
+
+// issueURLPattern matches a full GitLab issue URL, e.g.
+// https://gitlab.com/namespace/subgroup/project/-/issues/42.
+var issueURLPattern = regexp.MustCompile(`^https?://[^/]+/(.+)/-/issues/(\d+)/?$`)
+
+// ParseIssueRef normalizes a GitLab issue reference into a (projectPath, issueIID) pair. ref may
+// be a full issue URL (https://gitlab.com/namespace/project/-/issues/42) or a short reference
+// (namespace/project#42). This lets MCP tools accept whatever shape the model pastes in instead of
+// requiring it to split project_path and issue_iid itself.
+func ParseIssueRef(ref string) (projectPath string, issueIID int64, err error) {
+	ref = strings.TrimSpace(ref)
+	if ref == "" {
+		return "", 0, ErrInvalidIssueRef
+	}
+
+	if match := issueURLPattern.FindStringSubmatch(ref); match != nil {
+		iid, convErr := strconv.ParseInt(match[2], 10, 64)
+		if convErr != nil {
+			return "", 0, fmt.Errorf("%w: %q", ErrInvalidIssueRef, ref)
+		}
+		return match[1], iid, nil
+	}
+
+	if idx := strings.LastIndex(ref, "#"); idx > 0 {
+		path, iidStr := ref[:idx], ref[idx+1:]
+		iid, convErr := strconv.ParseInt(iidStr, 10, 64)
+		if convErr != nil || path == "" {
+			return "", 0, fmt.Errorf("%w: %q", ErrInvalidIssueRef, ref)
+		}
+		return path, iid, nil
+	}
+
+	return "", 0, fmt.Errorf("%w: %q", ErrInvalidIssueRef, ref)
+}
@@ -0,0 +1,77 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseIssueRef(t *testing.T) {
+	tests := []struct {
+		name     string
+		ref      string
+		wantPath string
+		wantIID  int64
+		wantErr  bool
+	}{
+		{
+			name:     "full url",
+			ref:      "https://gitlab.com/namespace/project/-/issues/42",
+			wantPath: "namespace/project",
+			wantIID:  42,
+		},
+		{
+			name:     "full url with subgroup",
+			ref:      "https://gitlab.example.com/group/subgroup/project/-/issues/7",
+			wantPath: "group/subgroup/project",
+			wantIID:  7,
+		},
+		{
+			name:     "short reference",
+			ref:      "namespace/project#42",
+			wantPath: "namespace/project",
+			wantIID:  42,
+		},
+		{
+			name:     "short reference with subgroup",
+			ref:      "group/subgroup/project#7",
+			wantPath: "group/subgroup/project",
+			wantIID:  7,
+		},
+		{
+			name:    "empty ref",
+			ref:     "",
+			wantErr: true,
+		},
+		{
+			name:    "missing iid",
+			ref:     "namespace/project#",
+			wantErr: true,
+		},
+		{
+			name:    "missing path",
+			ref:     "#42",
+			wantErr: true,
+		},
+		{
+			name:    "garbage",
+			ref:     "not an issue reference",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotPath, gotIID, err := ParseIssueRef(tt.ref)
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, ErrInvalidIssueRef)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantPath, gotPath)
+			assert.Equal(t, tt.wantIID, gotIID)
+		})
+	}
+}
@@ -0,0 +1,121 @@
+package app
+
+import (
+	"errors"
+	"fmt"
+
+	"gitlab.com/gitlab-org/api/client-go"
+)
+
+// ErrIterationNotFound is returned when an iteration title can't be matched to any of the
+// owning group's iterations.
+var ErrIterationNotFound = errors.New("iteration not found")
+
+// iterationResolverKey builds the resolver cache key's name field for a (groupPath, title) pair,
+// since iterations are resolved against a group rather than a project.
+func iterationResolverKey(groupPath, title string) string {
+	return groupPath + "\x00" + title
+}
+
+// resolveIterationIdentifier resolves title to an iteration ID against projectPath's ancestor
+// group, for ListProjectIssues' IterationTitle filter.
+func (a *App) resolveIterationIdentifier(projectPath, title string) (int64, error) {
+	groupPath, err := extractGroupPath(projectPath)
+	if err != nil {
+		return 0, fmt.Errorf("cannot resolve iteration title for a top-level project: %w", err)
+	}
+	return a.findIterationByTitle(groupPath, title)
+}
+
+// findIterationByTitle searches groupPath's iterations for one named title and returns its ID,
+// serving from the resolver cache when available so that repeated lookups of the same iteration
+// across a batch flow don't each issue a ListGroupIterations round-trip.
+func (a *App) findIterationByTitle(groupPath, title string) (int64, error) {
+	key := resolverCacheKey{kind: resolverKindIteration, name: iterationResolverKey(groupPath, title)}
+	if cached, ok := a.resolvers.get(key); ok {
+		if cached.err != nil {
+			return 0, cached.err
+		}
+		if id, ok := cached.value.(int64); ok {
+			a.logger.Debug("Resolver cache hit", "kind", "iteration", "group_path", groupPath, "title", title)
+			return id, nil
+		}
+	}
+
+	a.logger.Debug("Searching for iteration by title", "group_path", groupPath, "title", title)
+
+	iterations, err := a.ListGroupIterations(groupPath, "")
+	if err != nil {
+		a.resolvers.setFailure(key, err)
+		return 0, err
+	}
+
+	for _, iteration := range iterations {
+		if iteration.Title == title {
+			a.resolvers.setSuccess(key, iteration.ID)
+			return iteration.ID, nil
+		}
+	}
+
+	notFoundErr := fmt.Errorf("%w: %s", ErrIterationNotFound, title)
+	a.resolvers.setFailure(key, notFoundErr)
+	return 0, notFoundErr
+}
+
+// Iteration represents a GitLab iteration, a fixed-length timebox that issues can be scheduled into.
+type Iteration struct {
+	ID        int64  `json:"id"`
+	Title     string `json:"title"`
+	State     string `json:"state"`
+	StartDate string `json:"start_date,omitempty"`
+	DueDate   string `json:"due_date,omitempty"`
+}
+
+// convertGitLabIteration converts a GitLab iteration to our Iteration struct.
+func convertGitLabIteration(iteration *gitlab.GroupIteration) Iteration {
+	result := Iteration{
+		ID:    iteration.ID,
+		Title: iteration.Title,
+		State: iteration.State,
+	}
+
+	if iteration.StartDate != nil {
+		result.StartDate = iteration.StartDate.String()
+	}
+	if iteration.DueDate != nil {
+		result.DueDate = iteration.DueDate.String()
+	}
+
+	return result
+}
+
+// ListGroupIterations retrieves iterations for a given group path, optionally filtered by state
+// (e.g. "opened", "upcoming", "current", "closed", "all").
+func (a *App) ListGroupIterations(groupPath string, state string) ([]Iteration, error) {
+	a.logger.Debug("Listing iterations for group", "group_path", groupPath, "state", state)
+
+	group, err := a.getGroupCached(groupPath)
+	if err != nil {
+		a.logger.Error("Failed to get group", "error", err, "group_path", groupPath)
+		return nil, err
+	}
+
+	opts := &gitlab.ListGroupIterationsOptions{}
+	if state != "" {
+		opts.State = &state
+	}
+
+	iterations, _, err := a.client.Iterations().ListGroupIterations(group.ID, opts)
+	if err != nil {
+		a.logger.Error("Failed to list group iterations", "error", err, "group_id", group.ID)
+		return nil, fmt.Errorf("failed to list group iterations: %w", err)
+	}
+
+	result := make([]Iteration, 0, len(iterations))
+	for _, iteration := range iterations {
+		result = append(result, convertGitLabIteration(iteration))
+	}
+
+	a.logger.Info("Successfully retrieved group iterations", "count", len(result), "group_id", group.ID)
+	return result, nil
+}
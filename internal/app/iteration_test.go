@@ -0,0 +1,253 @@
+package app
+
+import (
+	"log/slog"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"gitlab.com/gitlab-org/api/client-go"
+)
+
+// TestConvertGitLabIteration tests the convertGitLabIteration function.
+func TestConvertGitLabIteration(t *testing.T) {
+	startDate := gitlab.ISOTime{}
+	dueDate := gitlab.ISOTime{}
+
+	iteration := &gitlab.GroupIteration{
+		ID:        1,
+		Title:     "Sprint 1",
+		State:     "current",
+		StartDate: &startDate,
+		DueDate:   &dueDate,
+	}
+
+	got := convertGitLabIteration(iteration)
+
+	assert.Equal(t, int64(1), got.ID)
+	assert.Equal(t, "Sprint 1", got.Title)
+}
+
+// TestApp_ListGroupIterations tests the App.ListGroupIterations method.
+func TestApp_ListGroupIterations(t *testing.T) {
+	tests := []struct {
+		name      string
+		groupPath string
+		state     string
+		setup     func(*MockGitLabClient, *MockGroupsService, *MockIterationsService)
+		wantErr   bool
+	}{
+		{
+			name:      "success",
+			groupPath: "test/group",
+			state:     "current",
+			setup: func(client *MockGitLabClient, groups *MockGroupsService, iterations *MockIterationsService) {
+				client.On("Groups").Return(groups)
+				client.On("Iterations").Return(iterations)
+
+				groups.On("GetGroup", "test/group", (*gitlab.GetGroupOptions)(nil)).Return(
+					&gitlab.Group{ID: 456}, &gitlab.Response{}, nil,
+				)
+
+				state := "current"
+				iterations.On("ListGroupIterations", int64(456), &gitlab.ListGroupIterationsOptions{State: &state}).Return(
+					[]*gitlab.GroupIteration{{ID: 1, Title: "Sprint 1"}}, &gitlab.Response{}, nil,
+				)
+			},
+			wantErr: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := new(MockGitLabClient)
+			mockGroups := new(MockGroupsService)
+			mockIterations := new(MockIterationsService)
+			tt.setup(mockClient, mockGroups, mockIterations)
+
+			app := NewWithClient("token", "https://gitlab.com/", mockClient)
+			app.SetLogger(slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelError})))
+
+			got, err := app.ListGroupIterations(tt.groupPath, tt.state)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Len(t, got, 1)
+			assert.Equal(t, "Sprint 1", got[0].Title)
+		})
+	}
+}
+
+// TestApp_FindIterationByTitle tests the App.findIterationByTitle resolver.
+func TestApp_FindIterationByTitle(t *testing.T) {
+	tests := []struct {
+		name      string
+		groupPath string
+		title     string
+		setup     func(*MockGitLabClient, *MockGroupsService, *MockIterationsService)
+		wantID    int64
+		wantErr   bool
+	}{
+		{
+			name:      "found",
+			groupPath: "test/group",
+			title:     "Sprint 2",
+			setup: func(client *MockGitLabClient, groups *MockGroupsService, iterations *MockIterationsService) {
+				client.On("Groups").Return(groups)
+				client.On("Iterations").Return(iterations)
+
+				groups.On("GetGroup", "test/group", (*gitlab.GetGroupOptions)(nil)).Return(
+					&gitlab.Group{ID: 456}, &gitlab.Response{}, nil,
+				)
+
+				iterations.On("ListGroupIterations", int64(456), &gitlab.ListGroupIterationsOptions{}).Return(
+					[]*gitlab.GroupIteration{
+						{ID: 1, Title: "Sprint 1"},
+						{ID: 2, Title: "Sprint 2"},
+					},
+					&gitlab.Response{}, nil,
+				)
+			},
+			wantID:  2,
+			wantErr: false,
+		},
+		{
+			name:      "not found",
+			groupPath: "test/group",
+			title:     "Sprint 99",
+			setup: func(client *MockGitLabClient, groups *MockGroupsService, iterations *MockIterationsService) {
+				client.On("Groups").Return(groups)
+				client.On("Iterations").Return(iterations)
+
+				groups.On("GetGroup", "test/group", (*gitlab.GetGroupOptions)(nil)).Return(
+					&gitlab.Group{ID: 456}, &gitlab.Response{}, nil,
+				)
+
+				iterations.On("ListGroupIterations", int64(456), &gitlab.ListGroupIterationsOptions{}).Return(
+					[]*gitlab.GroupIteration{{ID: 1, Title: "Sprint 1"}}, &gitlab.Response{}, nil,
+				)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := new(MockGitLabClient)
+			mockGroups := new(MockGroupsService)
+			mockIterations := new(MockIterationsService)
+			tt.setup(mockClient, mockGroups, mockIterations)
+
+			app := NewWithClient("token", "https://gitlab.com/", mockClient)
+			app.SetLogger(slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelError})))
+
+			got, err := app.findIterationByTitle(tt.groupPath, tt.title)
+
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrIterationNotFound)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantID, got)
+		})
+	}
+}
+
+// TestApp_ResolveIterationIdentifier tests the App.resolveIterationIdentifier helper used by
+// ListProjectIssues' IterationTitle filter.
+func TestApp_ResolveIterationIdentifier(t *testing.T) {
+	t.Run("top-level project has no group to resolve against", func(t *testing.T) {
+		app := NewWithClient("token", "https://gitlab.com/", new(MockGitLabClient))
+
+		_, err := app.resolveIterationIdentifier("standalone", "Sprint 1")
+
+		assert.ErrorIs(t, err, ErrProjectPathNotNested)
+	})
+
+	t.Run("resolves against the project's ancestor group", func(t *testing.T) {
+		mockClient := new(MockGitLabClient)
+		mockGroups := new(MockGroupsService)
+		mockIterations := new(MockIterationsService)
+
+		mockClient.On("Groups").Return(mockGroups)
+		mockClient.On("Iterations").Return(mockIterations)
+
+		mockGroups.On("GetGroup", "myorg/team", (*gitlab.GetGroupOptions)(nil)).Return(
+			&gitlab.Group{ID: 789}, &gitlab.Response{}, nil,
+		)
+		mockIterations.On("ListGroupIterations", int64(789), &gitlab.ListGroupIterationsOptions{}).Return(
+			[]*gitlab.GroupIteration{{ID: 42, Title: "Sprint 1"}}, &gitlab.Response{}, nil,
+		)
+
+		app := NewWithClient("token", "https://gitlab.com/", mockClient)
+		app.SetLogger(slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelError})))
+
+		got, err := app.resolveIterationIdentifier("myorg/team/project", "Sprint 1")
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(42), got)
+	})
+}
+
+// TestApp_ListProjectIssues_IterationTitle verifies that ListProjectIssues resolves an
+// IterationTitle filter to an ID before calling GitLab, analogous to TestListProjectIssuesWithGroupIssues.
+func TestApp_ListProjectIssues_IterationTitle(t *testing.T) {
+	testTime := time.Now()
+
+	mockClient := new(MockGitLabClient)
+	mockProjects := new(MockProjectsService)
+	mockIssues := new(MockIssuesService)
+	mockGroups := new(MockGroupsService)
+	mockIterations := new(MockIterationsService)
+
+	mockClient.On("Projects").Return(mockProjects)
+	mockClient.On("Issues").Return(mockIssues)
+	mockClient.On("Groups").Return(mockGroups)
+	mockClient.On("Iterations").Return(mockIterations)
+
+	mockProjects.On("GetProject", "myorg/team/project", (*gitlab.GetProjectOptions)(nil)).Return(
+		&gitlab.Project{ID: 123}, &gitlab.Response{}, nil,
+	)
+	mockGroups.On("GetGroup", "myorg/team", (*gitlab.GetGroupOptions)(nil)).Return(
+		&gitlab.Group{ID: 789}, &gitlab.Response{}, nil,
+	)
+	mockIterations.On("ListGroupIterations", int64(789), &gitlab.ListGroupIterationsOptions{}).Return(
+		[]*gitlab.GroupIteration{{ID: 42, Title: "Sprint 1"}}, &gitlab.Response{}, nil,
+	)
+
+	expectedIterationID := int64(42)
+	expectedOpts := &gitlab.ListProjectIssuesOptions{
+		State:       gitlab.Ptr("opened"),
+		ListOptions: gitlab.ListOptions{PerPage: 100, Page: 1},
+		IterationID: &expectedIterationID,
+	}
+	mockIssues.On("ListProjectIssues", int64(123), expectedOpts).Return(
+		[]*gitlab.Issue{
+			{ID: 1, IID: 1, ProjectID: 123, Title: "Sprint issue", State: "opened", CreatedAt: &testTime, UpdatedAt: &testTime},
+		},
+		&gitlab.Response{}, nil,
+	)
+
+	app := NewWithClient("token", "https://gitlab.com/", mockClient)
+	app.SetLogger(slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelError})))
+
+	title := "Sprint 1"
+	issues, err := app.ListProjectIssues("myorg/team/project", &ListIssuesOptions{
+		State: "opened", Limit: 100, IterationTitle: &title,
+	})
+
+	assert.NoError(t, err)
+	assert.Len(t, issues, 1)
+
+	mockClient.AssertExpectations(t)
+	mockProjects.AssertExpectations(t)
+	mockIssues.AssertExpectations(t)
+	mockGroups.AssertExpectations(t)
+	mockIterations.AssertExpectations(t)
+}
@@ -0,0 +1,159 @@
+package app
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// IssueRef is a single issue or merge request reference found in free text by
+// ExtractIssueReferences.
+type IssueRef struct {
+	// Project is the reference's own "group/project" prefix, or defaultProject when the
+	// reference didn't carry one.
+	Project string
+	IID     int64
+	// Verb is the closing verb the reference appeared after ("closes", "fixes", "resolved", ...),
+	// lowercased, or "" when the reference is a bare mention with no closing verb.
+	Verb string
+	// Raw is the exact reference text matched, e.g. "#42" or "group/project#123".
+	Raw string
+}
+
+// closingVerbAlternation lists the verb forms GitLab itself recognizes as closing a linked issue.
+const closingVerbAlternation = `closes?|closed|closing|fix(?:e[ds])?|fixing|resolves?|resolved|resolving`
+
+// closingRefListPattern matches a closing verb immediately followed by a comma/and/&-separated
+// list of issue references, e.g. "Closes #1, #2 and #3" or "Fixes group/project#42".
+var closingRefListPattern = regexp.MustCompile(
+	`(?i)\b(` + closingVerbAlternation + `)\b((?:\s*(?:,|and|&)?\s*[\w.-]*(?:/[\w.-]+)*[#!]\d+)+)`,
+)
+
+// issueRefPattern matches a single issue or merge request reference, optionally qualified with a
+// "group/project" or "group/subgroup/project" prefix.
+var issueRefPattern = regexp.MustCompile(`([\w.-]+(?:/[\w.-]+)*)?[#!](\d+)`)
+
+// ExtractIssueReferences scans text (an issue or merge request description or comment) for issue
+// references: both closing references ("Closes #1, #2 and #3", "Fixes group/project#42") and bare
+// mentions ("see #7"). defaultProject is used for any reference that doesn't carry its own
+// "group/project" prefix. Results are deduplicated by (Project, IID, Verb) while preserving the
+// order references first appear in text, so the same issue appears more than once only when it is
+// matched with different verbs (for example after a closing verb and again as a bare mention).
+func ExtractIssueReferences(text string, defaultProject string) []IssueRef {
+	var refs []IssueRef
+	seen := make(map[string]bool)
+	var consumed [][2]int
+
+	addRef := func(verb, project, raw string, iid int64) {
+		if project == "" {
+			project = defaultProject
+		}
+		key := project + "\x00" + strconv.FormatInt(iid, 10) + "\x00" + verb
+		if seen[key] {
+			return
+		}
+		seen[key] = true
+		refs = append(refs, IssueRef{Project: project, IID: iid, Verb: verb, Raw: raw})
+	}
+
+	for _, clause := range closingRefListPattern.FindAllStringSubmatchIndex(text, -1) {
+		verb := strings.ToLower(text[clause[2]:clause[3]])
+		listStart, listEnd := clause[4], clause[5]
+		consumed = append(consumed, [2]int{clause[0], clause[1]})
+
+		for _, token := range issueRefPattern.FindAllStringSubmatchIndex(text[listStart:listEnd], -1) {
+			project := ""
+			if token[2] != -1 {
+				project = text[listStart+token[2] : listStart+token[3]]
+			}
+			iid, err := strconv.ParseInt(text[listStart+token[4]:listStart+token[5]], 10, 64)
+			if err != nil {
+				continue
+			}
+			raw := text[listStart+token[0] : listStart+token[1]]
+			addRef(verb, project, raw, iid)
+		}
+	}
+
+	for _, token := range issueRefPattern.FindAllStringSubmatchIndex(text, -1) {
+		if withinAnyRange(consumed, token[0], token[1]) {
+			continue
+		}
+		project := ""
+		if token[2] != -1 {
+			project = text[token[2]:token[3]]
+		}
+		iid, err := strconv.ParseInt(text[token[4]:token[5]], 10, 64)
+		if err != nil {
+			continue
+		}
+		raw := text[token[0]:token[1]]
+		addRef("", project, raw, iid)
+	}
+
+	return refs
+}
+
+// withinAnyRange reports whether [start, end) falls entirely inside one of ranges.
+func withinAnyRange(ranges [][2]int, start, end int) bool {
+	for _, r := range ranges {
+		if start >= r[0] && end <= r[1] {
+			return true
+		}
+	}
+	return false
+}
+
+// GetLinkedIssues scans a resource's description and comment thread for issue/MR references,
+// returning the deduplicated list in order of first appearance. kind selects whether
+// resourceIID identifies an issue (ResourceKindIssue) or a merge request (ResourceKindMergeRequest);
+// any other kind returns ErrUnknownResourceURI.
+func (a *App) GetLinkedIssues(projectPath string, kind ResourceKind, resourceIID int64) ([]IssueRef, error) {
+	var description string
+	var notes []Note
+
+	switch kind {
+	case ResourceKindIssue:
+		issue, err := a.GetProjectIssue(projectPath, resourceIID)
+		if err != nil {
+			return nil, err
+		}
+		description = issue.Description
+		notes, err = a.ListIssueNotes(projectPath, resourceIID)
+		if err != nil {
+			return nil, err
+		}
+	case ResourceKindMergeRequest:
+		mr, err := a.GetProjectMergeRequest(projectPath, resourceIID)
+		if err != nil {
+			return nil, err
+		}
+		description = mr.Description
+		notes, err = a.ListMergeRequestNotes(projectPath, resourceIID)
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, ErrUnknownResourceURI
+	}
+
+	var refs []IssueRef
+	seen := make(map[string]bool)
+	collect := func(text string) {
+		for _, ref := range ExtractIssueReferences(text, projectPath) {
+			key := ref.Project + "\x00" + strconv.FormatInt(ref.IID, 10) + "\x00" + ref.Verb
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			refs = append(refs, ref)
+		}
+	}
+
+	collect(description)
+	for _, note := range notes {
+		collect(note.Body)
+	}
+
+	return refs, nil
+}
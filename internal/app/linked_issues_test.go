@@ -0,0 +1,138 @@
+package app
+
+import (
+	"log/slog"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gitlab.com/gitlab-org/api/client-go"
+)
+
+func TestExtractIssueReferences(t *testing.T) {
+	tests := []struct {
+		name           string
+		text           string
+		defaultProject string
+		want           []IssueRef
+	}{
+		{
+			name:           "single closing reference",
+			text:           "Closes #42",
+			defaultProject: "group/project",
+			want: []IssueRef{
+				{Project: "group/project", IID: 42, Verb: "closes", Raw: "#42"},
+			},
+		},
+		{
+			name:           "comma and and separated list",
+			text:           "Closes #1, #2 and #3",
+			defaultProject: "group/project",
+			want: []IssueRef{
+				{Project: "group/project", IID: 1, Verb: "closes", Raw: "#1"},
+				{Project: "group/project", IID: 2, Verb: "closes", Raw: "#2"},
+				{Project: "group/project", IID: 3, Verb: "closes", Raw: "#3"},
+			},
+		},
+		{
+			name:           "cross-project reference",
+			text:           "Resolves group/other#7",
+			defaultProject: "group/project",
+			want: []IssueRef{
+				{Project: "group/other", IID: 7, Verb: "resolves", Raw: "group/other#7"},
+			},
+		},
+		{
+			name:           "case-insensitive verb",
+			text:           "FIXED #9",
+			defaultProject: "group/project",
+			want: []IssueRef{
+				{Project: "group/project", IID: 9, Verb: "fixed", Raw: "#9"},
+			},
+		},
+		{
+			name:           "bare mention has no verb",
+			text:           "see #5 for context",
+			defaultProject: "group/project",
+			want: []IssueRef{
+				{Project: "group/project", IID: 5, Verb: "", Raw: "#5"},
+			},
+		},
+		{
+			name:           "closing reference and separate mention both kept",
+			text:           "Closes #1. Also related to #2.",
+			defaultProject: "group/project",
+			want: []IssueRef{
+				{Project: "group/project", IID: 1, Verb: "closes", Raw: "#1"},
+				{Project: "group/project", IID: 2, Verb: "", Raw: "#2"},
+			},
+		},
+		{
+			name:           "duplicate references are deduplicated preserving order",
+			text:           "Closes #1. Closes #1 again.",
+			defaultProject: "group/project",
+			want: []IssueRef{
+				{Project: "group/project", IID: 1, Verb: "closes", Raw: "#1"},
+			},
+		},
+		{
+			name:           "merge request bang reference",
+			text:           "Fixes !3",
+			defaultProject: "group/project",
+			want: []IssueRef{
+				{Project: "group/project", IID: 3, Verb: "fixes", Raw: "!3"},
+			},
+		},
+		{
+			name:           "no references",
+			text:           "Just a plain description with no links.",
+			defaultProject: "group/project",
+			want:           nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ExtractIssueReferences(tt.text, tt.defaultProject)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestApp_GetLinkedIssues(t *testing.T) {
+	mockClient := &MockGitLabClient{}
+	mockProjects := &MockProjectsService{}
+	mockIssues := &MockIssuesService{}
+	mockNotes := &MockNotesService{}
+
+	mockClient.On("Projects").Return(mockProjects)
+	mockClient.On("Issues").Return(mockIssues)
+	mockClient.On("Notes").Return(mockNotes)
+
+	mockProjects.On("GetProject", "group/project", (*gitlab.GetProjectOptions)(nil)).Return(
+		&gitlab.Project{ID: 123}, &gitlab.Response{}, nil,
+	)
+	mockIssues.On("GetIssue", int64(123), 5).Return(
+		&gitlab.Issue{ID: 1, IID: 5, Description: "Closes #1 and #2"}, &gitlab.Response{}, nil,
+	)
+	mockNotes.On("ListIssueNotes", int64(123), 5, (*gitlab.ListIssueNotesOptions)(nil)).Return(
+		[]*gitlab.Note{{ID: 1, Body: "also see #3"}}, &gitlab.Response{}, nil,
+	)
+
+	app := NewWithClient("token", "https://gitlab.com/", mockClient)
+	app.SetLogger(slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelError})))
+
+	refs, err := app.GetLinkedIssues("group/project", ResourceKindIssue, 5)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []IssueRef{
+		{Project: "group/project", IID: 1, Verb: "closes", Raw: "#1"},
+		{Project: "group/project", IID: 2, Verb: "closes", Raw: "#2"},
+		{Project: "group/project", IID: 3, Verb: "", Raw: "#3"},
+	}, refs)
+
+	mockClient.AssertExpectations(t)
+	mockProjects.AssertExpectations(t)
+	mockIssues.AssertExpectations(t)
+	mockNotes.AssertExpectations(t)
+}
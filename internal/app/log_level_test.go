@@ -0,0 +1,42 @@
+package app
+
+import (
+	"bytes"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestApp_SetLogLevel tests that SetLogLevel validates its input, reports the previous level,
+// and that the change immediately affects which records the shared logger emits.
+func TestApp_SetLogLevel(t *testing.T) {
+	var levelVar slog.LevelVar
+	levelVar.Set(slog.LevelInfo)
+
+	var buf bytes.Buffer
+	testLogger := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: &levelVar}))
+
+	app := NewWithClient("token", "https://gitlab.com/", &MockGitLabClient{})
+	app.SetLogger(testLogger)
+	app.SetLogLevelVar(&levelVar)
+
+	assert.Equal(t, "info", app.GetLogLevel())
+
+	app.logger.Debug("should not be emitted at info level")
+	assert.Empty(t, buf.String())
+
+	previous, err := app.SetLogLevel("debug")
+	require.NoError(t, err)
+	assert.Equal(t, "info", previous)
+	assert.Equal(t, "debug", app.GetLogLevel())
+
+	buf.Reset()
+	app.logger.Debug("should now be emitted at debug level")
+	assert.NotEmpty(t, buf.String())
+
+	_, err = app.SetLogLevel("not-a-level")
+	require.ErrorIs(t, err, ErrInvalidLogLevel)
+	assert.Equal(t, "debug", app.GetLogLevel(), "an invalid level must not change the current level")
+}
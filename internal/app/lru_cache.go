@@ -0,0 +1,111 @@
+package app
+
+import (
+	"container/list"
+	"sync"
+	"time"
+)
+
+// defaultCacheCapacity is the number of entries the default in-memory cache retains.
+const defaultCacheCapacity = 256
+
+// lruCacheItem is a single entry stored in LRUCache's internal list.
+type lruCacheItem struct {
+	key       string
+	entry     CacheEntry
+	expiresAt time.Time
+}
+
+// LRUCache is an in-memory, fixed-capacity Cache implementation with per-entry TTL expiry and
+// least-recently-used eviction once capacity is exceeded.
+type LRUCache struct {
+	mu       sync.Mutex
+	capacity int
+	items    map[string]*list.Element
+	order    *list.List
+	hits     int64
+	misses   int64
+}
+
+// NewLRUCache creates an LRUCache that holds at most capacity entries.
+func NewLRUCache(capacity int) *LRUCache {
+	if capacity <= 0 {
+		capacity = defaultCacheCapacity
+	}
+	return &LRUCache{
+		capacity: capacity,
+		items:    make(map[string]*list.Element),
+		order:    list.New(),
+	}
+}
+
+// Get returns the cached entry for key if present and not expired.
+func (c *LRUCache) Get(key string) (CacheEntry, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.items[key]
+	if !ok {
+		c.misses++
+		return CacheEntry{}, false
+	}
+
+	item, _ := elem.Value.(*lruCacheItem)
+	if time.Now().After(item.expiresAt) {
+		c.order.Remove(elem)
+		delete(c.items, key)
+		c.misses++
+		return CacheEntry{}, false
+	}
+
+	c.order.MoveToFront(elem)
+	c.hits++
+	return item.entry, true
+}
+
+// Set stores entry under key with the given TTL, evicting the least-recently-used entry if the
+// cache is at capacity.
+func (c *LRUCache) Set(key string, entry CacheEntry, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, ok := c.items[key]; ok {
+		item, _ := elem.Value.(*lruCacheItem)
+		item.entry = entry
+		item.expiresAt = time.Now().Add(ttl)
+		c.order.MoveToFront(elem)
+		return
+	}
+
+	item := &lruCacheItem{key: key, entry: entry, expiresAt: time.Now().Add(ttl)}
+	elem := c.order.PushFront(item)
+	c.items[key] = elem
+
+	if c.order.Len() > c.capacity {
+		oldest := c.order.Back()
+		if oldest != nil {
+			c.order.Remove(oldest)
+			if oldestItem, ok := oldest.Value.(*lruCacheItem); ok {
+				delete(c.items, oldestItem.key)
+			}
+		}
+	}
+}
+
+// Delete removes key from the cache, if present.
+func (c *LRUCache) Delete(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, ok := c.items[key]; ok {
+		c.order.Remove(elem)
+		delete(c.items, key)
+	}
+}
+
+// Stats returns the cumulative hit/miss counts for this cache.
+func (c *LRUCache) Stats() (hits int64, misses int64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.hits, c.misses
+}
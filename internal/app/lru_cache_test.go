@@ -0,0 +1,76 @@
+package app
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestLRUCache_GetSet tests basic Get/Set round-tripping.
+func TestLRUCache_GetSet(t *testing.T) {
+	c := NewLRUCache(2)
+
+	_, ok := c.Get("missing")
+	assert.False(t, ok)
+
+	c.Set("a", CacheEntry{Value: "a-value"}, time.Minute)
+	entry, ok := c.Get("a")
+	assert.True(t, ok)
+	assert.Equal(t, "a-value", entry.Value)
+}
+
+// TestLRUCache_TTLExpiry tests that an entry is no longer served once its TTL has elapsed.
+func TestLRUCache_TTLExpiry(t *testing.T) {
+	c := NewLRUCache(2)
+
+	c.Set("a", CacheEntry{Value: "a-value"}, -time.Second)
+	_, ok := c.Get("a")
+	assert.False(t, ok, "expired entry should not be served")
+}
+
+// TestLRUCache_EvictsLeastRecentlyUsed tests that adding beyond capacity evicts the LRU entry.
+func TestLRUCache_EvictsLeastRecentlyUsed(t *testing.T) {
+	c := NewLRUCache(2)
+
+	c.Set("a", CacheEntry{Value: "a-value"}, time.Minute)
+	c.Set("b", CacheEntry{Value: "b-value"}, time.Minute)
+
+	// Touch "a" so "b" becomes the least-recently-used entry.
+	_, _ = c.Get("a")
+
+	c.Set("c", CacheEntry{Value: "c-value"}, time.Minute)
+
+	_, ok := c.Get("b")
+	assert.False(t, ok, "b should have been evicted")
+
+	_, ok = c.Get("a")
+	assert.True(t, ok, "a should still be present")
+
+	_, ok = c.Get("c")
+	assert.True(t, ok, "c should still be present")
+}
+
+// TestLRUCache_Delete tests that Delete removes an entry.
+func TestLRUCache_Delete(t *testing.T) {
+	c := NewLRUCache(2)
+
+	c.Set("a", CacheEntry{Value: "a-value"}, time.Minute)
+	c.Delete("a")
+
+	_, ok := c.Get("a")
+	assert.False(t, ok)
+}
+
+// TestLRUCache_Stats tests that hit/miss counters accumulate as expected.
+func TestLRUCache_Stats(t *testing.T) {
+	c := NewLRUCache(2)
+
+	c.Set("a", CacheEntry{Value: "a-value"}, time.Minute)
+	_, _ = c.Get("a")
+	_, _ = c.Get("missing")
+
+	hits, misses := c.Stats()
+	assert.Equal(t, int64(1), hits)
+	assert.Equal(t, int64(1), misses)
+}
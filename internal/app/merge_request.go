@@ -0,0 +1,935 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/sgaunet/gitlab-mcp/internal/gitlaberr"
+	"gitlab.com/gitlab-org/api/client-go"
+)
+
+// maxMergeRequestsPerPage caps how many merge requests ListProjectMergeRequests requests in one page.
+const maxMergeRequestsPerPage = 100
+
+// ErrInvalidMergeRequestIID is returned when a merge request operation is requested without a
+// valid internal ID (IID).
+var ErrInvalidMergeRequestIID = errors.New("merge request IID must be a positive integer")
+
+// ErrNoCommitsInRange is returned when AutofillMergeRequest finds no commits between the target
+// and source branches.
+var ErrNoCommitsInRange = errors.New("no commits found between target and source branch")
+
+// AutofillOptions controls how AutofillMergeRequest composes a title and description from a
+// branch's commit range.
+type AutofillOptions struct {
+	// FillCommitBody uses the first commit's full message body as the description, instead of a
+	// bulleted list of every commit's subject line.
+	FillCommitBody bool
+}
+
+// AddMergeRequestNoteOptions contains options for adding a note to a merge request.
+type AddMergeRequestNoteOptions struct {
+	Body string
+}
+
+// UpdateMergeRequestOptions contains options for updating a merge request.
+//
+// Labels, Assignees and Reviewers accept a +/- diff list (see ParseDiffList): a bare value
+// replaces the entire set, while "+value" and "-value"/"!value" adjust the existing set in
+// place. Assignees and Reviewers are usernames, resolved to IDs via findUserByUsername (resolver-cached).
+type UpdateMergeRequestOptions struct {
+	Title        string
+	Description  string
+	State        string
+	TargetBranch string
+	Labels       []string
+	Assignees    []string
+	Reviewers    []string
+}
+
+// ListMergeRequestsOptions contains options for listing a project's merge requests.
+//
+// ListAll walks every page via Paginator instead of returning just the first, bounded by
+// MaxItems (0 = Paginator's default). Truncated is an output field, set to true when ListAll
+// stopped early because a cap was hit.
+type ListMergeRequestsOptions struct {
+	State        string
+	TargetBranch string
+	AuthorID     int64
+	Labels       string
+	Limit        int64
+	ListAll      bool
+	MaxItems     int
+	Truncated    bool
+}
+
+// Discussion represents a GitLab merge request discussion thread.
+type Discussion struct {
+	ID    string `json:"id"`
+	Notes []Note `json:"notes"`
+}
+
+// Commit represents a single commit on a merge request.
+type Commit struct {
+	ID         string `json:"id"`
+	ShortID    string `json:"short_id"`
+	Title      string `json:"title"`
+	Message    string `json:"message"`
+	AuthorName string `json:"author_name"`
+	CreatedAt  string `json:"created_at"`
+}
+
+// unionStrings returns base with every value from extra appended, skipping duplicates already
+// present in base or earlier in extra, preserving order.
+func unionStrings(base, extra []string) []string {
+	result := make([]string, 0, len(base)+len(extra))
+	seen := make(map[string]bool, len(base)+len(extra))
+	for _, v := range base {
+		if !seen[v] {
+			seen[v] = true
+			result = append(result, v)
+		}
+	}
+	for _, v := range extra {
+		if !seen[v] {
+			seen[v] = true
+			result = append(result, v)
+		}
+	}
+	return result
+}
+
+// unionInt64s returns base with every value from extra appended, skipping duplicates already
+// present in base or earlier in extra, preserving order.
+func unionInt64s(base, extra []int64) []int64 {
+	result := make([]int64, 0, len(base)+len(extra))
+	seen := make(map[int64]bool, len(base)+len(extra))
+	for _, v := range base {
+		if !seen[v] {
+			seen[v] = true
+			result = append(result, v)
+		}
+	}
+	for _, v := range extra {
+		if !seen[v] {
+			seen[v] = true
+			result = append(result, v)
+		}
+	}
+	return result
+}
+
+// normalizeListMergeRequestsOptions sets default values for list merge requests options.
+func normalizeListMergeRequestsOptions(opts *ListMergeRequestsOptions) *ListMergeRequestsOptions {
+	if opts == nil {
+		opts = &ListMergeRequestsOptions{}
+	}
+	if opts.State == "" {
+		opts.State = defaultStateOpened
+	}
+	if opts.Limit == 0 {
+		opts.Limit = maxMergeRequestsPerPage
+	}
+	if opts.Limit > maxMergeRequestsPerPage {
+		opts.Limit = maxMergeRequestsPerPage
+	}
+	return opts
+}
+
+// convertGitLabNote converts a GitLab note to our Note struct.
+func convertGitLabNote(note *gitlab.Note) Note {
+	result := Note{
+		ID:        note.ID,
+		Body:      note.Body,
+		System:    note.System,
+		CreatedAt: formatGitLabTime(note.CreatedAt),
+		UpdatedAt: formatGitLabTime(note.UpdatedAt),
+	}
+
+	if note.Author.ID != 0 {
+		result.Author = map[string]any{
+			"id":       note.Author.ID,
+			"username": note.Author.Username,
+			"name":     note.Author.Name,
+		}
+	}
+
+	if note.NoteableID != 0 {
+		result.Noteable = map[string]any{
+			"id":   note.NoteableID,
+			"iid":  note.NoteableIID,
+			"type": note.NoteableType,
+		}
+	}
+
+	return result
+}
+
+// convertGitLabDiscussion converts a GitLab discussion to our Discussion struct.
+func convertGitLabDiscussion(discussion *gitlab.Discussion) Discussion {
+	notes := make([]Note, 0, len(discussion.Notes))
+	for _, note := range discussion.Notes {
+		notes = append(notes, convertGitLabNote(note))
+	}
+
+	return Discussion{
+		ID:    discussion.ID,
+		Notes: notes,
+	}
+}
+
+// convertGitLabCommit converts a GitLab commit to our Commit struct.
+func convertGitLabCommit(commit *gitlab.Commit) Commit {
+	result := Commit{
+		ID:         commit.ID,
+		ShortID:    commit.ShortID,
+		Title:      commit.Title,
+		Message:    commit.Message,
+		AuthorName: commit.AuthorName,
+	}
+
+	if commit.CreatedAt != nil {
+		result.CreatedAt = commit.CreatedAt.Format("2006-01-02T15:04:05Z")
+	}
+
+	return result
+}
+
+// ListProjectMergeRequests retrieves merge requests for a given project path.
+func (a *App) ListProjectMergeRequests(projectPath string, opts *ListMergeRequestsOptions) ([]MergeRequest, error) {
+	a.logger.Debug("Listing merge requests for project", "project_path", projectPath, "options", opts)
+
+	project, err := a.getProjectCached(projectPath)
+	if err != nil {
+		a.logger.Error("Failed to get project", "error", err, "project_path", projectPath)
+		return nil, err
+	}
+
+	opts = normalizeListMergeRequestsOptions(opts)
+
+	listOpts := &gitlab.ListProjectMergeRequestsOptions{
+		State:       &opts.State,
+		ListOptions: gitlab.ListOptions{PerPage: int(opts.Limit), Page: 1},
+	}
+	if opts.TargetBranch != "" {
+		listOpts.TargetBranch = &opts.TargetBranch
+	}
+	if opts.AuthorID != 0 {
+		listOpts.AuthorID = &opts.AuthorID
+	}
+	if opts.Labels != "" {
+		labels := gitlab.LabelOptions(parseLabels(opts.Labels))
+		listOpts.Labels = &labels
+	}
+
+	var mrs []*gitlab.MergeRequest
+	if opts.ListAll {
+		paginator := &Paginator[*gitlab.MergeRequest]{
+			MaxItems: opts.MaxItems,
+			Fetch: func(cursor string) ([]*gitlab.MergeRequest, *gitlab.Response, error) {
+				listOpts.Page = cursorToPage(cursor, listOpts.Page)
+				return retryCall(a, context.Background(), "ListProjectMergeRequests",
+					func() ([]*gitlab.MergeRequest, *gitlab.Response, error) {
+						return a.client.MergeRequests().ListProjectMergeRequests(project.ID, listOpts)
+					})
+			},
+		}
+		pageResult, err := paginator.FetchAll()
+		if err != nil {
+			a.logger.Error("Failed to list project merge requests", "error", err, "project_id", project.ID)
+			return nil, gitlaberr.Classify(err, "failed to list project merge requests")
+		}
+		mrs = pageResult.Items
+		opts.Truncated = pageResult.Truncated
+	} else {
+		var err error
+		mrs, _, err = retryCall(a, context.Background(), "ListProjectMergeRequests",
+			func() ([]*gitlab.MergeRequest, *gitlab.Response, error) {
+				return a.client.MergeRequests().ListProjectMergeRequests(project.ID, listOpts)
+			})
+		if err != nil {
+			a.logger.Error("Failed to list project merge requests", "error", err, "project_id", project.ID)
+			return nil, gitlaberr.Classify(err, "failed to list project merge requests")
+		}
+	}
+
+	result := make([]MergeRequest, 0, len(mrs))
+	for _, mr := range mrs {
+		result = append(result, convertGitLabMergeRequest(mr))
+	}
+
+	a.logger.Info("Successfully listed project merge requests", "count", len(result), "project_id", project.ID)
+	return result, nil
+}
+
+// ListGroupMergeRequests retrieves merge requests across all projects in a group.
+func (a *App) ListGroupMergeRequests(groupPath string, opts *ListMergeRequestsOptions) ([]MergeRequest, error) {
+	a.logger.Debug("Listing merge requests for group", "group_path", groupPath, "options", opts)
+
+	group, err := a.getGroupCached(groupPath)
+	if err != nil {
+		a.logger.Error("Failed to get group", "error", err, "group_path", groupPath)
+		return nil, err
+	}
+
+	opts = normalizeListMergeRequestsOptions(opts)
+
+	listOpts := &gitlab.ListGroupMergeRequestsOptions{
+		State:       &opts.State,
+		ListOptions: gitlab.ListOptions{PerPage: int(opts.Limit), Page: 1},
+	}
+	if opts.TargetBranch != "" {
+		listOpts.TargetBranch = &opts.TargetBranch
+	}
+	if opts.AuthorID != 0 {
+		listOpts.AuthorID = &opts.AuthorID
+	}
+	if opts.Labels != "" {
+		labels := gitlab.LabelOptions(parseLabels(opts.Labels))
+		listOpts.Labels = &labels
+	}
+
+	var mrs []*gitlab.MergeRequest
+	if opts.ListAll {
+		paginator := &Paginator[*gitlab.MergeRequest]{
+			MaxItems: opts.MaxItems,
+			Fetch: func(cursor string) ([]*gitlab.MergeRequest, *gitlab.Response, error) {
+				listOpts.Page = cursorToPage(cursor, listOpts.Page)
+				return retryCall(a, context.Background(), "ListGroupMergeRequests",
+					func() ([]*gitlab.MergeRequest, *gitlab.Response, error) {
+						return a.client.MergeRequests().ListGroupMergeRequests(group.ID, listOpts)
+					})
+			},
+		}
+		pageResult, err := paginator.FetchAll()
+		if err != nil {
+			a.logger.Error("Failed to list group merge requests", "error", err, "group_id", group.ID)
+			return nil, gitlaberr.Classify(err, "failed to list group merge requests")
+		}
+		mrs = pageResult.Items
+		opts.Truncated = pageResult.Truncated
+	} else {
+		var err error
+		mrs, _, err = retryCall(a, context.Background(), "ListGroupMergeRequests",
+			func() ([]*gitlab.MergeRequest, *gitlab.Response, error) {
+				return a.client.MergeRequests().ListGroupMergeRequests(group.ID, listOpts)
+			})
+		if err != nil {
+			a.logger.Error("Failed to list group merge requests", "error", err, "group_id", group.ID)
+			return nil, gitlaberr.Classify(err, "failed to list group merge requests")
+		}
+	}
+
+	result := make([]MergeRequest, 0, len(mrs))
+	for _, mr := range mrs {
+		result = append(result, convertGitLabMergeRequest(mr))
+	}
+
+	a.logger.Info("Successfully listed group merge requests", "count", len(result), "group_id", group.ID)
+	return result, nil
+}
+
+// GetProjectMergeRequest retrieves a single merge request by IID.
+func (a *App) GetProjectMergeRequest(projectPath string, mrIID int64) (*MergeRequest, error) {
+	if mrIID <= 0 {
+		return nil, ErrInvalidMergeRequestIID
+	}
+
+	a.logger.Debug("Getting merge request", "project_path", projectPath, "mr_iid", mrIID)
+
+	project, err := a.getProjectCached(projectPath)
+	if err != nil {
+		a.logger.Error("Failed to get project", "error", err, "project_path", projectPath)
+		return nil, err
+	}
+
+	mr, _, err := retryCall(a, context.Background(), "GetMergeRequest",
+		func() (*gitlab.MergeRequest, *gitlab.Response, error) {
+			return a.client.MergeRequests().GetMergeRequest(project.ID, mrIID, nil)
+		})
+	if err != nil {
+		a.logger.Error("Failed to get merge request", "error", err, "project_id", project.ID, "mr_iid", mrIID)
+		return nil, gitlaberr.Classify(err, "failed to get merge request")
+	}
+
+	result := convertGitLabMergeRequest(mr)
+	a.logger.Info("Successfully retrieved merge request", "mr_iid", result.IID, "project_id", project.ID)
+	return &result, nil
+}
+
+// GetMergeRequestChanges retrieves a merge request along with its file diffs.
+func (a *App) GetMergeRequestChanges(projectPath string, mrIID int64) (*MergeRequest, error) {
+	if mrIID <= 0 {
+		return nil, ErrInvalidMergeRequestIID
+	}
+
+	a.logger.Debug("Getting merge request changes", "project_path", projectPath, "mr_iid", mrIID)
+
+	project, err := a.getProjectCached(projectPath)
+	if err != nil {
+		a.logger.Error("Failed to get project", "error", err, "project_path", projectPath)
+		return nil, err
+	}
+
+	mr, _, err := retryCall(a, context.Background(), "GetMergeRequestChanges",
+		func() (*gitlab.MergeRequest, *gitlab.Response, error) {
+			return a.client.MergeRequests().GetMergeRequestChanges(project.ID, mrIID, nil)
+		})
+	if err != nil {
+		a.logger.Error("Failed to get merge request changes", "error", err, "project_id", project.ID, "mr_iid", mrIID)
+		return nil, gitlaberr.Classify(err, "failed to get merge request changes")
+	}
+
+	result := convertGitLabMergeRequest(mr)
+	a.logger.Info("Successfully retrieved merge request changes", "mr_iid", result.IID, "project_id", project.ID)
+	return &result, nil
+}
+
+// GetMergeRequestCommits retrieves the commits that make up a merge request.
+func (a *App) GetMergeRequestCommits(projectPath string, mrIID int64) ([]Commit, error) {
+	if mrIID <= 0 {
+		return nil, ErrInvalidMergeRequestIID
+	}
+
+	a.logger.Debug("Getting merge request commits", "project_path", projectPath, "mr_iid", mrIID)
+
+	project, err := a.getProjectCached(projectPath)
+	if err != nil {
+		a.logger.Error("Failed to get project", "error", err, "project_path", projectPath)
+		return nil, err
+	}
+
+	commits, _, err := retryCall(a, context.Background(), "GetMergeRequestCommits",
+		func() ([]*gitlab.Commit, *gitlab.Response, error) {
+			return a.client.MergeRequests().GetMergeRequestCommits(project.ID, mrIID, nil)
+		})
+	if err != nil {
+		a.logger.Error("Failed to get merge request commits", "error", err, "project_id", project.ID, "mr_iid", mrIID)
+		return nil, gitlaberr.Classify(err, "failed to get merge request commits")
+	}
+
+	result := make([]Commit, 0, len(commits))
+	for _, commit := range commits {
+		result = append(result, convertGitLabCommit(commit))
+	}
+
+	a.logger.Info("Successfully retrieved merge request commits", "count", len(result), "mr_iid", mrIID)
+	return result, nil
+}
+
+// AutofillMergeRequest builds a CreateMergeRequestOptions from the commits between targetBranch
+// and sourceBranch: the first commit's subject becomes the title, and either that commit's body
+// or a bulleted list of every commit's subject becomes the description, per
+// opts.FillCommitBody. A "Draft:"/"WIP:" prefix on the first commit's subject is preserved in the
+// title and reflected in the returned options' Draft field.
+func (a *App) AutofillMergeRequest(
+	projectPath, sourceBranch, targetBranch string, opts *AutofillOptions,
+) (*CreateMergeRequestOptions, error) {
+	if opts == nil {
+		opts = &AutofillOptions{}
+	}
+
+	a.logger.Debug("Autofilling merge request from commit range",
+		"project_path", projectPath, "source_branch", sourceBranch, "target_branch", targetBranch)
+
+	project, err := a.getProjectCached(projectPath)
+	if err != nil {
+		a.logger.Error("Failed to get project", "error", err, "project_path", projectPath)
+		return nil, err
+	}
+
+	compare, _, err := retryCall(a, context.Background(), "CompareCommits",
+		func() (*gitlab.Compare, *gitlab.Response, error) {
+			return a.client.Repositories().Compare(project.ID, &gitlab.CompareOptions{
+				From: &targetBranch,
+				To:   &sourceBranch,
+			})
+		})
+	if err != nil {
+		a.logger.Error("Failed to compare branches", "error", err,
+			"project_id", project.ID, "source_branch", sourceBranch, "target_branch", targetBranch)
+		return nil, gitlaberr.Classify(err, "failed to compare branches")
+	}
+	if len(compare.Commits) == 0 {
+		return nil, ErrNoCommitsInRange
+	}
+
+	first := compare.Commits[0]
+
+	description := first.Message
+	if !opts.FillCommitBody {
+		var sb strings.Builder
+		for _, commit := range compare.Commits {
+			sb.WriteString("- ")
+			sb.WriteString(commit.Title)
+			sb.WriteString("\n")
+		}
+		description = sb.String()
+	}
+
+	a.logger.Info("Autofilled merge request from commit range",
+		"commit_count", len(compare.Commits), "title", first.Title)
+
+	return &CreateMergeRequestOptions{
+		SourceBranch: sourceBranch,
+		TargetBranch: targetBranch,
+		Title:        first.Title,
+		Description:  description,
+		Draft:        strings.HasPrefix(first.Title, "Draft:") || strings.HasPrefix(first.Title, "WIP:"),
+	}, nil
+}
+
+// CreateMergeRequestFromCommits autofills a merge request's title and description from the
+// commits between targetBranch and sourceBranch (see AutofillMergeRequest), then creates it.
+func (a *App) CreateMergeRequestFromCommits(
+	projectPath, sourceBranch, targetBranch string, opts *AutofillOptions,
+) (*MergeRequest, error) {
+	createOpts, err := a.AutofillMergeRequest(projectPath, sourceBranch, targetBranch, opts)
+	if err != nil {
+		return nil, err
+	}
+	return a.CreateProjectMergeRequest(projectPath, createOpts)
+}
+
+// UpdateProjectMergeRequest updates an existing merge request.
+func (a *App) UpdateProjectMergeRequest(
+	projectPath string,
+	mrIID int64,
+	opts *UpdateMergeRequestOptions,
+) (*MergeRequest, error) {
+	if mrIID <= 0 {
+		return nil, ErrInvalidMergeRequestIID
+	}
+
+	a.logger.Debug("Updating merge request", "project_path", projectPath, "mr_iid", mrIID)
+
+	project, err := a.getProjectCached(projectPath)
+	if err != nil {
+		a.logger.Error("Failed to get project", "error", err, "project_path", projectPath)
+		return nil, err
+	}
+
+	updateOpts := &gitlab.UpdateMergeRequestOptions{}
+	if opts != nil {
+		if opts.Title != "" {
+			updateOpts.Title = &opts.Title
+		}
+		if opts.Description != "" {
+			updateOpts.Description = &opts.Description
+		}
+		if opts.State != "" {
+			updateOpts.StateEvent = &opts.State
+		}
+		if opts.TargetBranch != "" {
+			updateOpts.TargetBranch = &opts.TargetBranch
+		}
+		if len(opts.Labels) > 0 {
+			applyMergeRequestLabelDiff(updateOpts, ParseLabels(opts.Labels))
+		}
+		if len(opts.Assignees) > 0 {
+			assigneeIDs, resolveErr := a.resolveMergeRequestAssigneeDiff(project.ID, mrIID, ParseAssignees(opts.Assignees))
+			if resolveErr != nil {
+				a.logger.Error("Failed to resolve assignees", "error", resolveErr)
+				return nil, fmt.Errorf("failed to resolve assignees: %w", resolveErr)
+			}
+			updateOpts.AssigneeIDs = &assigneeIDs
+		}
+		if len(opts.Reviewers) > 0 {
+			reviewerIDs, resolveErr := a.resolveMergeRequestReviewerDiff(project.ID, mrIID, ParseAssignees(opts.Reviewers))
+			if resolveErr != nil {
+				a.logger.Error("Failed to resolve reviewers", "error", resolveErr)
+				return nil, fmt.Errorf("failed to resolve reviewers: %w", resolveErr)
+			}
+			updateOpts.ReviewerIDs = &reviewerIDs
+		}
+	}
+
+	mr, _, err := retryCall(a, context.Background(), "UpdateMergeRequest",
+		func() (*gitlab.MergeRequest, *gitlab.Response, error) {
+			return a.client.MergeRequests().UpdateMergeRequest(project.ID, mrIID, updateOpts)
+		})
+	if err != nil {
+		a.logger.Error("Failed to update merge request", "error", err, "project_id", project.ID, "mr_iid", mrIID)
+		return nil, gitlaberr.Classify(err, "failed to update merge request")
+	}
+
+	result := convertGitLabMergeRequest(mr)
+	a.logger.Info("Successfully updated merge request", "mr_iid", result.IID, "project_id", project.ID)
+	return &result, nil
+}
+
+// applyMergeRequestLabelDiff sets the Labels/AddLabels/RemoveLabels fields on updateOpts from a
+// parsed label diff, letting GitLab apply add/remove label changes server-side rather than
+// requiring a prior fetch of the merge request's current labels.
+func applyMergeRequestLabelDiff(updateOpts *gitlab.UpdateMergeRequestOptions, diff DiffSet) {
+	if len(diff.Replace) > 0 {
+		labels := gitlab.LabelOptions(diff.Replace)
+		updateOpts.Labels = &labels
+		return
+	}
+	if len(diff.Add) > 0 {
+		add := gitlab.LabelOptions(diff.Add)
+		updateOpts.AddLabels = &add
+	}
+	if len(diff.Remove) > 0 {
+		remove := gitlab.LabelOptions(diff.Remove)
+		updateOpts.RemoveLabels = &remove
+	}
+}
+
+// resolveMergeRequestAssigneeDiff resolves an assignee diff into the full set of assignee IDs
+// the merge request should end up with. Add/remove tokens are applied against the merge
+// request's current assignees, since GitLab's UpdateMergeRequestOptions only supports replacing
+// the full assignee set in one call.
+func (a *App) resolveMergeRequestAssigneeDiff(projectID int64, mrIID int64, diff DiffSet) ([]int64, error) {
+	current, err := a.currentMergeRequestUserIDs(projectID, mrIID, diff,
+		func(mr *gitlab.MergeRequest) []*gitlab.BasicUser { return mr.Assignees })
+	if err != nil {
+		return nil, err
+	}
+	return ApplyIDDiff(current, diff, a.findUserByUsername)
+}
+
+// resolveMergeRequestReviewerDiff resolves a reviewer diff into the full set of reviewer IDs the
+// merge request should end up with, following the same rules as resolveMergeRequestAssigneeDiff.
+func (a *App) resolveMergeRequestReviewerDiff(projectID int64, mrIID int64, diff DiffSet) ([]int64, error) {
+	current, err := a.currentMergeRequestUserIDs(projectID, mrIID, diff,
+		func(mr *gitlab.MergeRequest) []*gitlab.BasicUser { return mr.Reviewers })
+	if err != nil {
+		return nil, err
+	}
+	return ApplyIDDiff(current, diff, a.findUserByUsername)
+}
+
+// currentMergeRequestUserIDs fetches the merge request and extracts the user IDs picked out by
+// pick, but only when diff actually needs them - a replace-only diff never reads the current set.
+func (a *App) currentMergeRequestUserIDs(
+	projectID int64, mrIID int64, diff DiffSet, pick func(*gitlab.MergeRequest) []*gitlab.BasicUser,
+) ([]int64, error) {
+	if len(diff.Add) == 0 && len(diff.Remove) == 0 {
+		return nil, nil
+	}
+
+	mr, _, err := retryCall(a, context.Background(), "GetMergeRequest",
+		func() (*gitlab.MergeRequest, *gitlab.Response, error) {
+			return a.client.MergeRequests().GetMergeRequest(projectID, mrIID, nil)
+		})
+	if err != nil {
+		return nil, gitlaberr.Classify(err, "failed to get merge request")
+	}
+
+	users := pick(mr)
+	ids := make([]int64, 0, len(users))
+	for _, user := range users {
+		ids = append(ids, user.ID)
+	}
+	return ids, nil
+}
+
+// AcceptMergeRequestOptions contains options for merging (accepting) a merge request.
+type AcceptMergeRequestOptions struct {
+	Squash                    bool
+	SquashCommitMessage       string
+	ShouldRemoveSourceBranch  bool
+	MergeWhenPipelineSucceeds bool
+	MergeCommitMessage        string
+}
+
+// AcceptProjectMergeRequest merges (accepts) a merge request.
+func (a *App) AcceptProjectMergeRequest(
+	projectPath string,
+	mrIID int64,
+	opts *AcceptMergeRequestOptions,
+) (*MergeRequest, error) {
+	if mrIID <= 0 {
+		return nil, ErrInvalidMergeRequestIID
+	}
+
+	a.logger.Debug("Merging merge request", "project_path", projectPath, "mr_iid", mrIID, "options", opts)
+
+	project, err := a.getProjectCached(projectPath)
+	if err != nil {
+		a.logger.Error("Failed to get project", "error", err, "project_path", projectPath)
+		return nil, err
+	}
+
+	acceptOpts := &gitlab.AcceptMergeRequestOptions{}
+	if opts != nil {
+		if opts.Squash {
+			acceptOpts.Squash = &opts.Squash
+		}
+		if opts.SquashCommitMessage != "" {
+			acceptOpts.SquashCommitMessage = &opts.SquashCommitMessage
+		}
+		if opts.ShouldRemoveSourceBranch {
+			acceptOpts.ShouldRemoveSourceBranch = &opts.ShouldRemoveSourceBranch
+		}
+		if opts.MergeWhenPipelineSucceeds {
+			acceptOpts.MergeWhenPipelineSucceeds = &opts.MergeWhenPipelineSucceeds
+		}
+		if opts.MergeCommitMessage != "" {
+			acceptOpts.MergeCommitMessage = &opts.MergeCommitMessage
+		}
+	}
+
+	mr, _, err := retryCall(a, context.Background(), "AcceptMergeRequest",
+		func() (*gitlab.MergeRequest, *gitlab.Response, error) {
+			return a.client.MergeRequests().AcceptMergeRequest(project.ID, mrIID, acceptOpts)
+		})
+	if err != nil {
+		a.logger.Error("Failed to merge merge request", "error", err, "project_id", project.ID, "mr_iid", mrIID)
+		return nil, gitlaberr.Classify(err, "failed to merge merge request")
+	}
+
+	result := convertGitLabMergeRequest(mr)
+	a.logger.Info("Successfully merged merge request", "mr_iid", result.IID, "project_id", project.ID)
+	return &result, nil
+}
+
+// CancelMergeRequestAutoMerge cancels a pending merge-when-pipeline-succeeds on a merge request.
+func (a *App) CancelMergeRequestAutoMerge(projectPath string, mrIID int64) (*MergeRequest, error) {
+	if mrIID <= 0 {
+		return nil, ErrInvalidMergeRequestIID
+	}
+
+	a.logger.Debug("Cancelling merge request auto-merge", "project_path", projectPath, "mr_iid", mrIID)
+
+	project, err := a.getProjectCached(projectPath)
+	if err != nil {
+		a.logger.Error("Failed to get project", "error", err, "project_path", projectPath)
+		return nil, err
+	}
+
+	mr, _, err := retryCall(a, context.Background(), "CancelMergeWhenPipelineSucceeds",
+		func() (*gitlab.MergeRequest, *gitlab.Response, error) {
+			return a.client.MergeRequests().CancelMergeWhenPipelineSucceeds(project.ID, mrIID)
+		})
+	if err != nil {
+		a.logger.Error("Failed to cancel merge request auto-merge", "error", err, "project_id", project.ID, "mr_iid", mrIID)
+		return nil, gitlaberr.Classify(err, "failed to cancel merge request auto-merge")
+	}
+
+	result := convertGitLabMergeRequest(mr)
+	a.logger.Info("Successfully cancelled merge request auto-merge", "mr_iid", result.IID, "project_id", project.ID)
+	return &result, nil
+}
+
+// CloseMergeRequest closes a merge request without merging it.
+func (a *App) CloseMergeRequest(projectPath string, mrIID int64) (*MergeRequest, error) {
+	if mrIID <= 0 {
+		return nil, ErrInvalidMergeRequestIID
+	}
+
+	return a.UpdateProjectMergeRequest(projectPath, mrIID, &UpdateMergeRequestOptions{State: "close"})
+}
+
+// RebaseMergeRequest schedules a rebase of a merge request's source branch onto its target
+// branch. The rebase happens asynchronously on GitLab's side; this call only queues it.
+// When skipCI is true, the rebase commit does not trigger a new pipeline.
+func (a *App) RebaseMergeRequest(projectPath string, mrIID int64, skipCI bool) error {
+	if mrIID <= 0 {
+		return ErrInvalidMergeRequestIID
+	}
+
+	a.logger.Debug("Rebasing merge request", "project_path", projectPath, "mr_iid", mrIID, "skip_ci", skipCI)
+
+	project, err := a.getProjectCached(projectPath)
+	if err != nil {
+		a.logger.Error("Failed to get project", "error", err, "project_path", projectPath)
+		return err
+	}
+
+	var rebaseOpts *gitlab.RebaseMergeRequestOptions
+	if skipCI {
+		rebaseOpts = &gitlab.RebaseMergeRequestOptions{SkipCI: &skipCI}
+	}
+
+	if _, err := a.client.MergeRequests().RebaseMergeRequest(project.ID, mrIID, rebaseOpts); err != nil {
+		a.logger.Error("Failed to rebase merge request", "error", err, "project_id", project.ID, "mr_iid", mrIID)
+		return gitlaberr.Classify(err, "failed to rebase merge request")
+	}
+
+	a.logger.Info("Successfully queued merge request rebase", "mr_iid", mrIID, "project_id", project.ID)
+	return nil
+}
+
+// MarkMergeRequestReady removes a "Draft:"/"WIP:" prefix from a merge request's title, taking it
+// out of draft status. If the merge request is not currently a draft, it is returned unchanged.
+func (a *App) MarkMergeRequestReady(projectPath string, mrIID int64) (*MergeRequest, error) {
+	if mrIID <= 0 {
+		return nil, ErrInvalidMergeRequestIID
+	}
+
+	mr, err := a.GetProjectMergeRequest(projectPath, mrIID)
+	if err != nil {
+		return nil, err
+	}
+
+	title := mr.Title
+	title = strings.TrimPrefix(title, "Draft: ")
+	title = strings.TrimPrefix(title, "Draft:")
+	title = strings.TrimPrefix(title, "WIP: ")
+	title = strings.TrimPrefix(title, "WIP:")
+	title = strings.TrimSpace(title)
+
+	if title == mr.Title {
+		return mr, nil
+	}
+
+	return a.UpdateProjectMergeRequest(projectPath, mrIID, &UpdateMergeRequestOptions{Title: title})
+}
+
+// ListMergeRequestNotes lists the notes (comments) on a merge request.
+func (a *App) ListMergeRequestNotes(projectPath string, mrIID int64) ([]Note, error) {
+	if mrIID <= 0 {
+		return nil, ErrInvalidMergeRequestIID
+	}
+
+	a.logger.Debug("Listing merge request notes", "project_path", projectPath, "mr_iid", mrIID)
+
+	project, err := a.getProjectCached(projectPath)
+	if err != nil {
+		a.logger.Error("Failed to get project", "error", err, "project_path", projectPath)
+		return nil, err
+	}
+
+	notes, _, err := retryCall(a, context.Background(), "ListMergeRequestNotes",
+		func() ([]*gitlab.Note, *gitlab.Response, error) {
+			return a.client.MergeRequests().ListMergeRequestNotes(project.ID, mrIID, nil)
+		})
+	if err != nil {
+		a.logger.Error("Failed to list merge request notes", "error", err, "project_id", project.ID, "mr_iid", mrIID)
+		return nil, gitlaberr.Classify(err, "failed to list merge request notes")
+	}
+
+	result := make([]Note, 0, len(notes))
+	for _, note := range notes {
+		result = append(result, convertGitLabNote(note))
+	}
+
+	a.logger.Info("Successfully listed merge request notes", "count", len(result), "project_id", project.ID,
+		"mr_iid", mrIID)
+	return result, nil
+}
+
+// DeleteProjectMergeRequest deletes a merge request.
+func (a *App) DeleteProjectMergeRequest(projectPath string, mrIID int64) error {
+	if mrIID <= 0 {
+		return ErrInvalidMergeRequestIID
+	}
+
+	a.logger.Debug("Deleting merge request", "project_path", projectPath, "mr_iid", mrIID)
+
+	project, err := a.getProjectCached(projectPath)
+	if err != nil {
+		a.logger.Error("Failed to get project", "error", err, "project_path", projectPath)
+		return err
+	}
+
+	if _, err := a.client.MergeRequests().DeleteMergeRequest(project.ID, mrIID); err != nil {
+		a.logger.Error("Failed to delete merge request", "error", err, "project_id", project.ID, "mr_iid", mrIID)
+		return gitlaberr.Classify(err, "failed to delete merge request")
+	}
+
+	a.logger.Info("Successfully deleted merge request", "mr_iid", mrIID, "project_id", project.ID)
+	return nil
+}
+
+// ListMergeRequestDiscussions lists the discussion threads on a merge request.
+func (a *App) ListMergeRequestDiscussions(projectPath string, mrIID int64) ([]Discussion, error) {
+	if mrIID <= 0 {
+		return nil, ErrInvalidMergeRequestIID
+	}
+
+	a.logger.Debug("Listing merge request discussions", "project_path", projectPath, "mr_iid", mrIID)
+
+	project, err := a.getProjectCached(projectPath)
+	if err != nil {
+		a.logger.Error("Failed to get project", "error", err, "project_path", projectPath)
+		return nil, err
+	}
+
+	discussions, _, err := retryCall(a, context.Background(), "ListMergeRequestDiscussions",
+		func() ([]*gitlab.Discussion, *gitlab.Response, error) {
+			return a.client.Discussions().ListMergeRequestDiscussions(project.ID, mrIID, nil)
+		})
+	if err != nil {
+		a.logger.Error("Failed to list merge request discussions", "error", err, "project_id", project.ID, "mr_iid", mrIID)
+		return nil, gitlaberr.Classify(err, "failed to list merge request discussions")
+	}
+
+	result := make([]Discussion, 0, len(discussions))
+	for _, discussion := range discussions {
+		result = append(result, convertGitLabDiscussion(discussion))
+	}
+
+	a.logger.Info("Successfully listed merge request discussions", "count", len(result), "mr_iid", mrIID)
+	return result, nil
+}
+
+// AddMergeRequestNote adds a note (comment) to a merge request.
+func (a *App) AddMergeRequestNote(projectPath string, mrIID int64, opts *AddMergeRequestNoteOptions) (*Note, error) {
+	if mrIID <= 0 {
+		return nil, ErrInvalidMergeRequestIID
+	}
+	if opts == nil || opts.Body == "" {
+		return nil, ErrNoteBodyRequired
+	}
+
+	a.logger.Debug("Adding note to merge request", "project_path", projectPath, "mr_iid", mrIID)
+
+	project, err := a.getProjectCached(projectPath)
+	if err != nil {
+		a.logger.Error("Failed to get project", "error", err, "project_path", projectPath)
+		return nil, err
+	}
+
+	createOpts := &gitlab.CreateMergeRequestNoteOptions{
+		Body: &opts.Body,
+	}
+
+	note, _, err := retryCall(a, context.Background(), "CreateMergeRequestNote",
+		func() (*gitlab.Note, *gitlab.Response, error) {
+			return a.client.MergeRequests().CreateMergeRequestNote(project.ID, mrIID, createOpts)
+		})
+	if err != nil {
+		a.logger.Error("Failed to create merge request note", "error", err, "project_id", project.ID, "mr_iid", mrIID)
+		return nil, gitlaberr.Classify(err, "failed to create merge request note")
+	}
+
+	result := convertGitLabNote(note)
+	a.logger.Info("Successfully added note to merge request", "note_id", result.ID, "mr_iid", mrIID)
+	return &result, nil
+}
+
+// ApproveProjectMergeRequest approves a merge request on behalf of the authenticated user.
+func (a *App) ApproveProjectMergeRequest(projectPath string, mrIID int64) error {
+	if err := a.checkApprovalActionsAllowed(); err != nil {
+		return err
+	}
+	if mrIID <= 0 {
+		return ErrInvalidMergeRequestIID
+	}
+
+	a.logger.Debug("Approving merge request", "project_path", projectPath, "mr_iid", mrIID)
+
+	project, err := a.getProjectCached(projectPath)
+	if err != nil {
+		a.logger.Error("Failed to get project", "error", err, "project_path", projectPath)
+		return err
+	}
+
+	_, _, err = retryCall(a, context.Background(), "ApproveMergeRequest",
+		func() (*gitlab.MergeRequestApprovals, *gitlab.Response, error) {
+			return a.client.MergeRequestApprovals().ApproveMergeRequest(project.ID, mrIID, nil)
+		})
+	if err != nil {
+		a.logger.Error("Failed to approve merge request", "error", err, "project_id", project.ID, "mr_iid", mrIID)
+		return gitlaberr.Classify(err, "failed to approve merge request")
+	}
+
+	a.logger.Info("Successfully approved merge request", "mr_iid", mrIID, "project_id", project.ID)
+	return nil
+}
@@ -0,0 +1,590 @@
+package app
+
+import (
+	"errors"
+	"log/slog"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gitlab.com/gitlab-org/api/client-go"
+)
+
+func newTestAppForMergeRequest(mockClient *MockGitLabClient) *App {
+	a := NewWithClient("token", "https://gitlab.com/", mockClient)
+	a.SetLogger(slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelError})))
+	return a
+}
+
+// TestApp_GetProjectMergeRequest tests the App.GetProjectMergeRequest method.
+func TestApp_GetProjectMergeRequest(t *testing.T) {
+	tests := []struct {
+		name    string
+		mrIID   int64
+		setup   func(*MockGitLabClient, *MockProjectsService, *MockMergeRequestsService)
+		wantErr bool
+		errType error
+	}{
+		{
+			name:  "invalid mr iid",
+			mrIID: 0,
+			setup: func(_ *MockGitLabClient, _ *MockProjectsService, _ *MockMergeRequestsService) {},
+			wantErr: true,
+			errType: ErrInvalidMergeRequestIID,
+		},
+		{
+			name:  "success",
+			mrIID: 5,
+			setup: func(client *MockGitLabClient, projects *MockProjectsService, mrs *MockMergeRequestsService) {
+				client.On("Projects").Return(projects)
+				client.On("MergeRequests").Return(mrs)
+
+				projects.On("GetProject", "test/project", (*gitlab.GetProjectOptions)(nil)).Return(
+					&gitlab.Project{ID: 7}, &gitlab.Response{}, nil,
+				)
+
+				mrs.On("GetMergeRequest", int64(7), int64(5), (*gitlab.GetMergeRequestsOptions)(nil)).Return(
+					&gitlab.MergeRequest{BasicMergeRequest: gitlab.BasicMergeRequest{ID: 1, IID: 5, Title: "Fix bug"}},
+					&gitlab.Response{}, nil,
+				)
+			},
+			wantErr: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := new(MockGitLabClient)
+			mockProjects := new(MockProjectsService)
+			mockMRs := new(MockMergeRequestsService)
+			tt.setup(mockClient, mockProjects, mockMRs)
+
+			a := newTestAppForMergeRequest(mockClient)
+
+			got, err := a.GetProjectMergeRequest("test/project", tt.mrIID)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				if tt.errType != nil {
+					assert.ErrorIs(t, err, tt.errType)
+				}
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, got)
+			assert.Equal(t, int64(5), got.IID)
+			assert.Equal(t, "Fix bug", got.Title)
+		})
+	}
+}
+
+// TestApp_ListProjectMergeRequests tests the App.ListProjectMergeRequests method.
+func TestApp_ListProjectMergeRequests(t *testing.T) {
+	mockClient := new(MockGitLabClient)
+	mockProjects := new(MockProjectsService)
+	mockMRs := new(MockMergeRequestsService)
+
+	mockClient.On("Projects").Return(mockProjects)
+	mockClient.On("MergeRequests").Return(mockMRs)
+
+	mockProjects.On("GetProject", "test/project", (*gitlab.GetProjectOptions)(nil)).Return(
+		&gitlab.Project{ID: 7}, &gitlab.Response{}, nil,
+	)
+
+	state := defaultStateOpened
+	mockMRs.On("ListProjectMergeRequests", int64(7), &gitlab.ListProjectMergeRequestsOptions{
+		State:       &state,
+		ListOptions: gitlab.ListOptions{PerPage: maxMergeRequestsPerPage, Page: 1},
+	}).Return([]*gitlab.MergeRequest{
+		{BasicMergeRequest: gitlab.BasicMergeRequest{ID: 1, IID: 1, Title: "First"}},
+		{BasicMergeRequest: gitlab.BasicMergeRequest{ID: 2, IID: 2, Title: "Second"}},
+	}, &gitlab.Response{}, nil)
+
+	a := newTestAppForMergeRequest(mockClient)
+
+	got, err := a.ListProjectMergeRequests("test/project", nil)
+
+	require.NoError(t, err)
+	require.Len(t, got, 2)
+	assert.Equal(t, "First", got[0].Title)
+	assert.Equal(t, "Second", got[1].Title)
+}
+
+// TestApp_AddMergeRequestNote tests the App.AddMergeRequestNote method.
+func TestApp_AddMergeRequestNote(t *testing.T) {
+	mockClient := new(MockGitLabClient)
+	mockProjects := new(MockProjectsService)
+	mockMRs := new(MockMergeRequestsService)
+
+	mockClient.On("Projects").Return(mockProjects)
+	mockClient.On("MergeRequests").Return(mockMRs)
+
+	mockProjects.On("GetProject", "test/project", (*gitlab.GetProjectOptions)(nil)).Return(
+		&gitlab.Project{ID: 7}, &gitlab.Response{}, nil,
+	)
+
+	body := "Looks good to me"
+	mockMRs.On("CreateMergeRequestNote", int64(7), int64(5), &gitlab.CreateMergeRequestNoteOptions{
+		Body: &body,
+	}).Return(&gitlab.Note{ID: 9, Body: body}, &gitlab.Response{}, nil)
+
+	a := newTestAppForMergeRequest(mockClient)
+
+	got, err := a.AddMergeRequestNote("test/project", 5, &AddMergeRequestNoteOptions{Body: body})
+
+	require.NoError(t, err)
+	require.NotNil(t, got)
+	assert.Equal(t, body, got.Body)
+}
+
+// TestApp_AddMergeRequestNote_MissingBody tests that AddMergeRequestNote rejects an empty body
+// before calling the GitLab API.
+func TestApp_AddMergeRequestNote_MissingBody(t *testing.T) {
+	a := newTestAppForMergeRequest(new(MockGitLabClient))
+
+	_, err := a.AddMergeRequestNote("test/project", 5, &AddMergeRequestNoteOptions{})
+
+	assert.ErrorIs(t, err, ErrNoteBodyRequired)
+}
+
+// TestApp_ApproveProjectMergeRequest tests the App.ApproveProjectMergeRequest method.
+func TestApp_ApproveProjectMergeRequest(t *testing.T) {
+	mockClient := new(MockGitLabClient)
+	mockProjects := new(MockProjectsService)
+	mockApprovals := new(MockMergeRequestApprovalsService)
+
+	mockClient.On("Projects").Return(mockProjects)
+	mockClient.On("MergeRequestApprovals").Return(mockApprovals)
+
+	mockProjects.On("GetProject", "test/project", (*gitlab.GetProjectOptions)(nil)).Return(
+		&gitlab.Project{ID: 7}, &gitlab.Response{}, nil,
+	)
+
+	mockApprovals.On("ApproveMergeRequest", int64(7), int64(5), (*gitlab.ApproveMergeRequestOptions)(nil)).Return(
+		&gitlab.MergeRequestApprovals{}, &gitlab.Response{}, nil,
+	)
+
+	a := newTestAppForMergeRequest(mockClient)
+
+	err := a.ApproveProjectMergeRequest("test/project", 5)
+
+	require.NoError(t, err)
+}
+
+// TestApp_ApproveProjectMergeRequest_InvalidIID tests that ApproveProjectMergeRequest rejects a
+// non-positive IID before calling the GitLab API.
+func TestApp_ApproveProjectMergeRequest_InvalidIID(t *testing.T) {
+	a := newTestAppForMergeRequest(new(MockGitLabClient))
+
+	err := a.ApproveProjectMergeRequest("test/project", 0)
+
+	assert.ErrorIs(t, err, ErrInvalidMergeRequestIID)
+}
+
+// TestApp_UpdateProjectMergeRequest_LabelReplace tests that a bare label list replaces the
+// merge request's labels wholesale via the Labels field.
+func TestApp_UpdateProjectMergeRequest_LabelReplace(t *testing.T) {
+	mockClient := new(MockGitLabClient)
+	mockProjects := new(MockProjectsService)
+	mockMRs := new(MockMergeRequestsService)
+
+	mockClient.On("Projects").Return(mockProjects)
+	mockClient.On("MergeRequests").Return(mockMRs)
+
+	mockProjects.On("GetProject", "test/project", (*gitlab.GetProjectOptions)(nil)).Return(
+		&gitlab.Project{ID: 7}, &gitlab.Response{}, nil,
+	)
+
+	expectedLabels := gitlab.LabelOptions([]string{"bug"})
+	mockMRs.On("UpdateMergeRequest", int64(7), int64(5), &gitlab.UpdateMergeRequestOptions{
+		Labels: &expectedLabels,
+	}).Return(&gitlab.MergeRequest{BasicMergeRequest: gitlab.BasicMergeRequest{ID: 1, IID: 5}}, &gitlab.Response{}, nil)
+
+	a := newTestAppForMergeRequest(mockClient)
+
+	_, err := a.UpdateProjectMergeRequest("test/project", 5, &UpdateMergeRequestOptions{Labels: []string{"bug"}})
+
+	require.NoError(t, err)
+}
+
+// TestApp_UpdateProjectMergeRequest_AssigneeDiff tests that a +/- assignee diff is resolved
+// against the merge request's current assignees before being sent as a full AssigneeIDs list.
+func TestApp_UpdateProjectMergeRequest_AssigneeDiff(t *testing.T) {
+	mockClient := new(MockGitLabClient)
+	mockProjects := new(MockProjectsService)
+	mockMRs := new(MockMergeRequestsService)
+	mockUsers := new(MockUsersService)
+
+	mockClient.On("Projects").Return(mockProjects)
+	mockClient.On("MergeRequests").Return(mockMRs)
+	mockClient.On("Users").Return(mockUsers)
+
+	mockProjects.On("GetProject", "test/project", (*gitlab.GetProjectOptions)(nil)).Return(
+		&gitlab.Project{ID: 7}, &gitlab.Response{}, nil,
+	)
+
+	mockMRs.On("GetMergeRequest", int64(7), int64(5), (*gitlab.GetMergeRequestsOptions)(nil)).Return(
+		&gitlab.MergeRequest{
+			BasicMergeRequest: gitlab.BasicMergeRequest{
+				ID: 1, IID: 5,
+				Assignees: []*gitlab.BasicUser{{ID: 1, Username: "alice"}},
+			},
+		},
+		&gitlab.Response{}, nil,
+	)
+
+	mockUsers.On("ListUsers", &gitlab.ListUsersOptions{
+		Username:    gitlab.Ptr("bob"),
+		ListOptions: gitlab.ListOptions{PerPage: 1, Page: 1},
+	}).Return([]*gitlab.User{{ID: 2, Username: "bob"}}, &gitlab.Response{}, nil)
+
+	mockMRs.On("UpdateMergeRequest", int64(7), int64(5), &gitlab.UpdateMergeRequestOptions{
+		AssigneeIDs: &[]int64{1, 2},
+	}).Return(&gitlab.MergeRequest{BasicMergeRequest: gitlab.BasicMergeRequest{ID: 1, IID: 5}}, &gitlab.Response{}, nil)
+
+	a := newTestAppForMergeRequest(mockClient)
+
+	_, err := a.UpdateProjectMergeRequest("test/project", 5, &UpdateMergeRequestOptions{Assignees: []string{"+bob"}})
+
+	require.NoError(t, err)
+}
+
+// TestApp_ListGroupMergeRequests tests the App.ListGroupMergeRequests method.
+func TestApp_ListGroupMergeRequests(t *testing.T) {
+	mockClient := new(MockGitLabClient)
+	mockGroups := new(MockGroupsService)
+	mockMRs := new(MockMergeRequestsService)
+
+	mockClient.On("Groups").Return(mockGroups)
+	mockClient.On("MergeRequests").Return(mockMRs)
+
+	mockGroups.On("GetGroup", "test/group", (*gitlab.GetGroupOptions)(nil)).Return(
+		&gitlab.Group{ID: 9}, &gitlab.Response{}, nil,
+	)
+
+	state := defaultStateOpened
+	mockMRs.On("ListGroupMergeRequests", int64(9), &gitlab.ListGroupMergeRequestsOptions{
+		State:       &state,
+		ListOptions: gitlab.ListOptions{PerPage: maxMergeRequestsPerPage, Page: 1},
+	}).Return([]*gitlab.MergeRequest{
+		{BasicMergeRequest: gitlab.BasicMergeRequest{ID: 1, IID: 1, Title: "First"}},
+	}, &gitlab.Response{}, nil)
+
+	a := newTestAppForMergeRequest(mockClient)
+
+	got, err := a.ListGroupMergeRequests("test/group", nil)
+
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+	assert.Equal(t, "First", got[0].Title)
+}
+
+// TestApp_GetMergeRequestChanges tests the App.GetMergeRequestChanges method.
+func TestApp_GetMergeRequestChanges(t *testing.T) {
+	mockClient := new(MockGitLabClient)
+	mockProjects := new(MockProjectsService)
+	mockMRs := new(MockMergeRequestsService)
+
+	mockClient.On("Projects").Return(mockProjects)
+	mockClient.On("MergeRequests").Return(mockMRs)
+
+	mockProjects.On("GetProject", "test/project", (*gitlab.GetProjectOptions)(nil)).Return(
+		&gitlab.Project{ID: 7}, &gitlab.Response{}, nil,
+	)
+
+	mockMRs.On("GetMergeRequestChanges", int64(7), int64(5), (*gitlab.GetMergeRequestChangesOptions)(nil)).Return(
+		&gitlab.MergeRequest{BasicMergeRequest: gitlab.BasicMergeRequest{ID: 1, IID: 5, Title: "Fix bug"}},
+		&gitlab.Response{}, nil,
+	)
+
+	a := newTestAppForMergeRequest(mockClient)
+
+	got, err := a.GetMergeRequestChanges("test/project", 5)
+
+	require.NoError(t, err)
+	require.NotNil(t, got)
+	assert.Equal(t, "Fix bug", got.Title)
+}
+
+// TestApp_GetMergeRequestCommits tests the App.GetMergeRequestCommits method.
+func TestApp_GetMergeRequestCommits(t *testing.T) {
+	mockClient := new(MockGitLabClient)
+	mockProjects := new(MockProjectsService)
+	mockMRs := new(MockMergeRequestsService)
+
+	mockClient.On("Projects").Return(mockProjects)
+	mockClient.On("MergeRequests").Return(mockMRs)
+
+	mockProjects.On("GetProject", "test/project", (*gitlab.GetProjectOptions)(nil)).Return(
+		&gitlab.Project{ID: 7}, &gitlab.Response{}, nil,
+	)
+
+	mockMRs.On("GetMergeRequestCommits", int64(7), int64(5), (*gitlab.GetMergeRequestCommitsOptions)(nil)).Return(
+		[]*gitlab.Commit{{ID: "abc123", Title: "Fix bug"}}, &gitlab.Response{}, nil,
+	)
+
+	a := newTestAppForMergeRequest(mockClient)
+
+	got, err := a.GetMergeRequestCommits("test/project", 5)
+
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+	assert.Equal(t, "abc123", got[0].ID)
+}
+
+// TestApp_AcceptProjectMergeRequest_WithFlags tests that squash/remove-source-branch/
+// merge-when-pipeline-succeeds flags are forwarded to the GitLab API.
+func TestApp_AcceptProjectMergeRequest_WithFlags(t *testing.T) {
+	mockClient := new(MockGitLabClient)
+	mockProjects := new(MockProjectsService)
+	mockMRs := new(MockMergeRequestsService)
+
+	mockClient.On("Projects").Return(mockProjects)
+	mockClient.On("MergeRequests").Return(mockMRs)
+
+	mockProjects.On("GetProject", "test/project", (*gitlab.GetProjectOptions)(nil)).Return(
+		&gitlab.Project{ID: 7}, &gitlab.Response{}, nil,
+	)
+
+	mockMRs.On("AcceptMergeRequest", int64(7), int64(5), &gitlab.AcceptMergeRequestOptions{
+		Squash:                   gitlab.Ptr(true),
+		ShouldRemoveSourceBranch: gitlab.Ptr(true),
+	}).Return(&gitlab.MergeRequest{BasicMergeRequest: gitlab.BasicMergeRequest{ID: 1, IID: 5}}, &gitlab.Response{}, nil)
+
+	a := newTestAppForMergeRequest(mockClient)
+
+	_, err := a.AcceptProjectMergeRequest("test/project", 5, &AcceptMergeRequestOptions{
+		Squash:                   true,
+		ShouldRemoveSourceBranch: true,
+	})
+
+	require.NoError(t, err)
+}
+
+// TestApp_RebaseMergeRequest tests the App.RebaseMergeRequest method.
+func TestApp_RebaseMergeRequest(t *testing.T) {
+	tests := []struct {
+		name    string
+		mrIID   int64
+		skipCI  bool
+		setup   func(*MockGitLabClient, *MockProjectsService, *MockMergeRequestsService)
+		wantErr bool
+		errMsg  string
+	}{
+		{
+			name:  "happy path",
+			mrIID: 5,
+			setup: func(client *MockGitLabClient, projects *MockProjectsService, mrs *MockMergeRequestsService) {
+				client.On("Projects").Return(projects)
+				client.On("MergeRequests").Return(mrs)
+				projects.On("GetProject", "test/project", (*gitlab.GetProjectOptions)(nil)).Return(
+					&gitlab.Project{ID: 7}, &gitlab.Response{}, nil,
+				)
+				mrs.On("RebaseMergeRequest", int64(7), int64(5), (*gitlab.RebaseMergeRequestOptions)(nil)).Return(
+					&gitlab.Response{}, nil,
+				)
+			},
+		},
+		{
+			name:   "skip CI",
+			mrIID:  5,
+			skipCI: true,
+			setup: func(client *MockGitLabClient, projects *MockProjectsService, mrs *MockMergeRequestsService) {
+				client.On("Projects").Return(projects)
+				client.On("MergeRequests").Return(mrs)
+				projects.On("GetProject", "test/project", (*gitlab.GetProjectOptions)(nil)).Return(
+					&gitlab.Project{ID: 7}, &gitlab.Response{}, nil,
+				)
+				mrs.On("RebaseMergeRequest", int64(7), int64(5), &gitlab.RebaseMergeRequestOptions{SkipCI: gitlab.Ptr(true)}).Return(
+					&gitlab.Response{}, nil,
+				)
+			},
+		},
+		{
+			name:    "invalid IID",
+			mrIID:   0,
+			setup:   func(*MockGitLabClient, *MockProjectsService, *MockMergeRequestsService) {},
+			wantErr: true,
+		},
+		{
+			name:  "project not found",
+			mrIID: 5,
+			setup: func(client *MockGitLabClient, projects *MockProjectsService, _ *MockMergeRequestsService) {
+				client.On("Projects").Return(projects)
+				projects.On("GetProject", "test/project", (*gitlab.GetProjectOptions)(nil)).Return(
+					(*gitlab.Project)(nil), (*gitlab.Response)(nil), errors.New("404 Project Not Found"),
+				)
+			},
+			wantErr: true,
+		},
+		{
+			name:  "API error",
+			mrIID: 5,
+			setup: func(client *MockGitLabClient, projects *MockProjectsService, mrs *MockMergeRequestsService) {
+				client.On("Projects").Return(projects)
+				client.On("MergeRequests").Return(mrs)
+				projects.On("GetProject", "test/project", (*gitlab.GetProjectOptions)(nil)).Return(
+					&gitlab.Project{ID: 7}, &gitlab.Response{}, nil,
+				)
+				mrs.On("RebaseMergeRequest", int64(7), int64(5), (*gitlab.RebaseMergeRequestOptions)(nil)).Return(
+					(*gitlab.Response)(nil), errors.New("boom"),
+				)
+			},
+			wantErr: true,
+			errMsg:  "failed to rebase merge request",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mockClient := new(MockGitLabClient)
+			mockProjects := new(MockProjectsService)
+			mockMRs := new(MockMergeRequestsService)
+
+			tc.setup(mockClient, mockProjects, mockMRs)
+
+			a := newTestAppForMergeRequest(mockClient)
+
+			err := a.RebaseMergeRequest("test/project", tc.mrIID, tc.skipCI)
+
+			if tc.wantErr {
+				require.Error(t, err)
+				if tc.errMsg != "" {
+					assert.Contains(t, err.Error(), tc.errMsg)
+				}
+				return
+			}
+
+			require.NoError(t, err)
+			mockClient.AssertExpectations(t)
+			mockProjects.AssertExpectations(t)
+			mockMRs.AssertExpectations(t)
+		})
+	}
+}
+
+// TestApp_DeleteProjectMergeRequest tests the App.DeleteProjectMergeRequest method.
+func TestApp_DeleteProjectMergeRequest(t *testing.T) {
+	mockClient := new(MockGitLabClient)
+	mockProjects := new(MockProjectsService)
+	mockMRs := new(MockMergeRequestsService)
+
+	mockClient.On("Projects").Return(mockProjects)
+	mockClient.On("MergeRequests").Return(mockMRs)
+
+	mockProjects.On("GetProject", "test/project", (*gitlab.GetProjectOptions)(nil)).Return(
+		&gitlab.Project{ID: 7}, &gitlab.Response{}, nil,
+	)
+
+	mockMRs.On("DeleteMergeRequest", int64(7), int64(5)).Return(&gitlab.Response{}, nil)
+
+	a := newTestAppForMergeRequest(mockClient)
+
+	err := a.DeleteProjectMergeRequest("test/project", 5)
+
+	require.NoError(t, err)
+}
+
+// TestApp_DeleteProjectMergeRequest_InvalidIID tests that an invalid IID is rejected before any
+// API call is made.
+func TestApp_DeleteProjectMergeRequest_InvalidIID(t *testing.T) {
+	a := newTestAppForMergeRequest(new(MockGitLabClient))
+
+	err := a.DeleteProjectMergeRequest("test/project", 0)
+
+	assert.ErrorIs(t, err, ErrInvalidMergeRequestIID)
+}
+
+// TestApp_AutofillMergeRequest tests that AutofillMergeRequest takes the title from the first
+// commit in the compare range and, by default, a bulleted list of every commit's subject as the
+// description.
+func TestApp_AutofillMergeRequest(t *testing.T) {
+	mockClient := new(MockGitLabClient)
+	mockProjects := new(MockProjectsService)
+	mockRepos := new(MockRepositoriesService)
+
+	mockClient.On("Projects").Return(mockProjects)
+	mockClient.On("Repositories").Return(mockRepos)
+
+	mockProjects.On("GetProject", "test/project", (*gitlab.GetProjectOptions)(nil)).Return(
+		&gitlab.Project{ID: 7}, &gitlab.Response{}, nil,
+	)
+
+	from, to := "main", "feature-branch"
+	mockRepos.On("Compare", int64(7), &gitlab.CompareOptions{From: &from, To: &to}).Return(
+		&gitlab.Compare{Commits: []*gitlab.Commit{
+			{Title: "Add widget support", Message: "Add widget support\n\nCloses #1"},
+			{Title: "Fix widget typo"},
+		}},
+		&gitlab.Response{}, nil,
+	)
+
+	a := newTestAppForMergeRequest(mockClient)
+
+	got, err := a.AutofillMergeRequest("test/project", "feature-branch", "main", nil)
+
+	require.NoError(t, err)
+	assert.Equal(t, "Add widget support", got.Title)
+	assert.Equal(t, "- Add widget support\n- Fix widget typo\n", got.Description)
+	assert.False(t, got.Draft)
+}
+
+// TestApp_AutofillMergeRequest_FillCommitBody tests that FillCommitBody uses the first commit's
+// full message as the description instead of a bulleted subject list.
+func TestApp_AutofillMergeRequest_FillCommitBody(t *testing.T) {
+	mockClient := new(MockGitLabClient)
+	mockProjects := new(MockProjectsService)
+	mockRepos := new(MockRepositoriesService)
+
+	mockClient.On("Projects").Return(mockProjects)
+	mockClient.On("Repositories").Return(mockRepos)
+
+	mockProjects.On("GetProject", "test/project", (*gitlab.GetProjectOptions)(nil)).Return(
+		&gitlab.Project{ID: 7}, &gitlab.Response{}, nil,
+	)
+
+	from, to := "main", "feature-branch"
+	mockRepos.On("Compare", int64(7), &gitlab.CompareOptions{From: &from, To: &to}).Return(
+		&gitlab.Compare{Commits: []*gitlab.Commit{
+			{Title: "Draft: Add widget support", Message: "Draft: Add widget support\n\nCloses #1"},
+		}},
+		&gitlab.Response{}, nil,
+	)
+
+	a := newTestAppForMergeRequest(mockClient)
+
+	got, err := a.AutofillMergeRequest(
+		"test/project", "feature-branch", "main", &AutofillOptions{FillCommitBody: true},
+	)
+
+	require.NoError(t, err)
+	assert.Equal(t, "Draft: Add widget support\n\nCloses #1", got.Description)
+	assert.True(t, got.Draft)
+}
+
+// TestApp_AutofillMergeRequest_NoCommits tests that an empty commit range is rejected.
+func TestApp_AutofillMergeRequest_NoCommits(t *testing.T) {
+	mockClient := new(MockGitLabClient)
+	mockProjects := new(MockProjectsService)
+	mockRepos := new(MockRepositoriesService)
+
+	mockClient.On("Projects").Return(mockProjects)
+	mockClient.On("Repositories").Return(mockRepos)
+
+	mockProjects.On("GetProject", "test/project", (*gitlab.GetProjectOptions)(nil)).Return(
+		&gitlab.Project{ID: 7}, &gitlab.Response{}, nil,
+	)
+
+	from, to := "main", "feature-branch"
+	mockRepos.On("Compare", int64(7), &gitlab.CompareOptions{From: &from, To: &to}).Return(
+		&gitlab.Compare{}, &gitlab.Response{}, nil,
+	)
+
+	a := newTestAppForMergeRequest(mockClient)
+
+	_, err := a.AutofillMergeRequest("test/project", "feature-branch", "main", nil)
+
+	assert.ErrorIs(t, err, ErrNoCommitsInRange)
+}
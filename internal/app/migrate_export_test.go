@@ -0,0 +1,192 @@
+package app
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gitlab.com/gitlab-org/api/client-go"
+
+	"github.com/sgaunet/gitlab-mcp/internal/migrate"
+)
+
+// TestApp_ExportProject walks a small project through ExportProject and checks that the written
+// dump reflects the GitLab data, including the scoped-label rewrite and the IncludeReleases opt-in.
+func TestApp_ExportProject(t *testing.T) {
+	mockClient := &MockGitLabClient{}
+	mockProjects := &MockProjectsService{}
+	mockMilestones := &MockMilestonesService{}
+	mockLabels := &MockLabelsService{}
+	mockIssues := &MockIssuesService{}
+	mockMRs := &MockMergeRequestsService{}
+	mockNotes := &MockNotesService{}
+	mockDiscussions := &MockDiscussionsService{}
+	mockReleases := &MockReleasesService{}
+
+	mockClient.On("Projects").Return(mockProjects)
+	mockClient.On("Milestones").Return(mockMilestones)
+	mockClient.On("Labels").Return(mockLabels)
+	mockClient.On("Issues").Return(mockIssues)
+	mockClient.On("MergeRequests").Return(mockMRs)
+	mockClient.On("Notes").Return(mockNotes)
+	mockClient.On("Discussions").Return(mockDiscussions)
+	mockClient.On("Releases").Return(mockReleases)
+
+	mockProjects.On("GetProject", "test/project", (*gitlab.GetProjectOptions)(nil)).Return(
+		&gitlab.Project{ID: 123, Name: "Test Project", Description: "A test project", Topics: []string{"go"}},
+		&gitlab.Response{}, nil,
+	)
+
+	mockMilestones.On("ListMilestones", int64(123), &gitlab.ListMilestonesOptions{
+		ListOptions: gitlab.ListOptions{PerPage: maxMilestonesPerPage, Page: 1},
+	}).Return([]*gitlab.Milestone{
+		{Title: "v1.0", Description: "First release", State: "active"},
+	}, pageResponse(""), nil)
+
+	mockLabels.On("ListLabels", int64(123), &gitlab.ListLabelsOptions{
+		WithCounts:            gitlab.Ptr(false),
+		IncludeAncestorGroups: gitlab.Ptr(false),
+		ListOptions:           gitlab.ListOptions{PerPage: maxLabelsPerPage, Page: 1},
+	}).Return([]*gitlab.Label{
+		{Name: "priority::high", Color: "#ff0000"},
+	}, pageResponse(""), nil)
+
+	mockIssues.On("ListProjectIssues", 123, &gitlab.ListProjectIssuesOptions{
+		State:       gitlab.Ptr("all"),
+		ListOptions: gitlab.ListOptions{PerPage: maxIssuesPerPage, Page: 1},
+	}).Return([]*gitlab.Issue{
+		{ID: 1, IID: 1, Title: "Bug report", Description: "It broke", State: "opened"},
+	}, pageResponse(""), nil)
+	mockNotes.On("ListIssueNotes", int64(123), 1, (*gitlab.ListIssueNotesOptions)(nil)).Return(
+		[]*gitlab.Note{{Body: "looking into it", Author: gitlab.NoteAuthor{ID: 1, Username: "alice"}}},
+		&gitlab.Response{}, nil,
+	)
+
+	mockMRs.On("ListProjectMergeRequests", int64(123), &gitlab.ListProjectMergeRequestsOptions{
+		State:       gitlab.Ptr("all"),
+		ListOptions: gitlab.ListOptions{PerPage: maxMergeRequestsPerPage, Page: 1},
+	}).Return([]*gitlab.MergeRequest{
+		{
+			BasicMergeRequest: gitlab.BasicMergeRequest{
+				ID: 1, IID: 1, Title: "Fix the bug", Description: "Fixes it",
+				State: "merged", SourceBranch: "fix", TargetBranch: "main",
+				Author: &gitlab.BasicUser{Username: "bob"},
+			},
+		},
+	}, pageResponse(""), nil)
+	mockMRs.On("ListMergeRequestNotes", int64(123), int64(1), (*gitlab.ListMergeRequestNotesOptions)(nil)).Return(
+		[]*gitlab.Note{}, &gitlab.Response{}, nil,
+	)
+	mockDiscussions.On("ListMergeRequestDiscussions", int64(123), int64(1), (*gitlab.ListMergeRequestDiscussionsOptions)(nil)).Return(
+		[]*gitlab.Discussion{
+			{Notes: []*gitlab.Note{{Body: "looks good", Author: gitlab.NoteAuthor{ID: 1, Username: "carol"}}}},
+		}, &gitlab.Response{}, nil,
+	)
+
+	mockReleases.On("ListReleases", int64(123), &gitlab.ListReleasesOptions{
+		ListOptions: gitlab.ListOptions{PerPage: maxReleasesPerPage, Page: 1},
+	}).Return([]*gitlab.Release{
+		{TagName: "v1.0.0", Name: "v1.0.0", Description: "notes"},
+	}, &gitlab.Response{}, nil)
+
+	a := newTestAppForExport(mockClient)
+
+	destDir := t.TempDir()
+	err := a.ExportProject("test/project", destDir, &migrate.Options{IncludeReleases: true})
+	require.NoError(t, err)
+
+	var repo migrate.Repo
+	readJSONFile(t, filepath.Join(destDir, "repo.json"), &repo)
+	assert.Equal(t, "Test Project", repo.Name)
+	assert.Equal(t, "A test project", repo.Description)
+
+	var labels []migrate.Label
+	readJSONFile(t, filepath.Join(destDir, "labels.json"), &labels)
+	require.Len(t, labels, 1)
+	assert.Equal(t, "priority/high", labels[0].Name)
+
+	var issue migrate.Issue
+	readJSONFile(t, filepath.Join(destDir, "issues", "1.json"), &issue)
+	assert.Equal(t, "Bug report", issue.Title)
+	require.Len(t, issue.Comments, 1)
+	assert.Equal(t, "alice", issue.Comments[0].Poster)
+
+	var pr migrate.PullRequest
+	readJSONFile(t, filepath.Join(destDir, "pull_requests", "1.json"), &pr)
+	assert.Equal(t, "bob", pr.Poster)
+	require.Len(t, pr.ReviewComments, 1)
+	assert.Equal(t, "carol", pr.ReviewComments[0].Poster)
+
+	var releases []migrate.Release
+	readJSONFile(t, filepath.Join(destDir, "releases.json"), &releases)
+	require.Len(t, releases, 1)
+	assert.Equal(t, "v1.0.0", releases[0].TagName)
+
+	mockClient.AssertExpectations(t)
+	mockProjects.AssertExpectations(t)
+	mockMilestones.AssertExpectations(t)
+	mockLabels.AssertExpectations(t)
+	mockIssues.AssertExpectations(t)
+	mockMRs.AssertExpectations(t)
+	mockNotes.AssertExpectations(t)
+	mockDiscussions.AssertExpectations(t)
+	mockReleases.AssertExpectations(t)
+}
+
+// TestApp_ExportProject_NoReleases verifies that releases.json is omitted when IncludeReleases is false.
+func TestApp_ExportProject_NoReleases(t *testing.T) {
+	mockClient := &MockGitLabClient{}
+	mockProjects := &MockProjectsService{}
+	mockMilestones := &MockMilestonesService{}
+	mockLabels := &MockLabelsService{}
+	mockIssues := &MockIssuesService{}
+	mockMRs := &MockMergeRequestsService{}
+
+	mockClient.On("Projects").Return(mockProjects)
+	mockClient.On("Milestones").Return(mockMilestones)
+	mockClient.On("Labels").Return(mockLabels)
+	mockClient.On("Issues").Return(mockIssues)
+	mockClient.On("MergeRequests").Return(mockMRs)
+
+	mockProjects.On("GetProject", "test/project", (*gitlab.GetProjectOptions)(nil)).Return(
+		&gitlab.Project{ID: 123, Name: "Test Project"}, &gitlab.Response{}, nil,
+	)
+	mockMilestones.On("ListMilestones", int64(123), &gitlab.ListMilestonesOptions{
+		ListOptions: gitlab.ListOptions{PerPage: maxMilestonesPerPage, Page: 1},
+	}).Return([]*gitlab.Milestone{}, pageResponse(""), nil)
+	mockLabels.On("ListLabels", int64(123), &gitlab.ListLabelsOptions{
+		WithCounts:            gitlab.Ptr(false),
+		IncludeAncestorGroups: gitlab.Ptr(false),
+		ListOptions:           gitlab.ListOptions{PerPage: maxLabelsPerPage, Page: 1},
+	}).Return([]*gitlab.Label{}, pageResponse(""), nil)
+	mockIssues.On("ListProjectIssues", 123, &gitlab.ListProjectIssuesOptions{
+		State:       gitlab.Ptr("all"),
+		ListOptions: gitlab.ListOptions{PerPage: maxIssuesPerPage, Page: 1},
+	}).Return([]*gitlab.Issue{}, pageResponse(""), nil)
+	mockMRs.On("ListProjectMergeRequests", int64(123), &gitlab.ListProjectMergeRequestsOptions{
+		State:       gitlab.Ptr("all"),
+		ListOptions: gitlab.ListOptions{PerPage: maxMergeRequestsPerPage, Page: 1},
+	}).Return([]*gitlab.MergeRequest{}, pageResponse(""), nil)
+
+	a := newTestAppForExport(mockClient)
+
+	destDir := t.TempDir()
+	err := a.ExportProject("test/project", destDir, nil)
+	require.NoError(t, err)
+
+	_, err = os.Stat(filepath.Join(destDir, "releases.json"))
+	assert.True(t, os.IsNotExist(err))
+
+	mockClient.AssertExpectations(t)
+}
+
+// readJSONFile reads and unmarshals the JSON file at path into v, failing the test on error.
+func readJSONFile(t *testing.T, path string, v any) {
+	t.Helper()
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(data, v))
+}
@@ -0,0 +1,343 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/sgaunet/gitlab-mcp/internal/gitlaberr"
+	"gitlab.com/gitlab-org/api/client-go"
+)
+
+// Error variables for milestone-related static errors.
+var (
+	ErrMilestoneTitleRequired = errors.New("milestone title is required")
+	ErrMilestoneIDRequired    = errors.New("milestone id must be a positive integer")
+)
+
+// ListMilestonesOptions contains options for listing a project's milestones.
+type ListMilestonesOptions struct {
+	State  string
+	Search string
+	Limit  int64
+}
+
+// CreateMilestoneOptions contains options for creating a project milestone.
+type CreateMilestoneOptions struct {
+	Title       string
+	Description string
+	StartDate   string
+	DueDate     string
+}
+
+// UpdateMilestoneOptions contains options for updating a project milestone.
+type UpdateMilestoneOptions struct {
+	Title       string
+	Description string
+	State       string
+	StartDate   string
+	DueDate     string
+}
+
+// Milestone represents a GitLab project milestone.
+type Milestone struct {
+	ID          int64  `json:"id"`
+	IID         int64  `json:"iid"`
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	State       string `json:"state"`
+	StartDate   string `json:"start_date,omitempty"`
+	DueDate     string `json:"due_date,omitempty"`
+	WebURL      string `json:"web_url"`
+	// OpenIssuesCount and ClosedIssuesCount are only populated by ProjectMilestoneByTitle, which
+	// pays for an extra ListProjectIssues call to aggregate them; ListProjectMilestones leaves
+	// both zero rather than fanning that call out over every milestone in the project.
+	OpenIssuesCount   int64 `json:"open_issues_count,omitempty"`
+	ClosedIssuesCount int64 `json:"closed_issues_count,omitempty"`
+}
+
+// convertGitLabMilestone converts a GitLab milestone to our Milestone struct.
+func convertGitLabMilestone(milestone *gitlab.Milestone) Milestone {
+	result := Milestone{
+		ID:          int64(milestone.ID),
+		IID:         int64(milestone.IID),
+		Title:       milestone.Title,
+		Description: milestone.Description,
+		State:       milestone.State,
+		WebURL:      milestone.WebURL,
+	}
+
+	if milestone.StartDate != nil {
+		result.StartDate = milestone.StartDate.String()
+	}
+	if milestone.DueDate != nil {
+		result.DueDate = milestone.DueDate.String()
+	}
+
+	return result
+}
+
+// ListProjectMilestones lists a project's milestones, optionally filtered by state or search term.
+func (a *App) ListProjectMilestones(projectPath string, opts *ListMilestonesOptions) ([]Milestone, error) {
+	if opts == nil {
+		opts = &ListMilestonesOptions{}
+	}
+	if opts.Limit == 0 {
+		opts.Limit = maxMilestonesPerPage
+	}
+	if opts.Limit > maxMilestonesPerPage {
+		opts.Limit = maxMilestonesPerPage
+	}
+
+	a.logger.Debug("Listing project milestones", "project_path", projectPath, "state", opts.State)
+
+	project, err := a.getProjectCached(projectPath)
+	if err != nil {
+		a.logger.Error("Failed to get project", "error", err, "project_path", projectPath)
+		return nil, err
+	}
+
+	listOpts := &gitlab.ListMilestonesOptions{
+		ListOptions: gitlab.ListOptions{PerPage: opts.Limit, Page: 1},
+	}
+	if opts.State != "" {
+		listOpts.State = &opts.State
+	}
+	if opts.Search != "" {
+		listOpts.Search = &opts.Search
+	}
+
+	milestones, _, err := retryCall(a, context.Background(), "ListMilestones",
+		func() ([]*gitlab.Milestone, *gitlab.Response, error) {
+			return a.client.Milestones().ListMilestones(project.ID, listOpts)
+		})
+	if err != nil {
+		a.logger.Error("Failed to list project milestones", "error", err, "project_id", project.ID)
+		return nil, gitlaberr.Classify(err, "failed to list project milestones")
+	}
+
+	result := make([]Milestone, 0, len(milestones))
+	for _, milestone := range milestones {
+		result = append(result, convertGitLabMilestone(milestone))
+	}
+
+	a.logger.Info("Successfully listed project milestones", "count", len(result), "project_id", project.ID)
+	return result, nil
+}
+
+// ProjectMilestoneByTitle resolves a project milestone by its exact title.
+func (a *App) ProjectMilestoneByTitle(projectPath string, title string) (*Milestone, error) {
+	if title == "" {
+		return nil, ErrMilestoneTitleRequired
+	}
+
+	a.logger.Debug("Resolving milestone by title", "project_path", projectPath, "title", title)
+
+	project, err := a.getProjectCached(projectPath)
+	if err != nil {
+		a.logger.Error("Failed to get project", "error", err, "project_path", projectPath)
+		return nil, err
+	}
+
+	state := "active"
+	milestones, _, err := retryCall(a, context.Background(), "ListMilestones",
+		func() ([]*gitlab.Milestone, *gitlab.Response, error) {
+			return a.client.Milestones().ListMilestones(project.ID, &gitlab.ListMilestonesOptions{
+				State:       &state,
+				ListOptions: gitlab.ListOptions{PerPage: maxMilestonesPerPage, Page: 1},
+			})
+		})
+	if err != nil {
+		a.logger.Error("Failed to list project milestones", "error", err, "project_id", project.ID)
+		return nil, gitlaberr.Classify(err, "failed to list project milestones")
+	}
+
+	for _, milestone := range milestones {
+		if milestone.Title == title {
+			result := convertGitLabMilestone(milestone)
+			a.populateMilestoneIssueCounts(projectPath, &result)
+			return &result, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w: %s", ErrMilestoneNotFound, title)
+}
+
+// populateMilestoneIssueCounts fills in m.OpenIssuesCount and m.ClosedIssuesCount by listing every
+// issue assigned to the milestone. Failures are logged and swallowed, leaving both counts zero,
+// since a missing aggregate shouldn't fail the milestone lookup itself.
+func (a *App) populateMilestoneIssueCounts(projectPath string, m *Milestone) {
+	issues, err := a.ListProjectIssues(projectPath, &ListIssuesOptions{
+		State:          "all",
+		MilestoneTitle: m.Title,
+		ListAll:        true,
+	})
+	if err != nil {
+		a.logger.Debug("Failed to aggregate milestone issue counts", "error", err, "milestone_title", m.Title)
+		return
+	}
+
+	for _, issue := range issues {
+		if issue.State == "closed" {
+			m.ClosedIssuesCount++
+		} else {
+			m.OpenIssuesCount++
+		}
+	}
+}
+
+// CreateProjectMilestone creates a new milestone in a project.
+func (a *App) CreateProjectMilestone(projectPath string, opts *CreateMilestoneOptions) (*Milestone, error) {
+	if opts == nil || opts.Title == "" {
+		return nil, ErrMilestoneTitleRequired
+	}
+
+	a.logger.Debug("Creating project milestone", "project_path", projectPath, "title", opts.Title)
+
+	project, err := a.getProjectCached(projectPath)
+	if err != nil {
+		a.logger.Error("Failed to get project", "error", err, "project_path", projectPath)
+		return nil, err
+	}
+
+	createOpts, err := buildCreateMilestoneOptions(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	milestone, _, err := retryCall(a, context.Background(), "CreateMilestone",
+		func() (*gitlab.Milestone, *gitlab.Response, error) {
+			return a.client.Milestones().CreateMilestone(project.ID, createOpts)
+		})
+	if err != nil {
+		a.logger.Error("Failed to create project milestone", "error", err, "project_id", project.ID, "title", opts.Title)
+		return nil, gitlaberr.Classify(err, "failed to create project milestone")
+	}
+
+	result := convertGitLabMilestone(milestone)
+	a.logger.Info("Successfully created project milestone", "id", result.ID, "project_id", project.ID)
+	return &result, nil
+}
+
+// buildCreateMilestoneOptions builds the GitLab API options for creating a milestone.
+func buildCreateMilestoneOptions(opts *CreateMilestoneOptions) (*gitlab.CreateMilestoneOptions, error) {
+	createOpts := &gitlab.CreateMilestoneOptions{
+		Title: &opts.Title,
+	}
+
+	if opts.Description != "" {
+		createOpts.Description = &opts.Description
+	}
+
+	if opts.StartDate != "" {
+		startDate, err := gitlab.ParseISOTime(opts.StartDate)
+		if err != nil {
+			return nil, fmt.Errorf("invalid start date: %w", err)
+		}
+		createOpts.StartDate = &startDate
+	}
+
+	if opts.DueDate != "" {
+		dueDate, err := gitlab.ParseISOTime(opts.DueDate)
+		if err != nil {
+			return nil, fmt.Errorf("invalid due date: %w", err)
+		}
+		createOpts.DueDate = &dueDate
+	}
+
+	return createOpts, nil
+}
+
+// UpdateProjectMilestone updates an existing project milestone.
+func (a *App) UpdateProjectMilestone(
+	projectPath string, milestoneID int64, opts *UpdateMilestoneOptions,
+) (*Milestone, error) {
+	if milestoneID <= 0 {
+		return nil, ErrMilestoneIDRequired
+	}
+	if opts == nil {
+		opts = &UpdateMilestoneOptions{}
+	}
+
+	a.logger.Debug("Updating project milestone", "project_path", projectPath, "milestone_id", milestoneID)
+
+	project, err := a.getProjectCached(projectPath)
+	if err != nil {
+		a.logger.Error("Failed to get project", "error", err, "project_path", projectPath)
+		return nil, err
+	}
+
+	updateOpts, err := buildUpdateMilestoneOptions(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	milestone, _, err := retryCall(a, context.Background(), "UpdateMilestone",
+		func() (*gitlab.Milestone, *gitlab.Response, error) {
+			return a.client.Milestones().UpdateMilestone(project.ID, int(milestoneID), updateOpts)
+		})
+	if err != nil {
+		a.logger.Error("Failed to update project milestone", "error", err, "project_id", project.ID, "milestone_id", milestoneID)
+		return nil, gitlaberr.Classify(err, "failed to update project milestone")
+	}
+
+	result := convertGitLabMilestone(milestone)
+	a.logger.Info("Successfully updated project milestone", "id", result.ID, "project_id", project.ID)
+	return &result, nil
+}
+
+// buildUpdateMilestoneOptions builds the GitLab API options for updating a milestone.
+func buildUpdateMilestoneOptions(opts *UpdateMilestoneOptions) (*gitlab.UpdateMilestoneOptions, error) {
+	updateOpts := &gitlab.UpdateMilestoneOptions{}
+
+	if opts.Title != "" {
+		updateOpts.Title = &opts.Title
+	}
+	if opts.Description != "" {
+		updateOpts.Description = &opts.Description
+	}
+	if opts.State != "" {
+		updateOpts.StateEvent = &opts.State
+	}
+
+	if opts.StartDate != "" {
+		startDate, err := gitlab.ParseISOTime(opts.StartDate)
+		if err != nil {
+			return nil, fmt.Errorf("invalid start date: %w", err)
+		}
+		updateOpts.StartDate = &startDate
+	}
+
+	if opts.DueDate != "" {
+		dueDate, err := gitlab.ParseISOTime(opts.DueDate)
+		if err != nil {
+			return nil, fmt.Errorf("invalid due date: %w", err)
+		}
+		updateOpts.DueDate = &dueDate
+	}
+
+	return updateOpts, nil
+}
+
+// DeleteProjectMilestone deletes a milestone from a project.
+func (a *App) DeleteProjectMilestone(projectPath string, milestoneID int64) error {
+	if milestoneID <= 0 {
+		return ErrMilestoneIDRequired
+	}
+
+	a.logger.Debug("Deleting project milestone", "project_path", projectPath, "milestone_id", milestoneID)
+
+	project, err := a.getProjectCached(projectPath)
+	if err != nil {
+		a.logger.Error("Failed to get project", "error", err, "project_path", projectPath)
+		return err
+	}
+
+	if _, err := a.client.Milestones().DeleteMilestone(project.ID, int(milestoneID)); err != nil {
+		a.logger.Error("Failed to delete project milestone", "error", err, "project_id", project.ID, "milestone_id", milestoneID)
+		return gitlaberr.Classify(err, "failed to delete project milestone")
+	}
+
+	a.logger.Info("Successfully deleted project milestone", "milestone_id", milestoneID, "project_id", project.ID)
+	return nil
+}
@@ -1,6 +1,8 @@
 package app
 
 import (
+	"bytes"
+
 	"github.com/stretchr/testify/mock"
 	"gitlab.com/gitlab-org/api/client-go"
 )
@@ -10,6 +12,13 @@ const (
 )
 
 // MockGitLabClient is a mock implementation of GitLabClient.
+//
+// These hand-written testify/mock doubles are the suite's primary test double; a generated
+// go.uber.org/mock alternative was evaluated but dropped, since porting the mockClient.On(...)
+// call sites across every existing test file to its incompatible EXPECT() API isn't something
+// that can be done and verified by hand - it needs the package vendored and a working `go build`/
+// `go test` loop to catch mistakes, neither of which this environment has. New tests that want a
+// real server's behavior instead of a mocked interface should prefer internal/gitlabtest.
 type MockGitLabClient struct {
 	mock.Mock
 }
@@ -44,18 +53,102 @@ func (m *MockGitLabClient) Notes() NotesService {
 	return result
 }
 
+func (m *MockGitLabClient) Discussions() DiscussionsService {
+	args := m.Called()
+	result, _ := args.Get(0).(DiscussionsService)
+	return result
+}
+
+func (m *MockGitLabClient) TimeStats() TimeStatsService {
+	args := m.Called()
+	result, _ := args.Get(0).(TimeStatsService)
+	return result
+}
+
 func (m *MockGitLabClient) MergeRequests() MergeRequestsService {
 	args := m.Called()
 	result, _ := args.Get(0).(MergeRequestsService)
 	return result
 }
 
+func (m *MockGitLabClient) MergeRequestApprovals() MergeRequestApprovalsService {
+	args := m.Called()
+	result, _ := args.Get(0).(MergeRequestApprovalsService)
+	return result
+}
+
 func (m *MockGitLabClient) Milestones() MilestonesService {
 	args := m.Called()
 	result, _ := args.Get(0).(MilestonesService)
 	return result
 }
 
+func (m *MockGitLabClient) Groups() GroupsService {
+	args := m.Called()
+	result, _ := args.Get(0).(GroupsService)
+	return result
+}
+
+func (m *MockGitLabClient) Repositories() RepositoriesService {
+	args := m.Called()
+	result, _ := args.Get(0).(RepositoriesService)
+	return result
+}
+
+func (m *MockGitLabClient) Namespaces() NamespacesService {
+	args := m.Called()
+	result, _ := args.Get(0).(NamespacesService)
+	return result
+}
+
+func (m *MockGitLabClient) Epics() EpicsService {
+	args := m.Called()
+	result, _ := args.Get(0).(EpicsService)
+	return result
+}
+
+func (m *MockGitLabClient) EpicIssues() EpicIssuesService {
+	args := m.Called()
+	result, _ := args.Get(0).(EpicIssuesService)
+	return result
+}
+
+func (m *MockGitLabClient) EpicBoards() EpicBoardsService {
+	args := m.Called()
+	result, _ := args.Get(0).(EpicBoardsService)
+	return result
+}
+
+func (m *MockGitLabClient) Iterations() IterationsService {
+	args := m.Called()
+	result, _ := args.Get(0).(IterationsService)
+	return result
+}
+
+func (m *MockGitLabClient) Pipelines() PipelinesService {
+	args := m.Called()
+	result, _ := args.Get(0).(PipelinesService)
+	return result
+}
+
+func (m *MockGitLabClient) Jobs() JobsService {
+	args := m.Called()
+	result, _ := args.Get(0).(JobsService)
+	return result
+}
+
+func (m *MockGitLabClient) ProjectHooks() ProjectHooksService {
+	args := m.Called()
+	result, _ := args.Get(0).(ProjectHooksService)
+	return result
+}
+
+func (m *MockGitLabClient) Releases() ReleasesService {
+	args := m.Called()
+	result, _ := args.Get(0).(ReleasesService)
+	return result
+}
+
 // MockProjectsService is a mock implementation of ProjectsService.
 type MockProjectsService struct {
 	mock.Mock
@@ -117,6 +210,69 @@ func (m *MockIssuesService) UpdateIssue(
 	return updatedIssue, response, args.Error(errorArgIndex) //nolint:wrapcheck // Mock should pass through errors
 }
 
+func (m *MockIssuesService) GetIssue(pid any, issue int) (*gitlab.Issue, *gitlab.Response, error) {
+	args := m.Called(pid, issue)
+	gotIssue, _ := args.Get(0).(*gitlab.Issue)
+	response, _ := args.Get(1).(*gitlab.Response)
+	return gotIssue, response, args.Error(errorArgIndex) //nolint:wrapcheck // Mock should pass through errors
+}
+
+func (m *MockIssuesService) DeleteIssue(pid any, issue int) (*gitlab.Response, error) {
+	args := m.Called(pid, issue)
+	response, _ := args.Get(0).(*gitlab.Response)
+	return response, args.Error(1) //nolint:wrapcheck // Mock should pass through errors
+}
+
+func (m *MockIssuesService) MoveIssue(
+	pid any,
+	issue int,
+	opt *gitlab.MoveIssueOptions,
+) (*gitlab.Issue, *gitlab.Response, error) {
+	args := m.Called(pid, issue, opt)
+	movedIssue, _ := args.Get(0).(*gitlab.Issue)
+	response, _ := args.Get(1).(*gitlab.Response)
+	return movedIssue, response, args.Error(errorArgIndex) //nolint:wrapcheck // Mock should pass through errors
+}
+
+func (m *MockIssuesService) SubscribeToIssue(pid any, issue int) (*gitlab.Issue, *gitlab.Response, error) {
+	args := m.Called(pid, issue)
+	subscribedIssue, _ := args.Get(0).(*gitlab.Issue)
+	response, _ := args.Get(1).(*gitlab.Response)
+	return subscribedIssue, response, args.Error(errorArgIndex) //nolint:wrapcheck // Mock should pass through errors
+}
+
+func (m *MockIssuesService) UnsubscribeFromIssue(pid any, issue int) (*gitlab.Issue, *gitlab.Response, error) {
+	args := m.Called(pid, issue)
+	unsubscribedIssue, _ := args.Get(0).(*gitlab.Issue)
+	response, _ := args.Get(1).(*gitlab.Response)
+	return unsubscribedIssue, response, args.Error(errorArgIndex) //nolint:wrapcheck // Mock should pass through errors
+}
+
+func (m *MockIssuesService) CreateTodo(pid any, issue int) (*gitlab.Todo, *gitlab.Response, error) {
+	args := m.Called(pid, issue)
+	todo, _ := args.Get(0).(*gitlab.Todo)
+	response, _ := args.Get(1).(*gitlab.Response)
+	return todo, response, args.Error(errorArgIndex) //nolint:wrapcheck // Mock should pass through errors
+}
+
+func (m *MockIssuesService) ListGroupIssues(
+	gid any,
+	opt *gitlab.ListGroupIssuesOptions,
+	options ...gitlab.RequestOptionFunc,
+) ([]*gitlab.Issue, *gitlab.Response, error) {
+	args := m.Called(gid, opt, options)
+	issues, _ := args.Get(0).([]*gitlab.Issue)
+	response, _ := args.Get(1).(*gitlab.Response)
+	return issues, response, args.Error(errorArgIndex) //nolint:wrapcheck // Mock should pass through errors
+}
+
+func (m *MockIssuesService) ListIssues(opt *gitlab.ListIssuesOptions) ([]*gitlab.Issue, *gitlab.Response, error) {
+	args := m.Called(opt)
+	issues, _ := args.Get(0).([]*gitlab.Issue)
+	response, _ := args.Get(1).(*gitlab.Response)
+	return issues, response, args.Error(errorArgIndex) //nolint:wrapcheck // Mock should pass through errors
+}
+
 // MockLabelsService is a mock implementation of LabelsService.
 type MockLabelsService struct {
 	mock.Mock
@@ -132,6 +288,16 @@ func (m *MockLabelsService) ListLabels(
 	return labels, response, args.Error(errorArgIndex) //nolint:wrapcheck // Mock should pass through errors
 }
 
+func (m *MockLabelsService) ListGroupLabels(
+	gid any,
+	opt *gitlab.ListGroupLabelsOptions,
+) ([]*gitlab.Label, *gitlab.Response, error) {
+	args := m.Called(gid, opt)
+	labels, _ := args.Get(0).([]*gitlab.Label)
+	response, _ := args.Get(1).(*gitlab.Response)
+	return labels, response, args.Error(errorArgIndex) //nolint:wrapcheck // Mock should pass through errors
+}
+
 // MockUsersService is a mock implementation of UsersService.
 type MockUsersService struct {
 	mock.Mock
@@ -167,6 +333,249 @@ func (m *MockNotesService) CreateIssueNote(
 	return note, response, args.Error(errorArgIndex) //nolint:wrapcheck // Mock should pass through errors
 }
 
+func (m *MockNotesService) ListIssueNotes(
+	pid any,
+	issue int,
+	opt *gitlab.ListIssueNotesOptions,
+) ([]*gitlab.Note, *gitlab.Response, error) {
+	args := m.Called(pid, issue, opt)
+	notes, _ := args.Get(0).([]*gitlab.Note)
+	response, _ := args.Get(1).(*gitlab.Response)
+	return notes, response, args.Error(errorArgIndex) //nolint:wrapcheck // Mock should pass through errors
+}
+
+func (m *MockNotesService) GetIssueNote(pid any, issue int, note int) (*gitlab.Note, *gitlab.Response, error) {
+	args := m.Called(pid, issue, note)
+	gotNote, _ := args.Get(0).(*gitlab.Note)
+	response, _ := args.Get(1).(*gitlab.Response)
+	return gotNote, response, args.Error(errorArgIndex) //nolint:wrapcheck // Mock should pass through errors
+}
+
+func (m *MockNotesService) UpdateIssueNote(
+	pid any,
+	issue int,
+	note int,
+	opt *gitlab.UpdateIssueNoteOptions,
+) (*gitlab.Note, *gitlab.Response, error) {
+	args := m.Called(pid, issue, note, opt)
+	updatedNote, _ := args.Get(0).(*gitlab.Note)
+	response, _ := args.Get(1).(*gitlab.Response)
+	return updatedNote, response, args.Error(errorArgIndex) //nolint:wrapcheck // Mock should pass through errors
+}
+
+func (m *MockNotesService) DeleteIssueNote(pid any, issue int, note int) (*gitlab.Response, error) {
+	args := m.Called(pid, issue, note)
+	response, _ := args.Get(0).(*gitlab.Response)
+	return response, args.Error(1) //nolint:wrapcheck // Mock should pass through errors
+}
+
+// MockDiscussionsService is a mock implementation of DiscussionsService.
+type MockDiscussionsService struct {
+	mock.Mock
+}
+
+func (m *MockDiscussionsService) ListMergeRequestDiscussions(
+	pid any,
+	mergeRequest int64,
+	opt *gitlab.ListMergeRequestDiscussionsOptions,
+) ([]*gitlab.Discussion, *gitlab.Response, error) {
+	args := m.Called(pid, mergeRequest, opt)
+	discussions, _ := args.Get(0).([]*gitlab.Discussion)
+	response, _ := args.Get(1).(*gitlab.Response)
+	return discussions, response, args.Error(errorArgIndex) //nolint:wrapcheck // Mock should pass through errors
+}
+
+func (m *MockDiscussionsService) GetMergeRequestDiscussion(
+	pid any,
+	mergeRequest int64,
+	discussion string,
+) (*gitlab.Discussion, *gitlab.Response, error) {
+	args := m.Called(pid, mergeRequest, discussion)
+	disc, _ := args.Get(0).(*gitlab.Discussion)
+	response, _ := args.Get(1).(*gitlab.Response)
+	return disc, response, args.Error(errorArgIndex) //nolint:wrapcheck // Mock should pass through errors
+}
+
+func (m *MockDiscussionsService) CreateMergeRequestDiscussion(
+	pid any,
+	mergeRequest int64,
+	opt *gitlab.CreateMergeRequestDiscussionOptions,
+) (*gitlab.Discussion, *gitlab.Response, error) {
+	args := m.Called(pid, mergeRequest, opt)
+	disc, _ := args.Get(0).(*gitlab.Discussion)
+	response, _ := args.Get(1).(*gitlab.Response)
+	return disc, response, args.Error(errorArgIndex) //nolint:wrapcheck // Mock should pass through errors
+}
+
+func (m *MockDiscussionsService) AddMergeRequestDiscussionNote(
+	pid any,
+	mergeRequest int64,
+	discussion string,
+	opt *gitlab.AddMergeRequestDiscussionNoteOptions,
+) (*gitlab.Note, *gitlab.Response, error) {
+	args := m.Called(pid, mergeRequest, discussion, opt)
+	note, _ := args.Get(0).(*gitlab.Note)
+	response, _ := args.Get(1).(*gitlab.Response)
+	return note, response, args.Error(errorArgIndex) //nolint:wrapcheck // Mock should pass through errors
+}
+
+func (m *MockDiscussionsService) ResolveMergeRequestDiscussion(
+	pid any,
+	mergeRequest int64,
+	discussion string,
+	opt *gitlab.ResolveMergeRequestDiscussionOptions,
+) (*gitlab.Discussion, *gitlab.Response, error) {
+	args := m.Called(pid, mergeRequest, discussion, opt)
+	disc, _ := args.Get(0).(*gitlab.Discussion)
+	response, _ := args.Get(1).(*gitlab.Response)
+	return disc, response, args.Error(errorArgIndex) //nolint:wrapcheck // Mock should pass through errors
+}
+
+func (m *MockDiscussionsService) ListIssueDiscussions(
+	pid any,
+	issue int,
+	opt *gitlab.ListIssueDiscussionsOptions,
+) ([]*gitlab.Discussion, *gitlab.Response, error) {
+	args := m.Called(pid, issue, opt)
+	discussions, _ := args.Get(0).([]*gitlab.Discussion)
+	response, _ := args.Get(1).(*gitlab.Response)
+	return discussions, response, args.Error(errorArgIndex) //nolint:wrapcheck // Mock should pass through errors
+}
+
+func (m *MockDiscussionsService) GetIssueDiscussion(
+	pid any,
+	issue int,
+	discussion string,
+) (*gitlab.Discussion, *gitlab.Response, error) {
+	args := m.Called(pid, issue, discussion)
+	disc, _ := args.Get(0).(*gitlab.Discussion)
+	response, _ := args.Get(1).(*gitlab.Response)
+	return disc, response, args.Error(errorArgIndex) //nolint:wrapcheck // Mock should pass through errors
+}
+
+func (m *MockDiscussionsService) CreateIssueDiscussion(
+	pid any,
+	issue int,
+	opt *gitlab.CreateIssueDiscussionOptions,
+) (*gitlab.Discussion, *gitlab.Response, error) {
+	args := m.Called(pid, issue, opt)
+	disc, _ := args.Get(0).(*gitlab.Discussion)
+	response, _ := args.Get(1).(*gitlab.Response)
+	return disc, response, args.Error(errorArgIndex) //nolint:wrapcheck // Mock should pass through errors
+}
+
+func (m *MockDiscussionsService) AddIssueDiscussionNote(
+	pid any,
+	issue int,
+	discussion string,
+	opt *gitlab.AddIssueDiscussionNoteOptions,
+) (*gitlab.Note, *gitlab.Response, error) {
+	args := m.Called(pid, issue, discussion, opt)
+	note, _ := args.Get(0).(*gitlab.Note)
+	response, _ := args.Get(1).(*gitlab.Response)
+	return note, response, args.Error(errorArgIndex) //nolint:wrapcheck // Mock should pass through errors
+}
+
+// MockTimeStatsService is a mock implementation of TimeStatsService.
+type MockTimeStatsService struct {
+	mock.Mock
+}
+
+func (m *MockTimeStatsService) SetIssueTimeEstimate(
+	pid any,
+	issue int,
+	opt *gitlab.SetTimeEstimateOptions,
+) (*gitlab.TimeStats, *gitlab.Response, error) {
+	args := m.Called(pid, issue, opt)
+	stats, _ := args.Get(0).(*gitlab.TimeStats)
+	response, _ := args.Get(1).(*gitlab.Response)
+	return stats, response, args.Error(errorArgIndex) //nolint:wrapcheck // Mock should pass through errors
+}
+
+func (m *MockTimeStatsService) ResetIssueTimeEstimate(pid any, issue int) (*gitlab.TimeStats, *gitlab.Response, error) {
+	args := m.Called(pid, issue)
+	stats, _ := args.Get(0).(*gitlab.TimeStats)
+	response, _ := args.Get(1).(*gitlab.Response)
+	return stats, response, args.Error(errorArgIndex) //nolint:wrapcheck // Mock should pass through errors
+}
+
+func (m *MockTimeStatsService) AddIssueSpentTime(
+	pid any,
+	issue int,
+	opt *gitlab.AddSpentTimeOptions,
+) (*gitlab.TimeStats, *gitlab.Response, error) {
+	args := m.Called(pid, issue, opt)
+	stats, _ := args.Get(0).(*gitlab.TimeStats)
+	response, _ := args.Get(1).(*gitlab.Response)
+	return stats, response, args.Error(errorArgIndex) //nolint:wrapcheck // Mock should pass through errors
+}
+
+func (m *MockTimeStatsService) ResetIssueSpentTime(pid any, issue int) (*gitlab.TimeStats, *gitlab.Response, error) {
+	args := m.Called(pid, issue)
+	stats, _ := args.Get(0).(*gitlab.TimeStats)
+	response, _ := args.Get(1).(*gitlab.Response)
+	return stats, response, args.Error(errorArgIndex) //nolint:wrapcheck // Mock should pass through errors
+}
+
+func (m *MockTimeStatsService) GetIssueTimeStats(pid any, issue int) (*gitlab.TimeStats, *gitlab.Response, error) {
+	args := m.Called(pid, issue)
+	stats, _ := args.Get(0).(*gitlab.TimeStats)
+	response, _ := args.Get(1).(*gitlab.Response)
+	return stats, response, args.Error(errorArgIndex) //nolint:wrapcheck // Mock should pass through errors
+}
+
+func (m *MockTimeStatsService) SetMergeRequestTimeEstimate(
+	pid any,
+	mergeRequest int64,
+	opt *gitlab.SetTimeEstimateOptions,
+) (*gitlab.TimeStats, *gitlab.Response, error) {
+	args := m.Called(pid, mergeRequest, opt)
+	stats, _ := args.Get(0).(*gitlab.TimeStats)
+	response, _ := args.Get(1).(*gitlab.Response)
+	return stats, response, args.Error(errorArgIndex) //nolint:wrapcheck // Mock should pass through errors
+}
+
+func (m *MockTimeStatsService) ResetMergeRequestTimeEstimate(
+	pid any,
+	mergeRequest int64,
+) (*gitlab.TimeStats, *gitlab.Response, error) {
+	args := m.Called(pid, mergeRequest)
+	stats, _ := args.Get(0).(*gitlab.TimeStats)
+	response, _ := args.Get(1).(*gitlab.Response)
+	return stats, response, args.Error(errorArgIndex) //nolint:wrapcheck // Mock should pass through errors
+}
+
+func (m *MockTimeStatsService) AddMergeRequestSpentTime(
+	pid any,
+	mergeRequest int64,
+	opt *gitlab.AddSpentTimeOptions,
+) (*gitlab.TimeStats, *gitlab.Response, error) {
+	args := m.Called(pid, mergeRequest, opt)
+	stats, _ := args.Get(0).(*gitlab.TimeStats)
+	response, _ := args.Get(1).(*gitlab.Response)
+	return stats, response, args.Error(errorArgIndex) //nolint:wrapcheck // Mock should pass through errors
+}
+
+func (m *MockTimeStatsService) ResetMergeRequestSpentTime(
+	pid any,
+	mergeRequest int64,
+) (*gitlab.TimeStats, *gitlab.Response, error) {
+	args := m.Called(pid, mergeRequest)
+	stats, _ := args.Get(0).(*gitlab.TimeStats)
+	response, _ := args.Get(1).(*gitlab.Response)
+	return stats, response, args.Error(errorArgIndex) //nolint:wrapcheck // Mock should pass through errors
+}
+
+func (m *MockTimeStatsService) GetMergeRequestTimeStats(
+	pid any,
+	mergeRequest int64,
+) (*gitlab.TimeStats, *gitlab.Response, error) {
+	args := m.Called(pid, mergeRequest)
+	stats, _ := args.Get(0).(*gitlab.TimeStats)
+	response, _ := args.Get(1).(*gitlab.Response)
+	return stats, response, args.Error(errorArgIndex) //nolint:wrapcheck // Mock should pass through errors
+}
+
 // MockMergeRequestsService is a mock implementation of MergeRequestsService.
 type MockMergeRequestsService struct {
 	mock.Mock
@@ -182,6 +591,222 @@ func (m *MockMergeRequestsService) CreateMergeRequest(
 	return mr, response, args.Error(errorArgIndex) //nolint:wrapcheck // Mock should pass through errors
 }
 
+func (m *MockMergeRequestsService) ListProjectMergeRequests(
+	pid any,
+	opt *gitlab.ListProjectMergeRequestsOptions,
+) ([]*gitlab.MergeRequest, *gitlab.Response, error) {
+	args := m.Called(pid, opt)
+	mrs, _ := args.Get(0).([]*gitlab.MergeRequest)
+	response, _ := args.Get(1).(*gitlab.Response)
+	return mrs, response, args.Error(errorArgIndex) //nolint:wrapcheck // Mock should pass through errors
+}
+
+func (m *MockMergeRequestsService) ListGroupMergeRequests(
+	gid any,
+	opt *gitlab.ListGroupMergeRequestsOptions,
+) ([]*gitlab.MergeRequest, *gitlab.Response, error) {
+	args := m.Called(gid, opt)
+	mrs, _ := args.Get(0).([]*gitlab.MergeRequest)
+	response, _ := args.Get(1).(*gitlab.Response)
+	return mrs, response, args.Error(errorArgIndex) //nolint:wrapcheck // Mock should pass through errors
+}
+
+func (m *MockMergeRequestsService) GetMergeRequest(
+	pid any,
+	mergeRequest int64,
+	opt *gitlab.GetMergeRequestsOptions,
+) (*gitlab.MergeRequest, *gitlab.Response, error) {
+	args := m.Called(pid, mergeRequest, opt)
+	mr, _ := args.Get(0).(*gitlab.MergeRequest)
+	response, _ := args.Get(1).(*gitlab.Response)
+	return mr, response, args.Error(errorArgIndex) //nolint:wrapcheck // Mock should pass through errors
+}
+
+func (m *MockMergeRequestsService) GetMergeRequestChanges(
+	pid any,
+	mergeRequest int64,
+	opt *gitlab.GetMergeRequestChangesOptions,
+) (*gitlab.MergeRequest, *gitlab.Response, error) {
+	args := m.Called(pid, mergeRequest, opt)
+	mr, _ := args.Get(0).(*gitlab.MergeRequest)
+	response, _ := args.Get(1).(*gitlab.Response)
+	return mr, response, args.Error(errorArgIndex) //nolint:wrapcheck // Mock should pass through errors
+}
+
+func (m *MockMergeRequestsService) GetMergeRequestCommits(
+	pid any,
+	mergeRequest int64,
+	opt *gitlab.GetMergeRequestCommitsOptions,
+) ([]*gitlab.Commit, *gitlab.Response, error) {
+	args := m.Called(pid, mergeRequest, opt)
+	commits, _ := args.Get(0).([]*gitlab.Commit)
+	response, _ := args.Get(1).(*gitlab.Response)
+	return commits, response, args.Error(errorArgIndex) //nolint:wrapcheck // Mock should pass through errors
+}
+
+func (m *MockMergeRequestsService) UpdateMergeRequest(
+	pid any,
+	mergeRequest int64,
+	opt *gitlab.UpdateMergeRequestOptions,
+) (*gitlab.MergeRequest, *gitlab.Response, error) {
+	args := m.Called(pid, mergeRequest, opt)
+	mr, _ := args.Get(0).(*gitlab.MergeRequest)
+	response, _ := args.Get(1).(*gitlab.Response)
+	return mr, response, args.Error(errorArgIndex) //nolint:wrapcheck // Mock should pass through errors
+}
+
+func (m *MockMergeRequestsService) AcceptMergeRequest(
+	pid any,
+	mergeRequest int64,
+	opt *gitlab.AcceptMergeRequestOptions,
+) (*gitlab.MergeRequest, *gitlab.Response, error) {
+	args := m.Called(pid, mergeRequest, opt)
+	mr, _ := args.Get(0).(*gitlab.MergeRequest)
+	response, _ := args.Get(1).(*gitlab.Response)
+	return mr, response, args.Error(errorArgIndex) //nolint:wrapcheck // Mock should pass through errors
+}
+
+func (m *MockMergeRequestsService) CancelMergeWhenPipelineSucceeds(
+	pid any,
+	mergeRequest int64,
+) (*gitlab.MergeRequest, *gitlab.Response, error) {
+	args := m.Called(pid, mergeRequest)
+	mr, _ := args.Get(0).(*gitlab.MergeRequest)
+	response, _ := args.Get(1).(*gitlab.Response)
+	return mr, response, args.Error(errorArgIndex) //nolint:wrapcheck // Mock should pass through errors
+}
+
+func (m *MockMergeRequestsService) ListMergeRequestPipelines(
+	pid any,
+	mergeRequest int64,
+) ([]*gitlab.PipelineInfo, *gitlab.Response, error) {
+	args := m.Called(pid, mergeRequest)
+	pipelines, _ := args.Get(0).([]*gitlab.PipelineInfo)
+	response, _ := args.Get(1).(*gitlab.Response)
+	return pipelines, response, args.Error(errorArgIndex) //nolint:wrapcheck // Mock should pass through errors
+}
+
+func (m *MockMergeRequestsService) DeleteMergeRequest(pid any, mergeRequest int64) (*gitlab.Response, error) {
+	args := m.Called(pid, mergeRequest)
+	response, _ := args.Get(0).(*gitlab.Response)
+	return response, args.Error(1) //nolint:wrapcheck // Mock should pass through errors
+}
+
+
+func (m *MockMergeRequestsService) CreateMergeRequestNote(
+	pid any,
+	mergeRequest int64,
+	opt *gitlab.CreateMergeRequestNoteOptions,
+) (*gitlab.Note, *gitlab.Response, error) {
+	args := m.Called(pid, mergeRequest, opt)
+	note, _ := args.Get(0).(*gitlab.Note)
+	response, _ := args.Get(1).(*gitlab.Response)
+	return note, response, args.Error(errorArgIndex) //nolint:wrapcheck // Mock should pass through errors
+}
+
+func (m *MockMergeRequestsService) ListMergeRequestNotes(
+	pid any,
+	mergeRequest int64,
+	opt *gitlab.ListMergeRequestNotesOptions,
+) ([]*gitlab.Note, *gitlab.Response, error) {
+	args := m.Called(pid, mergeRequest, opt)
+	notes, _ := args.Get(0).([]*gitlab.Note)
+	response, _ := args.Get(1).(*gitlab.Response)
+	return notes, response, args.Error(errorArgIndex) //nolint:wrapcheck // Mock should pass through errors
+}
+
+func (m *MockMergeRequestsService) RebaseMergeRequest(
+	pid any, mergeRequest int64, opt *gitlab.RebaseMergeRequestOptions,
+) (*gitlab.Response, error) {
+	args := m.Called(pid, mergeRequest, opt)
+	response, _ := args.Get(0).(*gitlab.Response)
+	return response, args.Error(1) //nolint:wrapcheck // Mock should pass through errors
+}
+
+// MockMergeRequestApprovalsService is a mock implementation of MergeRequestApprovalsService.
+type MockMergeRequestApprovalsService struct {
+	mock.Mock
+}
+
+func (m *MockMergeRequestApprovalsService) ApproveMergeRequest(
+	pid any,
+	mergeRequest int64,
+	opt *gitlab.ApproveMergeRequestOptions,
+) (*gitlab.MergeRequestApprovals, *gitlab.Response, error) {
+	args := m.Called(pid, mergeRequest, opt)
+	approvals, _ := args.Get(0).(*gitlab.MergeRequestApprovals)
+	response, _ := args.Get(1).(*gitlab.Response)
+	return approvals, response, args.Error(errorArgIndex) //nolint:wrapcheck // Mock should pass through errors
+}
+
+func (m *MockMergeRequestApprovalsService) UnapproveMergeRequest(
+	pid any,
+	mergeRequest int64,
+) (*gitlab.Response, error) {
+	args := m.Called(pid, mergeRequest)
+	response, _ := args.Get(0).(*gitlab.Response)
+	return response, args.Error(1) //nolint:wrapcheck // Mock should pass through errors
+}
+
+func (m *MockMergeRequestApprovalsService) GetApprovalState(
+	pid any,
+	mergeRequest int64,
+) (*gitlab.MergeRequestApprovalState, *gitlab.Response, error) {
+	args := m.Called(pid, mergeRequest)
+	state, _ := args.Get(0).(*gitlab.MergeRequestApprovalState)
+	response, _ := args.Get(1).(*gitlab.Response)
+	return state, response, args.Error(errorArgIndex) //nolint:wrapcheck // Mock should pass through errors
+}
+
+func (m *MockMergeRequestApprovalsService) ChangeApprovalConfiguration(
+	pid any,
+	mergeRequest int64,
+	opt *gitlab.ChangeMergeRequestApprovalConfigurationOptions,
+) (*gitlab.MergeRequestApprovals, *gitlab.Response, error) {
+	args := m.Called(pid, mergeRequest, opt)
+	approvals, _ := args.Get(0).(*gitlab.MergeRequestApprovals)
+	response, _ := args.Get(1).(*gitlab.Response)
+	return approvals, response, args.Error(errorArgIndex) //nolint:wrapcheck // Mock should pass through errors
+}
+
+func (m *MockMergeRequestApprovalsService) GetProjectApprovalRules(
+	pid any,
+	opt *gitlab.GetProjectApprovalRulesListsOptions,
+) ([]*gitlab.ProjectApprovalRule, *gitlab.Response, error) {
+	args := m.Called(pid, opt)
+	rules, _ := args.Get(0).([]*gitlab.ProjectApprovalRule)
+	response, _ := args.Get(1).(*gitlab.Response)
+	return rules, response, args.Error(errorArgIndex) //nolint:wrapcheck // Mock should pass through errors
+}
+
+func (m *MockMergeRequestApprovalsService) CreateProjectApprovalRule(
+	pid any,
+	opt *gitlab.CreateProjectLevelRuleOptions,
+) (*gitlab.ProjectApprovalRule, *gitlab.Response, error) {
+	args := m.Called(pid, opt)
+	rule, _ := args.Get(0).(*gitlab.ProjectApprovalRule)
+	response, _ := args.Get(1).(*gitlab.Response)
+	return rule, response, args.Error(errorArgIndex) //nolint:wrapcheck // Mock should pass through errors
+}
+
+func (m *MockMergeRequestApprovalsService) UpdateProjectApprovalRule(
+	pid any,
+	approvalRuleID int,
+	opt *gitlab.UpdateProjectLevelRuleOptions,
+) (*gitlab.ProjectApprovalRule, *gitlab.Response, error) {
+	args := m.Called(pid, approvalRuleID, opt)
+	rule, _ := args.Get(0).(*gitlab.ProjectApprovalRule)
+	response, _ := args.Get(1).(*gitlab.Response)
+	return rule, response, args.Error(errorArgIndex) //nolint:wrapcheck // Mock should pass through errors
+}
+
+func (m *MockMergeRequestApprovalsService) DeleteProjectApprovalRule(pid any, approvalRuleID int) (*gitlab.Response, error) {
+	args := m.Called(pid, approvalRuleID)
+	response, _ := args.Get(0).(*gitlab.Response)
+	return response, args.Error(1) //nolint:wrapcheck // Mock should pass through errors
+}
+
+
 // MockMilestonesService is a mock implementation of MilestonesService.
 type MockMilestonesService struct {
 	mock.Mock
@@ -196,3 +821,340 @@ func (m *MockMilestonesService) ListMilestones(
 	response, _ := args.Get(1).(*gitlab.Response)
 	return milestones, response, args.Error(errorArgIndex) //nolint:wrapcheck // Mock should pass through errors
 }
+
+func (m *MockMilestonesService) CreateMilestone(
+	pid any,
+	opt *gitlab.CreateMilestoneOptions,
+) (*gitlab.Milestone, *gitlab.Response, error) {
+	args := m.Called(pid, opt)
+	milestone, _ := args.Get(0).(*gitlab.Milestone)
+	response, _ := args.Get(1).(*gitlab.Response)
+	return milestone, response, args.Error(errorArgIndex) //nolint:wrapcheck // Mock should pass through errors
+}
+
+func (m *MockMilestonesService) UpdateMilestone(
+	pid any,
+	milestone int,
+	opt *gitlab.UpdateMilestoneOptions,
+) (*gitlab.Milestone, *gitlab.Response, error) {
+	args := m.Called(pid, milestone, opt)
+	result, _ := args.Get(0).(*gitlab.Milestone)
+	response, _ := args.Get(1).(*gitlab.Response)
+	return result, response, args.Error(errorArgIndex) //nolint:wrapcheck // Mock should pass through errors
+}
+
+func (m *MockMilestonesService) DeleteMilestone(pid any, milestone int) (*gitlab.Response, error) {
+	args := m.Called(pid, milestone)
+	response, _ := args.Get(0).(*gitlab.Response)
+	return response, args.Error(1) //nolint:wrapcheck // Mock should pass through errors
+}
+
+// MockGroupsService is a mock implementation of GroupsService.
+type MockGroupsService struct {
+	mock.Mock
+}
+
+func (m *MockGroupsService) GetGroup(gid any, opt *gitlab.GetGroupOptions) (*gitlab.Group, *gitlab.Response, error) {
+	args := m.Called(gid, opt)
+	group, _ := args.Get(0).(*gitlab.Group)
+	response, _ := args.Get(1).(*gitlab.Response)
+	return group, response, args.Error(errorArgIndex) //nolint:wrapcheck // Mock should pass through errors
+}
+
+func (m *MockGroupsService) ListGroupProjects(
+	gid any,
+	opt *gitlab.ListGroupProjectsOptions,
+) ([]*gitlab.Project, *gitlab.Response, error) {
+	args := m.Called(gid, opt)
+	projects, _ := args.Get(0).([]*gitlab.Project)
+	response, _ := args.Get(1).(*gitlab.Response)
+	return projects, response, args.Error(errorArgIndex) //nolint:wrapcheck // Mock should pass through errors
+}
+
+func (m *MockGroupsService) ListGroupMembers(
+	gid any,
+	opt *gitlab.ListGroupMembersOptions,
+) ([]*gitlab.GroupMember, *gitlab.Response, error) {
+	args := m.Called(gid, opt)
+	members, _ := args.Get(0).([]*gitlab.GroupMember)
+	response, _ := args.Get(1).(*gitlab.Response)
+	return members, response, args.Error(errorArgIndex) //nolint:wrapcheck // Mock should pass through errors
+}
+
+// MockRepositoriesService is a mock implementation of RepositoriesService.
+type MockRepositoriesService struct {
+	mock.Mock
+}
+
+func (m *MockRepositoriesService) Compare(
+	pid any,
+	opt *gitlab.CompareOptions,
+) (*gitlab.Compare, *gitlab.Response, error) {
+	args := m.Called(pid, opt)
+	compare, _ := args.Get(0).(*gitlab.Compare)
+	response, _ := args.Get(1).(*gitlab.Response)
+	return compare, response, args.Error(errorArgIndex) //nolint:wrapcheck // Mock should pass through errors
+}
+
+// MockNamespacesService is a mock implementation of NamespacesService.
+type MockNamespacesService struct {
+	mock.Mock
+}
+
+func (m *MockNamespacesService) GetNamespace(
+	id any,
+	options ...gitlab.RequestOptionFunc,
+) (*gitlab.Namespace, *gitlab.Response, error) {
+	args := m.Called(id, options)
+	namespace, _ := args.Get(0).(*gitlab.Namespace)
+	response, _ := args.Get(1).(*gitlab.Response)
+	return namespace, response, args.Error(errorArgIndex) //nolint:wrapcheck // Mock should pass through errors
+}
+
+// MockEpicsService is a mock implementation of EpicsService.
+type MockEpicsService struct {
+	mock.Mock
+}
+
+func (m *MockEpicsService) ListGroupEpics(
+	gid any,
+	opt *gitlab.ListGroupEpicsOptions,
+) ([]*gitlab.Epic, *gitlab.Response, error) {
+	args := m.Called(gid, opt)
+	epics, _ := args.Get(0).([]*gitlab.Epic)
+	response, _ := args.Get(1).(*gitlab.Response)
+	return epics, response, args.Error(errorArgIndex) //nolint:wrapcheck // Mock should pass through errors
+}
+
+func (m *MockEpicsService) GetEpic(gid any, epic int64) (*gitlab.Epic, *gitlab.Response, error) {
+	args := m.Called(gid, epic)
+	gotEpic, _ := args.Get(0).(*gitlab.Epic)
+	response, _ := args.Get(1).(*gitlab.Response)
+	return gotEpic, response, args.Error(errorArgIndex) //nolint:wrapcheck // Mock should pass through errors
+}
+
+func (m *MockEpicsService) CreateEpic(gid any, opt *gitlab.CreateEpicOptions) (*gitlab.Epic, *gitlab.Response, error) {
+	args := m.Called(gid, opt)
+	epic, _ := args.Get(0).(*gitlab.Epic)
+	response, _ := args.Get(1).(*gitlab.Response)
+	return epic, response, args.Error(errorArgIndex) //nolint:wrapcheck // Mock should pass through errors
+}
+
+func (m *MockEpicsService) UpdateEpic(
+	gid any,
+	epic int64,
+	opt *gitlab.UpdateEpicOptions,
+) (*gitlab.Epic, *gitlab.Response, error) {
+	args := m.Called(gid, epic, opt)
+	updatedEpic, _ := args.Get(0).(*gitlab.Epic)
+	response, _ := args.Get(1).(*gitlab.Response)
+	return updatedEpic, response, args.Error(errorArgIndex) //nolint:wrapcheck // Mock should pass through errors
+}
+
+func (m *MockEpicsService) DeleteEpic(gid any, epic int64) (*gitlab.Response, error) {
+	args := m.Called(gid, epic)
+	response, _ := args.Get(0).(*gitlab.Response)
+	return response, args.Error(1) //nolint:wrapcheck // Mock should pass through errors
+}
+
+// MockEpicIssuesService is a mock implementation of EpicIssuesService.
+type MockEpicIssuesService struct {
+	mock.Mock
+}
+
+func (m *MockEpicIssuesService) AssignEpicIssue(
+	gid any,
+	epic int64,
+	issue int64,
+) (*gitlab.EpicIssueAssignment, *gitlab.Response, error) {
+	args := m.Called(gid, epic, issue)
+	assignment, _ := args.Get(0).(*gitlab.EpicIssueAssignment)
+	response, _ := args.Get(1).(*gitlab.Response)
+	return assignment, response, args.Error(errorArgIndex) //nolint:wrapcheck // Mock should pass through errors
+}
+
+func (m *MockEpicIssuesService) ListEpicIssues(
+	gid any,
+	epic int64,
+) ([]*gitlab.EpicIssueAssignment, *gitlab.Response, error) {
+	args := m.Called(gid, epic)
+	assignments, _ := args.Get(0).([]*gitlab.EpicIssueAssignment)
+	response, _ := args.Get(1).(*gitlab.Response)
+	return assignments, response, args.Error(errorArgIndex) //nolint:wrapcheck // Mock should pass through errors
+}
+
+// MockEpicBoardsService is a mock implementation of EpicBoardsService.
+type MockEpicBoardsService struct {
+	mock.Mock
+}
+
+func (m *MockEpicBoardsService) ListGroupEpicBoards(
+	gid any,
+	opt *gitlab.ListGroupEpicBoardsOptions,
+) ([]*gitlab.GroupEpicBoard, *gitlab.Response, error) {
+	args := m.Called(gid, opt)
+	boards, _ := args.Get(0).([]*gitlab.GroupEpicBoard)
+	response, _ := args.Get(1).(*gitlab.Response)
+	return boards, response, args.Error(errorArgIndex) //nolint:wrapcheck // Mock should pass through errors
+}
+
+func (m *MockEpicBoardsService) GetGroupEpicBoard(gid any, board int64) (*gitlab.GroupEpicBoard, *gitlab.Response, error) {
+	args := m.Called(gid, board)
+	gotBoard, _ := args.Get(0).(*gitlab.GroupEpicBoard)
+	response, _ := args.Get(1).(*gitlab.Response)
+	return gotBoard, response, args.Error(errorArgIndex) //nolint:wrapcheck // Mock should pass through errors
+}
+
+// MockIterationsService is a mock implementation of IterationsService.
+type MockIterationsService struct {
+	mock.Mock
+}
+
+func (m *MockIterationsService) ListGroupIterations(
+	gid any,
+	opt *gitlab.ListGroupIterationsOptions,
+) ([]*gitlab.GroupIteration, *gitlab.Response, error) {
+	args := m.Called(gid, opt)
+	iterations, _ := args.Get(0).([]*gitlab.GroupIteration)
+	response, _ := args.Get(1).(*gitlab.Response)
+	return iterations, response, args.Error(errorArgIndex) //nolint:wrapcheck // Mock should pass through errors
+}
+
+// MockPipelinesService is a mock implementation of PipelinesService.
+type MockPipelinesService struct {
+	mock.Mock
+}
+
+func (m *MockPipelinesService) ListProjectPipelines(
+	pid any,
+	opt *gitlab.ListProjectPipelinesOptions,
+) ([]*gitlab.PipelineInfo, *gitlab.Response, error) {
+	args := m.Called(pid, opt)
+	pipelines, _ := args.Get(0).([]*gitlab.PipelineInfo)
+	response, _ := args.Get(1).(*gitlab.Response)
+	return pipelines, response, args.Error(errorArgIndex) //nolint:wrapcheck // Mock should pass through errors
+}
+
+func (m *MockPipelinesService) GetPipeline(pid any, pipeline int64) (*gitlab.Pipeline, *gitlab.Response, error) {
+	args := m.Called(pid, pipeline)
+	gotPipeline, _ := args.Get(0).(*gitlab.Pipeline)
+	response, _ := args.Get(1).(*gitlab.Response)
+	return gotPipeline, response, args.Error(errorArgIndex) //nolint:wrapcheck // Mock should pass through errors
+}
+
+func (m *MockPipelinesService) CreatePipeline(
+	pid any,
+	opt *gitlab.CreatePipelineOptions,
+) (*gitlab.Pipeline, *gitlab.Response, error) {
+	args := m.Called(pid, opt)
+	pipeline, _ := args.Get(0).(*gitlab.Pipeline)
+	response, _ := args.Get(1).(*gitlab.Response)
+	return pipeline, response, args.Error(errorArgIndex) //nolint:wrapcheck // Mock should pass through errors
+}
+
+func (m *MockPipelinesService) RetryPipelineBuild(pid any, pipeline int64) (*gitlab.Pipeline, *gitlab.Response, error) {
+	args := m.Called(pid, pipeline)
+	retried, _ := args.Get(0).(*gitlab.Pipeline)
+	response, _ := args.Get(1).(*gitlab.Response)
+	return retried, response, args.Error(errorArgIndex) //nolint:wrapcheck // Mock should pass through errors
+}
+
+func (m *MockPipelinesService) CancelPipelineBuild(pid any, pipeline int64) (*gitlab.Pipeline, *gitlab.Response, error) {
+	args := m.Called(pid, pipeline)
+	canceled, _ := args.Get(0).(*gitlab.Pipeline)
+	response, _ := args.Get(1).(*gitlab.Response)
+	return canceled, response, args.Error(errorArgIndex) //nolint:wrapcheck // Mock should pass through errors
+}
+
+func (m *MockPipelinesService) GetPipelineVariables(
+	pid any,
+	pipeline int64,
+) ([]*gitlab.PipelineVariable, *gitlab.Response, error) {
+	args := m.Called(pid, pipeline)
+	variables, _ := args.Get(0).([]*gitlab.PipelineVariable)
+	response, _ := args.Get(1).(*gitlab.Response)
+	return variables, response, args.Error(errorArgIndex) //nolint:wrapcheck // Mock should pass through errors
+}
+
+func (m *MockPipelinesService) GetLatestPipeline(
+	pid any,
+	opt *gitlab.GetLatestPipelineOptions,
+) (*gitlab.Pipeline, *gitlab.Response, error) {
+	args := m.Called(pid, opt)
+	pipeline, _ := args.Get(0).(*gitlab.Pipeline)
+	response, _ := args.Get(1).(*gitlab.Response)
+	return pipeline, response, args.Error(errorArgIndex) //nolint:wrapcheck // Mock should pass through errors
+}
+
+// MockJobsService is a mock implementation of JobsService.
+type MockJobsService struct {
+	mock.Mock
+}
+
+func (m *MockJobsService) ListPipelineJobs(
+	pid any,
+	pipeline int64,
+	opt *gitlab.ListJobsOptions,
+) ([]*gitlab.Job, *gitlab.Response, error) {
+	args := m.Called(pid, pipeline, opt)
+	jobs, _ := args.Get(0).([]*gitlab.Job)
+	response, _ := args.Get(1).(*gitlab.Response)
+	return jobs, response, args.Error(errorArgIndex) //nolint:wrapcheck // Mock should pass through errors
+}
+
+func (m *MockJobsService) GetTraceFile(pid any, job int64) (*bytes.Reader, *gitlab.Response, error) {
+	args := m.Called(pid, job)
+	trace, _ := args.Get(0).(*bytes.Reader)
+	response, _ := args.Get(1).(*gitlab.Response)
+	return trace, response, args.Error(errorArgIndex) //nolint:wrapcheck // Mock should pass through errors
+}
+
+func (m *MockJobsService) CancelJob(pid any, job int64) (*gitlab.Job, *gitlab.Response, error) {
+	args := m.Called(pid, job)
+	result, _ := args.Get(0).(*gitlab.Job)
+	response, _ := args.Get(1).(*gitlab.Response)
+	return result, response, args.Error(errorArgIndex) //nolint:wrapcheck // Mock should pass through errors
+}
+
+func (m *MockJobsService) PlayJob(pid any, job int64, opt *gitlab.PlayJobOptions) (*gitlab.Job, *gitlab.Response, error) {
+	args := m.Called(pid, job, opt)
+	result, _ := args.Get(0).(*gitlab.Job)
+	response, _ := args.Get(1).(*gitlab.Response)
+	return result, response, args.Error(errorArgIndex) //nolint:wrapcheck // Mock should pass through errors
+}
+
+// MockProjectHooksService is a mock implementation of ProjectHooksService.
+type MockProjectHooksService struct {
+	mock.Mock
+}
+
+func (m *MockProjectHooksService) AddProjectHook(
+	pid any,
+	opt *gitlab.AddProjectHookOptions,
+) (*gitlab.ProjectHook, *gitlab.Response, error) {
+	args := m.Called(pid, opt)
+	hook, _ := args.Get(0).(*gitlab.ProjectHook)
+	response, _ := args.Get(1).(*gitlab.Response)
+	return hook, response, args.Error(errorArgIndex) //nolint:wrapcheck // Mock should pass through errors
+}
+
+func (m *MockProjectHooksService) DeleteProjectHook(pid any, hook int64) (*gitlab.Response, error) {
+	args := m.Called(pid, hook)
+	response, _ := args.Get(0).(*gitlab.Response)
+	return response, args.Error(1) //nolint:wrapcheck // Mock should pass through errors
+}
+
+// MockReleasesService is a mock implementation of ReleasesService.
+type MockReleasesService struct {
+	mock.Mock
+}
+
+func (m *MockReleasesService) ListReleases(
+	pid any,
+	opt *gitlab.ListReleasesOptions,
+) ([]*gitlab.Release, *gitlab.Response, error) {
+	args := m.Called(pid, opt)
+	releases, _ := args.Get(0).([]*gitlab.Release)
+	response, _ := args.Get(1).(*gitlab.Response)
+	return releases, response, args.Error(errorArgIndex) //nolint:wrapcheck // Mock should pass through errors
+}
@@ -0,0 +1,24 @@
+package app
+
+import "time"
+
+// NoopCache is a Cache implementation that never stores anything, so every Get misses. It's
+// useful for callers that want to disable response caching entirely via App.SetCache without
+// having to implement the Cache interface themselves.
+type NoopCache struct{}
+
+// NewNoopCache creates a Cache that discards every entry it is given.
+func NewNoopCache() *NoopCache {
+	return &NoopCache{}
+}
+
+// Get always reports a miss.
+func (c *NoopCache) Get(_ string) (CacheEntry, bool) {
+	return CacheEntry{}, false
+}
+
+// Set is a no-op.
+func (c *NoopCache) Set(_ string, _ CacheEntry, _ time.Duration) {}
+
+// Delete is a no-op.
+func (c *NoopCache) Delete(_ string) {}
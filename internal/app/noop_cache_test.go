@@ -0,0 +1,21 @@
+package app
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestNoopCache_NeverServes tests that a NoopCache reports a miss even right after a Set.
+func TestNoopCache_NeverServes(t *testing.T) {
+	c := NewNoopCache()
+
+	c.Set("a", CacheEntry{Value: "a-value"}, time.Minute)
+	_, ok := c.Get("a")
+	assert.False(t, ok)
+
+	c.Delete("a")
+	_, ok = c.Get("a")
+	assert.False(t, ok)
+}
@@ -0,0 +1,129 @@
+package app
+
+import (
+	"encoding/json"
+	"strconv"
+
+	"gitlab.com/gitlab-org/api/client-go"
+)
+
+// Default caps applied by Paginator when a caller doesn't set its own, chosen to keep a
+// fully-expanded List* response well within an LLM's usable context window.
+const (
+	defaultMaxPaginatedItems = 1000
+	defaultMaxPaginatedBytes = 1 << 20 // 1 MiB of marshaled JSON
+)
+
+// PageFetchFunc retrieves a single page of results. cursor is the opaque pagination token to
+// request ("" for the first page); the function is responsible for applying it to its own
+// GitLab options before calling the API. Only offset pagination (a page number) is supported -
+// GitLab's keyset pagination is not used by any Fetch implementation in this codebase.
+type PageFetchFunc[T any] func(cursor string) ([]T, *gitlab.Response, error)
+
+// PaginationResult carries the items collected by a Paginator run, plus whether the walk was
+// cut short by MaxItems or MaxBytes before GitLab reported the result set as exhausted.
+type PaginationResult[T any] struct {
+	Items     []T
+	Truncated bool
+}
+
+// Paginator drives a paginated GitLab List* call to completion by following offset pagination's
+// "X-Next-Page" header; keyset pagination's "Link: rel=next" header is not read. MaxItems and
+// MaxBytes bound how much is collected; a zero value falls back to defaultMaxPaginatedItems /
+// defaultMaxPaginatedBytes.
+type Paginator[T any] struct {
+	Fetch    PageFetchFunc[T]
+	MaxItems int
+	MaxBytes int
+}
+
+// FetchAll walks every page reachable from Fetch, stopping at the first of: GitLab reporting no
+// further pages, MaxItems items collected, or MaxBytes of marshaled JSON collected. In the
+// latter two cases the returned result has Truncated set so the caller can surface a
+// partial-result marker to the model.
+func (p *Paginator[T]) FetchAll() (PaginationResult[T], error) {
+	maxItems := p.MaxItems
+	if maxItems <= 0 {
+		maxItems = defaultMaxPaginatedItems
+	}
+	maxBytes := p.MaxBytes
+	if maxBytes <= 0 {
+		maxBytes = defaultMaxPaginatedBytes
+	}
+
+	var items []T
+	byteCount := 0
+	cursor := ""
+
+	for {
+		pageItems, resp, err := p.Fetch(cursor)
+		if err != nil {
+			return PaginationResult[T]{Items: items}, err
+		}
+
+		for _, item := range pageItems {
+			if len(items) >= maxItems {
+				return PaginationResult[T]{Items: items, Truncated: true}, nil
+			}
+			if encoded, marshalErr := json.Marshal(item); marshalErr == nil {
+				byteCount += len(encoded)
+				if byteCount > maxBytes {
+					return PaginationResult[T]{Items: items, Truncated: true}, nil
+				}
+			}
+			items = append(items, item)
+		}
+
+		next, ok := nextPageCursor(resp)
+		if !ok {
+			break
+		}
+		cursor = next
+	}
+
+	return PaginationResult[T]{Items: items}, nil
+}
+
+// nextPageCursor extracts the next-page cursor from a GitLab API response's offset-pagination
+// X-Next-Page header.
+func nextPageCursor(resp *gitlab.Response) (string, bool) {
+	if resp == nil || resp.Response == nil {
+		return "", false
+	}
+	if next := resp.Header.Get("X-Next-Page"); next != "" {
+		return next, true
+	}
+	return "", false
+}
+
+// paginationMeta extracts the next page number and total item count reported by a single-page
+// offset-pagination fetch, from GitLab's X-Next-Page/X-Total response headers. It returns zeros
+// when resp is nil or GitLab didn't report one of the headers (e.g. keyset pagination, or the
+// last page).
+func paginationMeta(resp *gitlab.Response) (nextPage, total int64) {
+	if resp == nil {
+		return 0, 0
+	}
+	return int64(resp.NextPage), int64(resp.TotalItems)
+}
+
+// cappedPerPage returns limit clamped to max, for callers that auto-paginate up to an overall
+// Limit while keeping each individual page request within GitLab's per-resource page-size cap.
+func cappedPerPage(limit int64, max int64) int64 {
+	if limit > max {
+		return max
+	}
+	return limit
+}
+
+// cursorToPage converts a Paginator cursor into an offset-pagination page number. It returns the
+// fallback page when the cursor is empty (first page) or not a plain integer.
+func cursorToPage(cursor string, fallback int64) int64 {
+	if cursor == "" {
+		return fallback
+	}
+	if page, err := strconv.ParseInt(cursor, 10, 64); err == nil {
+		return page
+	}
+	return fallback
+}
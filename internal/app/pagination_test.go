@@ -0,0 +1,163 @@
+package app
+
+import (
+	"log/slog"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gitlab.com/gitlab-org/api/client-go"
+)
+
+// pageResponse builds a *gitlab.Response carrying the X-Next-Page header nextPageCursor reads,
+// or no header at all when nextPage is "" to simulate the last page.
+func pageResponse(nextPage string) *gitlab.Response {
+	header := http.Header{}
+	if nextPage != "" {
+		header.Set("X-Next-Page", nextPage)
+	}
+	return &gitlab.Response{Response: &http.Response{Header: header}}
+}
+
+func TestCappedPerPage(t *testing.T) {
+	tests := []struct {
+		name  string
+		limit int64
+		max   int64
+		want  int64
+	}{
+		{name: "limit below max is unchanged", limit: 50, max: 100, want: 50},
+		{name: "limit above max is capped", limit: 250, max: 100, want: 100},
+		{name: "limit equal to max is unchanged", limit: 100, max: 100, want: 100},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, cappedPerPage(tt.limit, tt.max))
+		})
+	}
+}
+
+// TestApp_ListProjectIssues_AutoPaginates verifies that a Limit spanning multiple pages drives
+// three sequential ListProjectIssues calls, and that the items from every page are aggregated.
+func TestApp_ListProjectIssues_AutoPaginates(t *testing.T) {
+	mockClient := &MockGitLabClient{}
+	mockProjects := &MockProjectsService{}
+	mockIssues := &MockIssuesService{}
+
+	mockClient.On("Projects").Return(mockProjects)
+	mockClient.On("Issues").Return(mockIssues)
+	mockProjects.On("GetProject", "test/project", (*gitlab.GetProjectOptions)(nil)).Return(
+		&gitlab.Project{ID: 123}, &gitlab.Response{}, nil,
+	)
+
+	page := func(page int) *gitlab.ListProjectIssuesOptions {
+		return &gitlab.ListProjectIssuesOptions{
+			State:       gitlab.Ptr("opened"),
+			ListOptions: gitlab.ListOptions{PerPage: 100, Page: page},
+		}
+	}
+	issuesOfLen := func(n int) []*gitlab.Issue {
+		issues := make([]*gitlab.Issue, n)
+		for i := range issues {
+			issues[i] = &gitlab.Issue{ID: int64(i + 1), IID: int64(i + 1), State: "opened"}
+		}
+		return issues
+	}
+
+	mockIssues.On("ListProjectIssues", 123, page(1)).Return(issuesOfLen(100), pageResponse("2"), nil)
+	mockIssues.On("ListProjectIssues", 123, page(2)).Return(issuesOfLen(100), pageResponse("3"), nil)
+	mockIssues.On("ListProjectIssues", 123, page(3)).Return(issuesOfLen(50), pageResponse(""), nil)
+
+	app := NewWithClient("token", "https://gitlab.com/", mockClient)
+	app.SetLogger(slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelError})))
+
+	result, err := app.ListProjectIssues("test/project", &ListIssuesOptions{State: "opened", Limit: 250})
+
+	assert.NoError(t, err)
+	assert.Len(t, result, 250)
+
+	mockClient.AssertExpectations(t)
+	mockProjects.AssertExpectations(t)
+	mockIssues.AssertExpectations(t)
+}
+
+// TestApp_ListProjectIssues_AutoPaginateStopsEarly verifies that auto-pagination stops as soon as
+// GitLab reports no further page, even though fewer items than Limit were collected.
+func TestApp_ListProjectIssues_AutoPaginateStopsEarly(t *testing.T) {
+	mockClient := &MockGitLabClient{}
+	mockProjects := &MockProjectsService{}
+	mockIssues := &MockIssuesService{}
+
+	mockClient.On("Projects").Return(mockProjects)
+	mockClient.On("Issues").Return(mockIssues)
+	mockProjects.On("GetProject", "test/project", (*gitlab.GetProjectOptions)(nil)).Return(
+		&gitlab.Project{ID: 123}, &gitlab.Response{}, nil,
+	)
+
+	expectedOpts := &gitlab.ListProjectIssuesOptions{
+		State:       gitlab.Ptr("opened"),
+		ListOptions: gitlab.ListOptions{PerPage: 100, Page: 1},
+	}
+	mockIssues.On("ListProjectIssues", 123, expectedOpts).Return(
+		[]*gitlab.Issue{{ID: 1, IID: 1, State: "opened"}}, pageResponse(""), nil,
+	)
+
+	app := NewWithClient("token", "https://gitlab.com/", mockClient)
+	app.SetLogger(slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelError})))
+
+	result, err := app.ListProjectIssues("test/project", &ListIssuesOptions{State: "opened", Limit: 250})
+
+	assert.NoError(t, err)
+	assert.Len(t, result, 1)
+
+	// Only the single page above is registered on the mock; a second call would fail the test.
+	mockClient.AssertExpectations(t)
+	mockProjects.AssertExpectations(t)
+	mockIssues.AssertExpectations(t)
+}
+
+// TestApp_ListProjectLabels_AutoPaginates mirrors TestApp_ListProjectIssues_AutoPaginates for
+// ListProjectLabels, which shares the same Paginator-based fetch strategy.
+func TestApp_ListProjectLabels_AutoPaginates(t *testing.T) {
+	mockClient := &MockGitLabClient{}
+	mockProjects := &MockProjectsService{}
+	mockLabels := &MockLabelsService{}
+
+	mockClient.On("Projects").Return(mockProjects)
+	mockClient.On("Labels").Return(mockLabels)
+	mockProjects.On("GetProject", "test/project", (*gitlab.GetProjectOptions)(nil)).Return(
+		&gitlab.Project{ID: 123}, &gitlab.Response{}, nil,
+	)
+
+	page := func(page int) *gitlab.ListLabelsOptions {
+		return &gitlab.ListLabelsOptions{
+			WithCounts:            gitlab.Ptr(false),
+			IncludeAncestorGroups: gitlab.Ptr(false),
+			ListOptions:           gitlab.ListOptions{PerPage: 100, Page: page},
+		}
+	}
+	labelsOfLen := func(n int) []*gitlab.Label {
+		labels := make([]*gitlab.Label, n)
+		for i := range labels {
+			labels[i] = &gitlab.Label{ID: int64(i + 1), Name: "label"}
+		}
+		return labels
+	}
+
+	mockLabels.On("ListLabels", 123, page(1)).Return(labelsOfLen(100), pageResponse("2"), nil)
+	mockLabels.On("ListLabels", 123, page(2)).Return(labelsOfLen(50), pageResponse(""), nil)
+
+	app := NewWithClient("token", "https://gitlab.com/", mockClient)
+	app.SetLogger(slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelError})))
+
+	result, err := app.ListProjectLabels("test/project", &ListLabelsOptions{Limit: 150})
+
+	assert.NoError(t, err)
+	assert.Len(t, result, 150)
+
+	mockClient.AssertExpectations(t)
+	mockProjects.AssertExpectations(t)
+	mockLabels.AssertExpectations(t)
+}
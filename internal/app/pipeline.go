@@ -0,0 +1,482 @@
+package app
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/sgaunet/gitlab-mcp/internal/gitlaberr"
+	"gitlab.com/gitlab-org/api/client-go"
+)
+
+// maxPipelinesPerPage caps how many pipelines ListProjectPipelines requests in one page.
+const maxPipelinesPerPage = 100
+
+// ErrPipelineIDRequired is returned when a pipeline operation is requested without a pipeline ID.
+var ErrPipelineIDRequired = errors.New("pipeline ID must be a positive integer")
+
+// Pipeline represents a GitLab CI/CD pipeline, normalized for MCP tool responses.
+type Pipeline struct {
+	ID        int64  `json:"id"`
+	IID       int64  `json:"iid"`
+	Ref       string `json:"ref"`
+	SHA       string `json:"sha"`
+	Status    string `json:"status"`
+	Source    string `json:"source"`
+	WebURL    string `json:"web_url"`
+	CreatedAt string `json:"created_at"`
+	UpdatedAt string `json:"updated_at"`
+	Duration  int    `json:"duration_seconds"`
+}
+
+// Job represents a single job within a GitLab CI/CD pipeline.
+type Job struct {
+	ID     int64  `json:"id"`
+	Name   string `json:"name"`
+	Stage  string `json:"stage"`
+	Status string `json:"status"`
+	WebURL string `json:"web_url"`
+}
+
+// PipelineVariable represents a single CI/CD variable attached to a pipeline run.
+type PipelineVariable struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// ListPipelinesOptions contains options for listing a project's pipelines.
+type ListPipelinesOptions struct {
+	Ref    string
+	Status string
+	Source string
+	// Username filters to pipelines triggered by a given GitLab username.
+	Username string
+	// UpdatedAfter and UpdatedBefore filter to pipelines last updated within the window, when set.
+	UpdatedAfter  *time.Time
+	UpdatedBefore *time.Time
+	Limit         int64
+}
+
+// normalizeListPipelinesOptions sets default values for list pipelines options.
+func normalizeListPipelinesOptions(opts *ListPipelinesOptions) *ListPipelinesOptions {
+	if opts == nil {
+		opts = &ListPipelinesOptions{}
+	}
+	if opts.Limit == 0 {
+		opts.Limit = maxPipelinesPerPage
+	}
+	if opts.Limit > maxPipelinesPerPage {
+		opts.Limit = maxPipelinesPerPage
+	}
+	return opts
+}
+
+// normalizePipelineStatus maps a raw GitLab pipeline or job status to one of a small set of
+// coarse-grained states ("running", "success", "failed", "canceled") so assistants can surface
+// build health without tracking every status GitLab can report. A status it doesn't recognize is
+// returned unchanged.
+func normalizePipelineStatus(status string) string {
+	switch status {
+	case "success":
+		return "success"
+	case "failed":
+		return "failed"
+	case "canceled", "skipped":
+		return "canceled"
+	case "created", "waiting_for_resource", "preparing", "pending", "running", "scheduled", "manual":
+		return "running"
+	default:
+		return status
+	}
+}
+
+// convertGitLabPipelineInfo converts a GitLab pipeline list entry to our Pipeline struct.
+func convertGitLabPipelineInfo(info *gitlab.PipelineInfo) Pipeline {
+	return Pipeline{
+		ID:     info.ID,
+		IID:    info.IID,
+		Ref:    info.Ref,
+		SHA:    info.SHA,
+		Status: normalizePipelineStatus(info.Status),
+		Source: info.Source,
+		WebURL: info.WebURL,
+	}
+}
+
+// convertGitLabPipeline converts a single full GitLab pipeline to our Pipeline struct.
+func convertGitLabPipeline(pipeline *gitlab.Pipeline) Pipeline {
+	return Pipeline{
+		ID:        pipeline.ID,
+		IID:       pipeline.IID,
+		Ref:       pipeline.Ref,
+		SHA:       pipeline.SHA,
+		Status:    normalizePipelineStatus(pipeline.Status),
+		Source:    pipeline.Source,
+		WebURL:    pipeline.WebURL,
+		CreatedAt: formatGitLabTime(pipeline.CreatedAt),
+		UpdatedAt: formatGitLabTime(pipeline.UpdatedAt),
+		Duration:  pipeline.Duration,
+	}
+}
+
+// convertGitLabJob converts a GitLab job to our Job struct.
+func convertGitLabJob(job *gitlab.Job) Job {
+	return Job{
+		ID:     job.ID,
+		Name:   job.Name,
+		Stage:  job.Stage,
+		Status: normalizePipelineStatus(job.Status),
+		WebURL: job.WebURL,
+	}
+}
+
+// convertGitLabPipelineVariable converts a GitLab pipeline variable to our PipelineVariable struct.
+func convertGitLabPipelineVariable(variable *gitlab.PipelineVariable) PipelineVariable {
+	return PipelineVariable{
+		Key:   variable.Key,
+		Value: variable.Value,
+	}
+}
+
+// ListProjectPipelines lists pipelines for a GitLab project, optionally filtered by ref and status.
+func (a *App) ListProjectPipelines(projectPath string, opts *ListPipelinesOptions) ([]Pipeline, error) {
+	a.logger.Debug("Listing pipelines for project", "project_path", projectPath, "options", opts)
+
+	project, err := a.getProjectCached(projectPath)
+	if err != nil {
+		a.logger.Error("Failed to get project", "error", err, "project_path", projectPath)
+		return nil, err
+	}
+
+	opts = normalizeListPipelinesOptions(opts)
+
+	listOpts := &gitlab.ListProjectPipelinesOptions{
+		ListOptions: gitlab.ListOptions{PerPage: int(opts.Limit), Page: 1},
+	}
+	if opts.Ref != "" {
+		listOpts.Ref = &opts.Ref
+	}
+	if opts.Status != "" {
+		listOpts.Status = gitlab.Ptr(gitlab.BuildStateValue(opts.Status))
+	}
+	if opts.Source != "" {
+		listOpts.Source = &opts.Source
+	}
+	if opts.Username != "" {
+		listOpts.Username = &opts.Username
+	}
+	if opts.UpdatedAfter != nil {
+		listOpts.UpdatedAfter = opts.UpdatedAfter
+	}
+	if opts.UpdatedBefore != nil {
+		listOpts.UpdatedBefore = opts.UpdatedBefore
+	}
+
+	pipelines, _, err := retryCall(a, context.Background(), "ListProjectPipelines",
+		func() ([]*gitlab.PipelineInfo, *gitlab.Response, error) {
+			return a.client.Pipelines().ListProjectPipelines(project.ID, listOpts)
+		})
+	if err != nil {
+		a.logger.Error("Failed to list project pipelines", "error", err, "project_id", project.ID)
+		return nil, gitlaberr.Classify(err, "failed to list project pipelines")
+	}
+
+	result := make([]Pipeline, 0, len(pipelines))
+	for _, pipeline := range pipelines {
+		result = append(result, convertGitLabPipelineInfo(pipeline))
+	}
+
+	a.logger.Info("Successfully listed project pipelines", "count", len(result), "project_id", project.ID)
+	return result, nil
+}
+
+// GetPipeline retrieves a single pipeline by ID.
+func (a *App) GetPipeline(projectPath string, pipelineID int64) (*Pipeline, error) {
+	if pipelineID <= 0 {
+		return nil, ErrPipelineIDRequired
+	}
+
+	a.logger.Debug("Getting pipeline", "project_path", projectPath, "pipeline_id", pipelineID)
+
+	project, err := a.getProjectCached(projectPath)
+	if err != nil {
+		a.logger.Error("Failed to get project", "error", err, "project_path", projectPath)
+		return nil, err
+	}
+
+	pipeline, _, err := retryCall(a, context.Background(), "GetPipeline",
+		func() (*gitlab.Pipeline, *gitlab.Response, error) {
+			return a.client.Pipelines().GetPipeline(project.ID, pipelineID)
+		})
+	if err != nil {
+		a.logger.Error("Failed to get pipeline", "error", err, "project_id", project.ID, "pipeline_id", pipelineID)
+		return nil, gitlaberr.Classify(err, "failed to get pipeline")
+	}
+
+	result := convertGitLabPipeline(pipeline)
+	a.logger.Info("Successfully retrieved pipeline", "pipeline_id", result.ID, "project_id", project.ID)
+	return &result, nil
+}
+
+// CreatePipeline triggers a new pipeline run for the given ref.
+func (a *App) CreatePipeline(projectPath string, ref string) (*Pipeline, error) {
+	a.logger.Debug("Creating pipeline", "project_path", projectPath, "ref", ref)
+
+	project, err := a.getProjectCached(projectPath)
+	if err != nil {
+		a.logger.Error("Failed to get project", "error", err, "project_path", projectPath)
+		return nil, err
+	}
+
+	pipeline, _, err := retryCall(a, context.Background(), "CreatePipeline",
+		func() (*gitlab.Pipeline, *gitlab.Response, error) {
+			return a.client.Pipelines().CreatePipeline(project.ID, &gitlab.CreatePipelineOptions{Ref: &ref})
+		})
+	if err != nil {
+		a.logger.Error("Failed to create pipeline", "error", err, "project_id", project.ID, "ref", ref)
+		return nil, gitlaberr.Classify(err, "failed to create pipeline")
+	}
+
+	result := convertGitLabPipeline(pipeline)
+	a.logger.Info("Successfully created pipeline", "pipeline_id", result.ID, "project_id", project.ID, "ref", ref)
+	return &result, nil
+}
+
+// RetryPipeline retries the failed or canceled jobs of a pipeline.
+func (a *App) RetryPipeline(projectPath string, pipelineID int64) (*Pipeline, error) {
+	if pipelineID <= 0 {
+		return nil, ErrPipelineIDRequired
+	}
+
+	a.logger.Debug("Retrying pipeline", "project_path", projectPath, "pipeline_id", pipelineID)
+
+	project, err := a.getProjectCached(projectPath)
+	if err != nil {
+		a.logger.Error("Failed to get project", "error", err, "project_path", projectPath)
+		return nil, err
+	}
+
+	pipeline, _, err := retryCall(a, context.Background(), "RetryPipelineBuild",
+		func() (*gitlab.Pipeline, *gitlab.Response, error) {
+			return a.client.Pipelines().RetryPipelineBuild(project.ID, pipelineID)
+		})
+	if err != nil {
+		a.logger.Error("Failed to retry pipeline", "error", err, "project_id", project.ID, "pipeline_id", pipelineID)
+		return nil, gitlaberr.Classify(err, "failed to retry pipeline")
+	}
+
+	result := convertGitLabPipeline(pipeline)
+	a.logger.Info("Successfully retried pipeline", "pipeline_id", result.ID, "project_id", project.ID)
+	return &result, nil
+}
+
+// CancelPipeline cancels a running pipeline.
+func (a *App) CancelPipeline(projectPath string, pipelineID int64) (*Pipeline, error) {
+	if pipelineID <= 0 {
+		return nil, ErrPipelineIDRequired
+	}
+
+	a.logger.Debug("Canceling pipeline", "project_path", projectPath, "pipeline_id", pipelineID)
+
+	project, err := a.getProjectCached(projectPath)
+	if err != nil {
+		a.logger.Error("Failed to get project", "error", err, "project_path", projectPath)
+		return nil, err
+	}
+
+	pipeline, _, err := retryCall(a, context.Background(), "CancelPipelineBuild",
+		func() (*gitlab.Pipeline, *gitlab.Response, error) {
+			return a.client.Pipelines().CancelPipelineBuild(project.ID, pipelineID)
+		})
+	if err != nil {
+		a.logger.Error("Failed to cancel pipeline", "error", err, "project_id", project.ID, "pipeline_id", pipelineID)
+		return nil, gitlaberr.Classify(err, "failed to cancel pipeline")
+	}
+
+	result := convertGitLabPipeline(pipeline)
+	a.logger.Info("Successfully canceled pipeline", "pipeline_id", result.ID, "project_id", project.ID)
+	return &result, nil
+}
+
+// GetPipelineVariables retrieves the CI/CD variables a pipeline was triggered with.
+func (a *App) GetPipelineVariables(projectPath string, pipelineID int64) ([]PipelineVariable, error) {
+	if pipelineID <= 0 {
+		return nil, ErrPipelineIDRequired
+	}
+
+	a.logger.Debug("Getting pipeline variables", "project_path", projectPath, "pipeline_id", pipelineID)
+
+	project, err := a.getProjectCached(projectPath)
+	if err != nil {
+		a.logger.Error("Failed to get project", "error", err, "project_path", projectPath)
+		return nil, err
+	}
+
+	variables, _, err := retryCall(a, context.Background(), "GetPipelineVariables",
+		func() ([]*gitlab.PipelineVariable, *gitlab.Response, error) {
+			return a.client.Pipelines().GetPipelineVariables(project.ID, pipelineID)
+		})
+	if err != nil {
+		a.logger.Error("Failed to get pipeline variables", "error", err, "project_id", project.ID, "pipeline_id", pipelineID)
+		return nil, gitlaberr.Classify(err, "failed to get pipeline variables")
+	}
+
+	result := make([]PipelineVariable, 0, len(variables))
+	for _, variable := range variables {
+		result = append(result, convertGitLabPipelineVariable(variable))
+	}
+
+	a.logger.Info("Successfully retrieved pipeline variables", "count", len(result), "pipeline_id", pipelineID)
+	return result, nil
+}
+
+// ListPipelineJobs lists the jobs belonging to a pipeline.
+func (a *App) ListPipelineJobs(projectPath string, pipelineID int64) ([]Job, error) {
+	if pipelineID <= 0 {
+		return nil, ErrPipelineIDRequired
+	}
+
+	a.logger.Debug("Listing pipeline jobs", "project_path", projectPath, "pipeline_id", pipelineID)
+
+	project, err := a.getProjectCached(projectPath)
+	if err != nil {
+		a.logger.Error("Failed to get project", "error", err, "project_path", projectPath)
+		return nil, err
+	}
+
+	jobs, _, err := retryCall(a, context.Background(), "ListPipelineJobs",
+		func() ([]*gitlab.Job, *gitlab.Response, error) {
+			return a.client.Jobs().ListPipelineJobs(project.ID, pipelineID, nil)
+		})
+	if err != nil {
+		a.logger.Error("Failed to list pipeline jobs", "error", err, "project_id", project.ID, "pipeline_id", pipelineID)
+		return nil, gitlaberr.Classify(err, "failed to list pipeline jobs")
+	}
+
+	result := make([]Job, 0, len(jobs))
+	for _, job := range jobs {
+		result = append(result, convertGitLabJob(job))
+	}
+
+	a.logger.Info("Successfully listed pipeline jobs", "count", len(result), "pipeline_id", pipelineID)
+	return result, nil
+}
+
+// GetJobLog retrieves the trace log of a single CI/CD job as plain text.
+func (a *App) GetJobLog(projectPath string, jobID int64) (string, error) {
+	if jobID <= 0 {
+		return "", ErrPipelineIDRequired
+	}
+
+	a.logger.Debug("Getting job log", "project_path", projectPath, "job_id", jobID)
+
+	project, err := a.getProjectCached(projectPath)
+	if err != nil {
+		a.logger.Error("Failed to get project", "error", err, "project_path", projectPath)
+		return "", err
+	}
+
+	trace, _, err := retryCall(a, context.Background(), "GetTraceFile",
+		func() (*bytes.Reader, *gitlab.Response, error) {
+			return a.client.Jobs().GetTraceFile(project.ID, jobID)
+		})
+	if err != nil {
+		a.logger.Error("Failed to get job log", "error", err, "project_id", project.ID, "job_id", jobID)
+		return "", gitlaberr.Classify(err, "failed to get job log")
+	}
+
+	buf := new(bytes.Buffer)
+	if _, err := buf.ReadFrom(trace); err != nil {
+		return "", fmt.Errorf("failed to read job log: %w", err)
+	}
+
+	a.logger.Info("Successfully retrieved job log", "project_id", project.ID, "job_id", jobID)
+	return buf.String(), nil
+}
+
+// GetLastPipeline retrieves the most recent pipeline for a given ref (branch or tag).
+func (a *App) GetLastPipeline(projectPath string, ref string) (*Pipeline, error) {
+	a.logger.Debug("Getting last pipeline", "project_path", projectPath, "ref", ref)
+
+	project, err := a.getProjectCached(projectPath)
+	if err != nil {
+		a.logger.Error("Failed to get project", "error", err, "project_path", projectPath)
+		return nil, err
+	}
+
+	opt := &gitlab.GetLatestPipelineOptions{}
+	if ref != "" {
+		opt.Ref = &ref
+	}
+
+	pipeline, _, err := retryCall(a, context.Background(), "GetLatestPipeline",
+		func() (*gitlab.Pipeline, *gitlab.Response, error) {
+			return a.client.Pipelines().GetLatestPipeline(project.ID, opt)
+		})
+	if err != nil {
+		a.logger.Error("Failed to get last pipeline", "error", err, "project_id", project.ID, "ref", ref)
+		return nil, gitlaberr.Classify(err, "failed to get last pipeline")
+	}
+
+	result := convertGitLabPipeline(pipeline)
+	a.logger.Info("Successfully retrieved last pipeline", "pipeline_id", result.ID, "project_id", project.ID)
+	return &result, nil
+}
+
+// CancelPipelineJob cancels a single running CI/CD job.
+func (a *App) CancelPipelineJob(projectPath string, jobID int64) (*Job, error) {
+	if jobID <= 0 {
+		return nil, ErrPipelineIDRequired
+	}
+
+	a.logger.Debug("Canceling pipeline job", "project_path", projectPath, "job_id", jobID)
+
+	project, err := a.getProjectCached(projectPath)
+	if err != nil {
+		a.logger.Error("Failed to get project", "error", err, "project_path", projectPath)
+		return nil, err
+	}
+
+	job, _, err := retryCall(a, context.Background(), "CancelJob",
+		func() (*gitlab.Job, *gitlab.Response, error) {
+			return a.client.Jobs().CancelJob(project.ID, jobID)
+		})
+	if err != nil {
+		a.logger.Error("Failed to cancel pipeline job", "error", err, "project_id", project.ID, "job_id", jobID)
+		return nil, gitlaberr.Classify(err, "failed to cancel pipeline job")
+	}
+
+	result := convertGitLabJob(job)
+	a.logger.Info("Successfully canceled pipeline job", "job_id", result.ID, "project_id", project.ID)
+	return &result, nil
+}
+
+// PlayJob triggers a manual CI/CD job to start running.
+func (a *App) PlayJob(projectPath string, jobID int64) (*Job, error) {
+	if jobID <= 0 {
+		return nil, ErrPipelineIDRequired
+	}
+
+	a.logger.Debug("Playing job", "project_path", projectPath, "job_id", jobID)
+
+	project, err := a.getProjectCached(projectPath)
+	if err != nil {
+		a.logger.Error("Failed to get project", "error", err, "project_path", projectPath)
+		return nil, err
+	}
+
+	job, _, err := retryCall(a, context.Background(), "PlayJob",
+		func() (*gitlab.Job, *gitlab.Response, error) {
+			return a.client.Jobs().PlayJob(project.ID, jobID, nil)
+		})
+	if err != nil {
+		a.logger.Error("Failed to play job", "error", err, "project_id", project.ID, "job_id", jobID)
+		return nil, gitlaberr.Classify(err, "failed to play job")
+	}
+
+	result := convertGitLabJob(job)
+	a.logger.Info("Successfully played job", "job_id", result.ID, "project_id", project.ID)
+	return &result, nil
+}
@@ -0,0 +1,202 @@
+package app
+
+import (
+	"bytes"
+	"log/slog"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gitlab.com/gitlab-org/api/client-go"
+)
+
+// TestNormalizePipelineStatus tests that normalizePipelineStatus buckets raw GitLab statuses into
+// the small set of coarse-grained states assistants surface.
+func TestNormalizePipelineStatus(t *testing.T) {
+	tests := []struct {
+		status string
+		want   string
+	}{
+		{status: "success", want: "success"},
+		{status: "failed", want: "failed"},
+		{status: "canceled", want: "canceled"},
+		{status: "skipped", want: "canceled"},
+		{status: "pending", want: "running"},
+		{status: "running", want: "running"},
+		{status: "manual", want: "running"},
+		{status: "something_unknown", want: "something_unknown"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.status, func(t *testing.T) {
+			assert.Equal(t, tt.want, normalizePipelineStatus(tt.status))
+		})
+	}
+}
+
+func newTestAppForPipeline(mockClient *MockGitLabClient) *App {
+	a := NewWithClient("token", "https://gitlab.com/", mockClient)
+	a.SetLogger(slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelError})))
+	return a
+}
+
+// TestApp_GetPipeline tests the App.GetPipeline method.
+func TestApp_GetPipeline(t *testing.T) {
+	tests := []struct {
+		name       string
+		pipelineID int64
+		setup      func(*MockGitLabClient, *MockProjectsService, *MockPipelinesService)
+		wantErr    bool
+		errType    error
+	}{
+		{
+			name:       "invalid pipeline id",
+			pipelineID: 0,
+			setup:      func(_ *MockGitLabClient, _ *MockProjectsService, _ *MockPipelinesService) {},
+			wantErr:    true,
+			errType:    ErrPipelineIDRequired,
+		},
+		{
+			name:       "success",
+			pipelineID: 42,
+			setup: func(client *MockGitLabClient, projects *MockProjectsService, pipelines *MockPipelinesService) {
+				client.On("Projects").Return(projects)
+				client.On("Pipelines").Return(pipelines)
+
+				projects.On("GetProject", "test/project", (*gitlab.GetProjectOptions)(nil)).Return(
+					&gitlab.Project{ID: 7}, &gitlab.Response{}, nil,
+				)
+
+				pipelines.On("GetPipeline", int64(7), int64(42)).Return(
+					&gitlab.Pipeline{ID: 42, Ref: "main", Status: "running"}, &gitlab.Response{}, nil,
+				)
+			},
+			wantErr: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := new(MockGitLabClient)
+			mockProjects := new(MockProjectsService)
+			mockPipelines := new(MockPipelinesService)
+			tt.setup(mockClient, mockProjects, mockPipelines)
+
+			a := newTestAppForPipeline(mockClient)
+
+			got, err := a.GetPipeline("test/project", tt.pipelineID)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				if tt.errType != nil {
+					assert.ErrorIs(t, err, tt.errType)
+				}
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, got)
+			assert.Equal(t, int64(42), got.ID)
+			assert.Equal(t, "running", got.Status)
+		})
+	}
+}
+
+// TestApp_ListProjectPipelines tests the App.ListProjectPipelines method.
+func TestApp_ListProjectPipelines(t *testing.T) {
+	mockClient := new(MockGitLabClient)
+	mockProjects := new(MockProjectsService)
+	mockPipelines := new(MockPipelinesService)
+
+	mockClient.On("Projects").Return(mockProjects)
+	mockClient.On("Pipelines").Return(mockPipelines)
+
+	mockProjects.On("GetProject", "test/project", (*gitlab.GetProjectOptions)(nil)).Return(
+		&gitlab.Project{ID: 7}, &gitlab.Response{}, nil,
+	)
+
+	mockPipelines.On("ListProjectPipelines", int64(7), &gitlab.ListProjectPipelinesOptions{
+		ListOptions: gitlab.ListOptions{PerPage: maxPipelinesPerPage, Page: 1},
+	}).Return([]*gitlab.PipelineInfo{
+		{ID: 1, Ref: "main", Status: "success"},
+		{ID: 2, Ref: "main", Status: "failed"},
+	}, &gitlab.Response{}, nil)
+
+	a := newTestAppForPipeline(mockClient)
+
+	got, err := a.ListProjectPipelines("test/project", nil)
+
+	require.NoError(t, err)
+	require.Len(t, got, 2)
+	assert.Equal(t, "success", got[0].Status)
+	assert.Equal(t, "failed", got[1].Status)
+}
+
+// TestApp_ListProjectPipelines_SourceAndUsernameFilters tests that ListProjectPipelines forwards
+// the source and username filters to the GitLab API.
+func TestApp_ListProjectPipelines_SourceAndUsernameFilters(t *testing.T) {
+	mockClient := new(MockGitLabClient)
+	mockProjects := new(MockProjectsService)
+	mockPipelines := new(MockPipelinesService)
+
+	mockClient.On("Projects").Return(mockProjects)
+	mockClient.On("Pipelines").Return(mockPipelines)
+
+	mockProjects.On("GetProject", "test/project", (*gitlab.GetProjectOptions)(nil)).Return(
+		&gitlab.Project{ID: 7}, &gitlab.Response{}, nil,
+	)
+
+	source := "schedule"
+	username := "alice"
+	mockPipelines.On("ListProjectPipelines", int64(7), &gitlab.ListProjectPipelinesOptions{
+		ListOptions: gitlab.ListOptions{PerPage: maxPipelinesPerPage, Page: 1},
+		Source:      &source,
+		Username:    &username,
+	}).Return([]*gitlab.PipelineInfo{
+		{ID: 1, Ref: "main", Status: "success", Source: "schedule"},
+	}, &gitlab.Response{}, nil)
+
+	a := newTestAppForPipeline(mockClient)
+
+	got, err := a.ListProjectPipelines("test/project", &ListPipelinesOptions{Source: source, Username: username})
+
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+	assert.Equal(t, "schedule", got[0].Source)
+}
+
+// TestApp_GetJobLog tests the App.GetJobLog method.
+func TestApp_GetJobLog(t *testing.T) {
+	mockClient := new(MockGitLabClient)
+	mockProjects := new(MockProjectsService)
+	mockJobs := new(MockJobsService)
+
+	mockClient.On("Projects").Return(mockProjects)
+	mockClient.On("Jobs").Return(mockJobs)
+
+	mockProjects.On("GetProject", "test/project", (*gitlab.GetProjectOptions)(nil)).Return(
+		&gitlab.Project{ID: 7}, &gitlab.Response{}, nil,
+	)
+
+	mockJobs.On("GetTraceFile", int64(7), int64(99)).Return(
+		bytes.NewReader([]byte("build output")), &gitlab.Response{}, nil,
+	)
+
+	a := newTestAppForPipeline(mockClient)
+
+	got, err := a.GetJobLog("test/project", 99)
+
+	require.NoError(t, err)
+	assert.Equal(t, "build output", got)
+}
+
+// TestApp_GetJobLog_InvalidID tests that GetJobLog rejects a non-positive job ID before calling
+// the GitLab API.
+func TestApp_GetJobLog_InvalidID(t *testing.T) {
+	a := newTestAppForPipeline(new(MockGitLabClient))
+
+	_, err := a.GetJobLog("test/project", 0)
+
+	assert.ErrorIs(t, err, ErrPipelineIDRequired)
+}
@@ -0,0 +1,197 @@
+package app
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/gobwas/glob"
+)
+
+// ErrBulkUpdateOptionsRequired is returned when a bulk topic/description update is attempted
+// without options.
+var ErrBulkUpdateOptionsRequired = errors.New("bulk update options are required")
+
+// ErrBulkTopicsModeConflict is returned when both Set and Add/Remove are supplied for a bulk
+// topics update - the two are mutually exclusive semantics (replace vs merge).
+var ErrBulkTopicsModeConflict = errors.New("set cannot be combined with add or remove")
+
+// BulkUpdateTopicsOptions selects the projects under a namespace to update and the topic change
+// to apply to each. IncludeGlob/ExcludeGlob filter matched projects by their path; leaving
+// IncludeGlob empty matches every project, and leaving ExcludeGlob empty excludes none. Either
+// Set (replace semantics) or Add/Remove (merge semantics) may be supplied, but not both.
+type BulkUpdateTopicsOptions struct {
+	IncludeGlob        string
+	ExcludeGlob        string
+	SubgroupsRecursive bool
+	Add                []string
+	Remove             []string
+	Set                []string
+}
+
+// BulkUpdateDescriptionOptions selects the projects under a namespace to update and the
+// description to apply to each. IncludeGlob/ExcludeGlob filter matched projects by their path;
+// leaving IncludeGlob empty matches every project, and leaving ExcludeGlob empty excludes none.
+type BulkUpdateDescriptionOptions struct {
+	IncludeGlob        string
+	ExcludeGlob        string
+	SubgroupsRecursive bool
+	Description        string
+}
+
+// BulkProjectUpdateFailure records a project that could not be updated, along with the error.
+type BulkProjectUpdateFailure struct {
+	ProjectPath string
+	Err         error
+}
+
+// BulkProjectUpdateResult contains the outcome of a bulk project update.
+type BulkProjectUpdateResult struct {
+	Successes []*ProjectInfo
+	Failures  []BulkProjectUpdateFailure
+}
+
+// BulkUpdateProjectTopics updates the topics of every project under namespace whose path matches
+// opts's glob filters, using either Set (replace semantics) or Add/Remove (merge semantics). A
+// failure on one project does not abort the others - every outcome is reported in the returned
+// BulkProjectUpdateResult.
+func (a *App) BulkUpdateProjectTopics(namespace string, opts *BulkUpdateTopicsOptions) (*BulkProjectUpdateResult, error) {
+	if opts == nil {
+		return nil, ErrBulkUpdateOptionsRequired
+	}
+	if len(opts.Set) > 0 && (len(opts.Add) > 0 || len(opts.Remove) > 0) {
+		return nil, ErrBulkTopicsModeConflict
+	}
+
+	projects, err := a.listNamespaceProjectsForBulkUpdate(namespace, opts.IncludeGlob, opts.ExcludeGlob, opts.SubgroupsRecursive)
+	if err != nil {
+		return nil, err
+	}
+
+	a.logger.Debug("Bulk updating project topics", "namespace", namespace, "project_count", len(projects))
+
+	result := &BulkProjectUpdateResult{
+		Successes: make([]*ProjectInfo, 0, len(projects)),
+		Failures:  make([]BulkProjectUpdateFailure, 0),
+	}
+	for _, project := range projects {
+		topics := applyTopicsDiff(project.Topics, opts)
+		updated, err := a.UpdateProjectTopics(project.Path, topics)
+		if err != nil {
+			result.Failures = append(result.Failures, BulkProjectUpdateFailure{ProjectPath: project.Path, Err: err})
+			continue
+		}
+		result.Successes = append(result.Successes, updated)
+	}
+
+	a.logger.Info("Bulk topics update complete", "namespace", namespace,
+		"succeeded", len(result.Successes), "failed", len(result.Failures))
+	return result, nil
+}
+
+// BulkUpdateProjectDescription updates the description of every project under namespace whose
+// path matches opts's glob filters. A failure on one project does not abort the others - every
+// outcome is reported in the returned BulkProjectUpdateResult.
+func (a *App) BulkUpdateProjectDescription(namespace string, opts *BulkUpdateDescriptionOptions) (*BulkProjectUpdateResult, error) {
+	if opts == nil {
+		return nil, ErrBulkUpdateOptionsRequired
+	}
+
+	projects, err := a.listNamespaceProjectsForBulkUpdate(namespace, opts.IncludeGlob, opts.ExcludeGlob, opts.SubgroupsRecursive)
+	if err != nil {
+		return nil, err
+	}
+
+	a.logger.Debug("Bulk updating project descriptions", "namespace", namespace, "project_count", len(projects))
+
+	result := &BulkProjectUpdateResult{
+		Successes: make([]*ProjectInfo, 0, len(projects)),
+		Failures:  make([]BulkProjectUpdateFailure, 0),
+	}
+	for _, project := range projects {
+		updated, err := a.UpdateProjectDescription(project.Path, opts.Description)
+		if err != nil {
+			result.Failures = append(result.Failures, BulkProjectUpdateFailure{ProjectPath: project.Path, Err: err})
+			continue
+		}
+		result.Successes = append(result.Successes, updated)
+	}
+
+	a.logger.Info("Bulk description update complete", "namespace", namespace,
+		"succeeded", len(result.Successes), "failed", len(result.Failures))
+	return result, nil
+}
+
+// listNamespaceProjectsForBulkUpdate enumerates every project under namespace and filters the
+// result down to those whose path matches includeGlob and does not match excludeGlob. An empty
+// includeGlob matches every project; an empty excludeGlob excludes none.
+func (a *App) listNamespaceProjectsForBulkUpdate(
+	namespace, includeGlob, excludeGlob string, subgroupsRecursive bool,
+) ([]ProjectInfo, error) {
+	var includeMatcher, excludeMatcher glob.Glob
+	if includeGlob != "" {
+		matcher, err := glob.Compile(includeGlob)
+		if err != nil {
+			return nil, fmt.Errorf("invalid include glob %q: %w", includeGlob, err)
+		}
+		includeMatcher = matcher
+	}
+	if excludeGlob != "" {
+		matcher, err := glob.Compile(excludeGlob)
+		if err != nil {
+			return nil, fmt.Errorf("invalid exclude glob %q: %w", excludeGlob, err)
+		}
+		excludeMatcher = matcher
+	}
+
+	projects, err := a.ListGroupProjects(namespace, &ListGroupProjectsOptions{
+		IncludeSubgroups: subgroupsRecursive,
+		ListAll:          true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	filtered := make([]ProjectInfo, 0, len(projects))
+	for _, project := range projects {
+		if includeMatcher != nil && !includeMatcher.Match(project.Path) {
+			continue
+		}
+		if excludeMatcher != nil && excludeMatcher.Match(project.Path) {
+			continue
+		}
+		filtered = append(filtered, project)
+	}
+	return filtered, nil
+}
+
+// applyTopicsDiff computes the new topic set for a project given its current topics and a
+// BulkUpdateTopicsOptions: Set replaces the topics outright, while Add/Remove merge into the
+// existing set.
+func applyTopicsDiff(current []string, opts *BulkUpdateTopicsOptions) []string {
+	if len(opts.Set) > 0 {
+		return opts.Set
+	}
+
+	removed := make(map[string]bool, len(opts.Remove))
+	for _, topic := range opts.Remove {
+		removed[topic] = true
+	}
+
+	topics := make([]string, 0, len(current)+len(opts.Add))
+	seen := make(map[string]bool, len(current)+len(opts.Add))
+	for _, topic := range current {
+		if removed[topic] || seen[topic] {
+			continue
+		}
+		seen[topic] = true
+		topics = append(topics, topic)
+	}
+	for _, topic := range opts.Add {
+		if removed[topic] || seen[topic] {
+			continue
+		}
+		seen[topic] = true
+		topics = append(topics, topic)
+	}
+	return topics
+}
@@ -0,0 +1,89 @@
+package app
+
+import (
+	"errors"
+	"log/slog"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"gitlab.com/gitlab-org/api/client-go"
+)
+
+// TestApp_BulkUpdateProjectTopics_Validation tests the up-front validation of
+// BulkUpdateProjectTopics.
+func TestApp_BulkUpdateProjectTopics_Validation(t *testing.T) {
+	app := NewWithClient("token", "https://gitlab.com/", &MockGitLabClient{})
+	app.SetLogger(slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelError})))
+
+	_, err := app.BulkUpdateProjectTopics("test/group", nil)
+	require.ErrorIs(t, err, ErrBulkUpdateOptionsRequired)
+
+	_, err = app.BulkUpdateProjectTopics("test/group", &BulkUpdateTopicsOptions{
+		Set: []string{"a"},
+		Add: []string{"b"},
+	})
+	require.ErrorIs(t, err, ErrBulkTopicsModeConflict)
+}
+
+// TestApp_BulkUpdateProjectTopics_GlobFilterAndPartialFailure tests that only projects matching
+// IncludeGlob (and not ExcludeGlob) are updated, and that a failure on one project does not
+// abort the others.
+func TestApp_BulkUpdateProjectTopics_GlobFilterAndPartialFailure(t *testing.T) {
+	mockClient := &MockGitLabClient{}
+	mockGroups := &MockGroupsService{}
+	mockProjects := &MockProjectsService{}
+
+	mockClient.On("Groups").Return(mockGroups)
+	mockClient.On("Projects").Return(mockProjects)
+
+	mockGroups.On("GetGroup", "test/group", (*gitlab.GetGroupOptions)(nil)).Return(
+		&gitlab.Group{ID: 456}, &gitlab.Response{}, nil,
+	)
+
+	mockGroups.On("ListGroupProjects", int64(456), mock.AnythingOfType("*gitlab.ListGroupProjectsOptions")).Return(
+		[]*gitlab.Project{
+			{ID: 1, Path: "service-a", Topics: []string{"go"}},
+			{ID: 2, Path: "service-b", Topics: []string{"go"}},
+			{ID: 3, Path: "docs-site", Topics: []string{"go"}},
+		}, &gitlab.Response{}, nil,
+	)
+
+	mockProjects.On("GetProject", "service-a", (*gitlab.GetProjectOptions)(nil)).Return(
+		&gitlab.Project{ID: 1, Path: "service-a"}, &gitlab.Response{}, nil,
+	)
+	mockProjects.On("EditProject", int64(1), mock.AnythingOfType("*gitlab.EditProjectOptions")).Return(
+		&gitlab.Project{ID: 1, Path: "service-a", Topics: []string{"go", "archived"}}, &gitlab.Response{}, nil,
+	)
+
+	mockProjects.On("GetProject", "service-b", (*gitlab.GetProjectOptions)(nil)).Return(
+		(*gitlab.Project)(nil), &gitlab.Response{}, errors.New("404 Not Found"),
+	)
+
+	app := NewWithClient("token", "https://gitlab.com/", mockClient)
+	app.SetLogger(slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelError})))
+
+	result, err := app.BulkUpdateProjectTopics("test/group", &BulkUpdateTopicsOptions{
+		IncludeGlob: "service-*",
+		Add:         []string{"archived"},
+	})
+
+	require.NoError(t, err)
+	assert.Len(t, result.Successes, 1)
+	assert.Equal(t, "service-a", result.Successes[0].Path)
+	require.Len(t, result.Failures, 1)
+	assert.Equal(t, "service-b", result.Failures[0].ProjectPath)
+}
+
+// TestApplyTopicsDiff tests the Set (replace) and Add/Remove (merge) topic semantics.
+func TestApplyTopicsDiff(t *testing.T) {
+	current := []string{"go", "cli"}
+
+	assert.Equal(t, []string{"new"}, applyTopicsDiff(current, &BulkUpdateTopicsOptions{Set: []string{"new"}}))
+	assert.Equal(t, []string{"go", "cli", "archived"},
+		applyTopicsDiff(current, &BulkUpdateTopicsOptions{Add: []string{"archived"}}))
+	assert.Equal(t, []string{"cli"},
+		applyTopicsDiff(current, &BulkUpdateTopicsOptions{Remove: []string{"go"}}))
+}
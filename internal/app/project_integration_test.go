@@ -0,0 +1,68 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/sgaunet/gitlab-mcp/internal/gitlabtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gitlab.com/gitlab-org/api/client-go"
+)
+
+// newIntegrationApp wires an App to a *gitlab.Client pointed at server, exercising the real
+// GitLabClientWrapper instead of a mocked GitLabClient.
+func newIntegrationApp(t *testing.T, server *gitlabtest.Server) *App {
+	t.Helper()
+	client, err := gitlab.NewClient("test-token", gitlab.WithBaseURL(server.BaseURL()))
+	require.NoError(t, err)
+	return NewWithClient("test-token", server.BaseURL(), NewGitLabClient(client))
+}
+
+// TestApp_GetProjectTopics_Integration drives App.GetProjectTopics end-to-end against a fake
+// GitLab server, without mocking the GitLabClient interface at all.
+func TestApp_GetProjectTopics_Integration(t *testing.T) {
+	project, err := gitlabtest.LoadProjectFixture("project")
+	require.NoError(t, err)
+
+	server := gitlabtest.NewServer().WithProject(project)
+	defer server.Close()
+
+	a := newIntegrationApp(t, server)
+
+	got, err := a.GetProjectTopics("test/project")
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(7), got.ID)
+	assert.Equal(t, []string{"golang", "mcp"}, got.Topics)
+}
+
+// TestApp_UpdateProjectTopics_Integration drives App.UpdateProjectTopics's +/- diff semantics
+// end-to-end against a fake GitLab server, exercising the real EditProject request and response
+// decoding rather than a mocked call.
+func TestApp_UpdateProjectTopics_Integration(t *testing.T) {
+	project, err := gitlabtest.LoadProjectFixture("project")
+	require.NoError(t, err)
+
+	server := gitlabtest.NewServer().WithProject(project)
+	defer server.Close()
+
+	a := newIntegrationApp(t, server)
+
+	got, err := a.UpdateProjectTopics("test/project", []string{"+api", "-golang"})
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"mcp", "api"}, got.Topics)
+}
+
+// TestApp_GetProjectTopics_Integration_NotFound tests that a real 404 response from the fake
+// server is classified the same way a mocked "404 Project Not Found" error is elsewhere.
+func TestApp_GetProjectTopics_Integration_NotFound(t *testing.T) {
+	server := gitlabtest.NewServer()
+	defer server.Close()
+
+	a := newIntegrationApp(t, server)
+
+	_, err := a.GetProjectTopics("missing/project")
+
+	require.Error(t, err)
+}
@@ -0,0 +1,157 @@
+package app
+
+import (
+	"context"
+	"sync"
+)
+
+// defaultProjectMutationConcurrency is the default number of workers used by
+// UpdateProjectsDescription and UpdateProjectsTopics.
+const defaultProjectMutationConcurrency = 4
+
+// TopicMode selects how UpdateProjectsTopics applies topics to each project. TopicModeReplace
+// discards each project's existing topic set; TopicModeAdd/TopicModeRemove merge into it,
+// driving UpdateProjectTopics's underlying +/- diff grammar (see ParseDiffList) so callers pick
+// a mode instead of having to prefix tokens themselves.
+type TopicMode int
+
+// Topic modes for UpdateProjectsTopics.
+const (
+	TopicModeReplace TopicMode = iota
+	TopicModeAdd
+	TopicModeRemove
+)
+
+// ProjectMutationOptions tunes a batch project mutation. A zero value uses
+// defaultProjectMutationConcurrency.
+type ProjectMutationOptions struct {
+	Concurrency int
+}
+
+// ProjectMutationResult is the outcome of mutating a single project within a batch call. Project
+// is set on success; Err holds the failure message otherwise.
+type ProjectMutationResult struct {
+	Path    string
+	Project *ProjectInfo
+	Err     string
+}
+
+// UpdateProjectsDescription sets description on every project in paths concurrently, using a
+// bounded worker pool (opts.Concurrency workers, default defaultProjectMutationConcurrency). A
+// failure on one project does not abort the others - every path in paths produces exactly one
+// ProjectMutationResult, in no particular order. Once ctx is done, any path not yet dispatched
+// to a worker is reported as failed with ctx.Err() instead of reaching the GitLab API.
+func (a *App) UpdateProjectsDescription(
+	ctx context.Context, paths []string, description string, opts *ProjectMutationOptions,
+) []ProjectMutationResult {
+	return a.mutateProjectsConcurrently(ctx, paths, opts, func(path string) (*ProjectInfo, error) {
+		return a.UpdateProjectDescription(path, description)
+	})
+}
+
+// UpdateProjectsTopics applies topics to every project in paths concurrently according to mode,
+// using a bounded worker pool (opts.Concurrency workers, default defaultProjectMutationConcurrency).
+// A failure on one project does not abort the others - every path in paths produces exactly one
+// ProjectMutationResult, in no particular order. Once ctx is done, any path not yet dispatched to
+// a worker is reported as failed with ctx.Err() instead of reaching the GitLab API.
+func (a *App) UpdateProjectsTopics(
+	ctx context.Context, paths []string, topics []string, mode TopicMode, opts *ProjectMutationOptions,
+) []ProjectMutationResult {
+	tokens := topicTokensForMode(topics, mode)
+	return a.mutateProjectsConcurrently(ctx, paths, opts, func(path string) (*ProjectInfo, error) {
+		return a.UpdateProjectTopics(path, tokens)
+	})
+}
+
+// topicTokensForMode prefixes topics with the token UpdateProjectTopics's diff grammar expects
+// for mode, so UpdateProjectsTopics's callers pick a TopicMode instead of needing to know the
+// +/- token syntax themselves.
+func topicTokensForMode(topics []string, mode TopicMode) []string {
+	if mode == TopicModeReplace {
+		return topics
+	}
+
+	prefix := "+"
+	if mode == TopicModeRemove {
+		prefix = "-"
+	}
+
+	tokens := make([]string, len(topics))
+	for i, topic := range topics {
+		tokens[i] = prefix + topic
+	}
+	return tokens
+}
+
+// mutateProjectsConcurrently runs mutate against each path in paths using a bounded worker pool,
+// honoring ctx for cancellation: once ctx is done, paths not yet handed to a worker are reported
+// as failed with ctx.Err() rather than being dispatched to the GitLab API.
+func (a *App) mutateProjectsConcurrently(
+	ctx context.Context, paths []string, opts *ProjectMutationOptions, mutate func(string) (*ProjectInfo, error),
+) []ProjectMutationResult {
+	concurrency := defaultProjectMutationConcurrency
+	if opts != nil && opts.Concurrency > 0 {
+		concurrency = opts.Concurrency
+	}
+
+	jobs := make(chan string)
+	outcomes := make(chan ProjectMutationResult, len(paths))
+
+	var wg sync.WaitGroup
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for path := range jobs {
+				outcomes <- mutateOneProject(ctx, path, mutate)
+			}
+		}()
+	}
+
+	go func() {
+		defer close(jobs)
+		for _, path := range paths {
+			select {
+			case <-ctx.Done():
+				return
+			case jobs <- path:
+			}
+		}
+	}()
+
+	go func() {
+		wg.Wait()
+		close(outcomes)
+	}()
+
+	results := make([]ProjectMutationResult, 0, len(paths))
+	seen := make(map[string]bool, len(paths))
+	for outcome := range outcomes {
+		seen[outcome.Path] = true
+		results = append(results, outcome)
+	}
+
+	for _, path := range paths {
+		if !seen[path] {
+			results = append(results, ProjectMutationResult{Path: path, Err: ctx.Err().Error()})
+		}
+	}
+
+	return results
+}
+
+// mutateOneProject runs mutate against path, short-circuiting with ctx.Err() if ctx is already
+// done instead of issuing the underlying GitLab API call.
+func mutateOneProject(
+	ctx context.Context, path string, mutate func(string) (*ProjectInfo, error),
+) ProjectMutationResult {
+	if err := ctx.Err(); err != nil {
+		return ProjectMutationResult{Path: path, Err: err.Error()}
+	}
+
+	project, err := mutate(path)
+	if err != nil {
+		return ProjectMutationResult{Path: path, Err: err.Error()}
+	}
+	return ProjectMutationResult{Path: path, Project: project}
+}
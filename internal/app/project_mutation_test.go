@@ -0,0 +1,142 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gitlab.com/gitlab-org/api/client-go"
+)
+
+// newMutationTestApp wires an App with logging quiet enough not to clutter test output.
+func newMutationTestApp(mockClient *MockGitLabClient) *App {
+	app := NewWithClient("token", "https://gitlab.com/", mockClient)
+	app.SetLogger(slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelError})))
+	return app
+}
+
+// TestApp_UpdateProjectsDescription_AllSucceed tests that every path gets a successful result
+// when the underlying GitLab calls all succeed.
+func TestApp_UpdateProjectsDescription_AllSucceed(t *testing.T) {
+	mockClient := &MockGitLabClient{}
+	mockProjects := &MockProjectsService{}
+	mockClient.On("Projects").Return(mockProjects)
+
+	mockProjects.On("GetProject", "test/one", (*gitlab.GetProjectOptions)(nil)).Return(
+		&gitlab.Project{ID: 1, PathWithNamespace: "test/one"}, &gitlab.Response{}, nil,
+	)
+	mockProjects.On("GetProject", "test/two", (*gitlab.GetProjectOptions)(nil)).Return(
+		&gitlab.Project{ID: 2, PathWithNamespace: "test/two"}, &gitlab.Response{}, nil,
+	)
+	mockProjects.On("EditProject", int64(1), &gitlab.EditProjectOptions{Description: gitlab.Ptr("updated")}).Return(
+		&gitlab.Project{ID: 1, PathWithNamespace: "test/one", Description: "updated"}, &gitlab.Response{}, nil,
+	)
+	mockProjects.On("EditProject", int64(2), &gitlab.EditProjectOptions{Description: gitlab.Ptr("updated")}).Return(
+		&gitlab.Project{ID: 2, PathWithNamespace: "test/two", Description: "updated"}, &gitlab.Response{}, nil,
+	)
+
+	app := newMutationTestApp(mockClient)
+
+	results := app.UpdateProjectsDescription(
+		context.Background(), []string{"test/one", "test/two"}, "updated", nil,
+	)
+
+	assert.Len(t, results, 2)
+	for _, result := range results {
+		assert.Empty(t, result.Err)
+		if assert.NotNil(t, result.Project) {
+			assert.Equal(t, "updated", result.Project.Description)
+		}
+	}
+}
+
+// TestApp_UpdateProjectsTopics_AllFail tests that every path reports its own failure when
+// GetProject fails for all of them.
+func TestApp_UpdateProjectsTopics_AllFail(t *testing.T) {
+	mockClient := &MockGitLabClient{}
+	mockProjects := &MockProjectsService{}
+	mockClient.On("Projects").Return(mockProjects)
+
+	mockProjects.On("GetProject", "test/one", (*gitlab.GetProjectOptions)(nil)).Return(
+		(*gitlab.Project)(nil), &gitlab.Response{}, errors.New("404 Not Found"),
+	)
+	mockProjects.On("GetProject", "test/two", (*gitlab.GetProjectOptions)(nil)).Return(
+		(*gitlab.Project)(nil), &gitlab.Response{}, errors.New("404 Not Found"),
+	)
+
+	app := newMutationTestApp(mockClient)
+
+	results := app.UpdateProjectsTopics(
+		context.Background(), []string{"test/one", "test/two"}, []string{"golang"}, TopicModeAdd, nil,
+	)
+
+	assert.Len(t, results, 2)
+	for _, result := range results {
+		assert.Nil(t, result.Project)
+		assert.NotEmpty(t, result.Err)
+	}
+}
+
+// TestApp_UpdateProjectsTopics_MixedResults tests that one project succeeding and another
+// failing produces one result of each kind, keyed by path.
+func TestApp_UpdateProjectsTopics_MixedResults(t *testing.T) {
+	mockClient := &MockGitLabClient{}
+	mockProjects := &MockProjectsService{}
+	mockClient.On("Projects").Return(mockProjects)
+
+	mockProjects.On("GetProject", "test/ok", (*gitlab.GetProjectOptions)(nil)).Return(
+		&gitlab.Project{ID: 1, PathWithNamespace: "test/ok", Topics: []string{"go"}}, &gitlab.Response{}, nil,
+	)
+	mockProjects.On("GetProject", "test/bad", (*gitlab.GetProjectOptions)(nil)).Return(
+		(*gitlab.Project)(nil), &gitlab.Response{}, errors.New("404 Not Found"),
+	)
+	mockProjects.On("EditProject", int64(1), &gitlab.EditProjectOptions{Topics: gitlab.Ptr([]string{"go", "cli"})}).Return(
+		&gitlab.Project{ID: 1, PathWithNamespace: "test/ok", Topics: []string{"go", "cli"}}, &gitlab.Response{}, nil,
+	)
+
+	app := newMutationTestApp(mockClient)
+
+	results := app.UpdateProjectsTopics(
+		context.Background(), []string{"test/ok", "test/bad"}, []string{"cli"}, TopicModeAdd, nil,
+	)
+
+	byPath := make(map[string]ProjectMutationResult, len(results))
+	for _, result := range results {
+		byPath[result.Path] = result
+	}
+
+	ok := byPath["test/ok"]
+	assert.Empty(t, ok.Err)
+	if assert.NotNil(t, ok.Project) {
+		assert.Equal(t, []string{"go", "cli"}, ok.Project.Topics)
+	}
+
+	bad := byPath["test/bad"]
+	assert.Nil(t, bad.Project)
+	assert.NotEmpty(t, bad.Err)
+}
+
+// TestApp_UpdateProjectsDescription_Cancellation tests that a pre-canceled context reports every
+// path as failed with ctx.Err() instead of issuing any GitLab calls.
+func TestApp_UpdateProjectsDescription_Cancellation(t *testing.T) {
+	mockClient := &MockGitLabClient{}
+
+	app := newMutationTestApp(mockClient)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	results := app.UpdateProjectsDescription(
+		ctx, []string{"test/one", "test/two", "test/three"}, "updated", &ProjectMutationOptions{Concurrency: 2},
+	)
+
+	assert.Len(t, results, 3)
+	for _, result := range results {
+		assert.Nil(t, result.Project)
+		assert.Equal(t, context.Canceled.Error(), result.Err)
+	}
+	mockClient.AssertNotCalled(t, "Projects")
+}
@@ -0,0 +1,71 @@
+package app
+
+import (
+	"context"
+
+	"github.com/sgaunet/gitlab-mcp/internal/gitlaberr"
+	"gitlab.com/gitlab-org/api/client-go"
+)
+
+// maxReleasesPerPage caps how many releases ListProjectReleases requests per page.
+const maxReleasesPerPage = 100
+
+// Release represents a GitLab project release.
+type Release struct {
+	TagName     string `json:"tag_name"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	CreatedAt   string `json:"created_at"`
+	ReleasedAt  string `json:"released_at"`
+}
+
+// convertGitLabRelease converts a GitLab release to our Release struct.
+func convertGitLabRelease(release *gitlab.Release) Release {
+	return Release{
+		TagName:     release.TagName,
+		Name:        release.Name,
+		Description: release.Description,
+		CreatedAt:   formatGitLabTime(release.CreatedAt),
+		ReleasedAt:  formatGitLabTime(release.ReleasedAt),
+	}
+}
+
+// ListProjectReleases lists a project's releases, newest first (GitLab's default order),
+// walking every page up to the Paginator's default item and byte caps.
+func (a *App) ListProjectReleases(projectPath string) ([]Release, error) {
+	a.logger.Debug("Listing project releases", "project_path", projectPath)
+
+	project, err := a.getProjectCached(projectPath)
+	if err != nil {
+		a.logger.Error("Failed to get project", "error", err, "project_path", projectPath)
+		return nil, err
+	}
+
+	listOpts := &gitlab.ListReleasesOptions{
+		ListOptions: gitlab.ListOptions{PerPage: maxReleasesPerPage, Page: 1},
+	}
+
+	paginator := &Paginator[*gitlab.Release]{
+		Fetch: func(cursor string) ([]*gitlab.Release, *gitlab.Response, error) {
+			listOpts.Page = cursorToPage(cursor, listOpts.Page)
+			return retryCall(a, context.Background(), "ListReleases",
+				func() ([]*gitlab.Release, *gitlab.Response, error) {
+					return a.client.Releases().ListReleases(project.ID, listOpts)
+				})
+		},
+	}
+	page, err := paginator.FetchAll()
+	if err != nil {
+		a.logger.Error("Failed to list project releases", "error", err, "project_id", project.ID)
+		return nil, gitlaberr.Classify(err, "failed to list project releases")
+	}
+	releases := page.Items
+
+	result := make([]Release, 0, len(releases))
+	for _, release := range releases {
+		result = append(result, convertGitLabRelease(release))
+	}
+
+	a.logger.Info("Successfully listed project releases", "count", len(result), "project_id", project.ID)
+	return result, nil
+}
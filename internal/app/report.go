@@ -0,0 +1,205 @@
+package app
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrReportOptionsRequired is returned when ReportFinding is called with nil options.
+var ErrReportOptionsRequired = errors.New("report finding options are required")
+
+// ErrFindingTitleRequired is returned when ReportFinding is called without a title.
+var ErrFindingTitleRequired = errors.New("finding title is required")
+
+// ErrFingerprintRequired is returned when ReportFinding is called without a fingerprint.
+var ErrFingerprintRequired = errors.New("finding fingerprint is required")
+
+// ReportFindingOptions describes a single finding to file or update via ReportFinding.
+//
+// Fingerprint is a stable identifier for the underlying issue (e.g. a hash of rule ID + file +
+// line, computed by the caller) used to dedupe repeated reports of the same finding across scans.
+// It is stored as a hidden marker in the issue description rather than shown to the user.
+// TemplateID is reserved for a future per-template label/field preset and currently unused.
+type ReportFindingOptions struct {
+	Title       string
+	Description string
+	Severity    string
+	Fingerprint string
+	TemplateID  string
+}
+
+// ReportFindingResult is the outcome of ReportFinding: the issue it ended up filing or touching,
+// and which of "created", "updated", or "reopened" it did.
+type ReportFindingResult struct {
+	Issue  Issue
+	Action string
+}
+
+// fingerprintMarker builds the hidden HTML-comment marker ReportFinding stores in an issue's
+// description to recognize it on a later report of the same finding.
+func fingerprintMarker(fingerprint string) string {
+	return fmt.Sprintf("<!-- fingerprint:%s -->", fingerprint)
+}
+
+// ReportFinding files or updates an issue for a single finding, deduplicating repeated reports of
+// the same finding via a stable Fingerprint instead of creating a new issue every time:
+//
+//   - if no issue carries Fingerprint's marker, it creates one with the configured severity label;
+//   - if a matching issue is open, it posts an occurrence note and bumps the severity label when
+//     Severity ranks higher than the label the issue already carries;
+//   - if a matching issue is closed, it reopens it and posts a regression note instead of treating
+//     it as a fresh occurrence.
+func (a *App) ReportFinding(projectPath string, opts *ReportFindingOptions) (*ReportFindingResult, error) {
+	if opts == nil {
+		return nil, ErrReportOptionsRequired
+	}
+	if opts.Title == "" {
+		return nil, ErrFindingTitleRequired
+	}
+	if opts.Fingerprint == "" {
+		return nil, ErrFingerprintRequired
+	}
+
+	existing, err := a.findIssueByFingerprint(projectPath, opts.Fingerprint)
+	if err != nil {
+		return nil, err
+	}
+
+	if existing == nil {
+		issue, err := a.createFindingIssue(projectPath, opts)
+		if err != nil {
+			return nil, err
+		}
+		return &ReportFindingResult{Issue: *issue, Action: "created"}, nil
+	}
+
+	if existing.State == "closed" {
+		return a.reopenFindingIssue(projectPath, existing, opts)
+	}
+	return a.bumpFindingIssue(projectPath, existing, opts)
+}
+
+// findIssueByFingerprint looks for an open or closed issue already carrying fingerprint's hidden
+// marker, returning nil if none is found.
+func (a *App) findIssueByFingerprint(projectPath, fingerprint string) (*Issue, error) {
+	marker := fingerprintMarker(fingerprint)
+
+	issues, err := a.ListProjectIssues(projectPath, &ListIssuesOptions{State: "all", ListAll: true})
+	if err != nil {
+		return nil, err
+	}
+	for i := range issues {
+		if strings.Contains(issues[i].Description, marker) {
+			return &issues[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// createFindingIssue files a fresh issue for opts, embedding the fingerprint marker in its
+// description and labeling it per the App's configured severity mapping.
+func (a *App) createFindingIssue(projectPath string, opts *ReportFindingOptions) (*Issue, error) {
+	description := opts.Description
+	if description != "" {
+		description += "\n\n"
+	}
+	description += fingerprintMarker(opts.Fingerprint)
+
+	var labels []string
+	if opts.Severity != "" {
+		labels = append(labels, a.reportConfig.severityLabel(opts.Severity))
+	}
+
+	return a.CreateProjectIssue(projectPath, &CreateIssueOptions{
+		Title:       opts.Title,
+		Description: description,
+		Labels:      labels,
+	})
+}
+
+// reopenFindingIssue reopens existing after it was previously closed and posts a regression note,
+// bumping its severity label per opts.Severity.
+func (a *App) reopenFindingIssue(
+	projectPath string, existing *Issue, opts *ReportFindingOptions,
+) (*ReportFindingResult, error) {
+	issue, err := a.UpdateProjectIssue(projectPath, existing.IID, &UpdateIssueOptions{State: "opened"})
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := a.AddIssueNote(projectPath, existing.IID, &AddIssueNoteOptions{
+		Body: "Regression detected: this finding reoccurred after the issue was closed.\n\n" + opts.Description,
+	}); err != nil {
+		a.logger.Error("Failed to post regression note",
+			"error", err, "project_path", projectPath, "issue_iid", existing.IID)
+	}
+
+	if opts.Severity != "" {
+		bumped, err := a.applySeverityLabel(projectPath, issue, opts.Severity)
+		if err != nil {
+			return nil, err
+		}
+		issue = bumped
+	}
+
+	return &ReportFindingResult{Issue: *issue, Action: "reopened"}, nil
+}
+
+// bumpFindingIssue records a new occurrence of a still-open finding: it posts an occurrence note
+// and bumps the severity label per opts.Severity.
+func (a *App) bumpFindingIssue(
+	projectPath string, existing *Issue, opts *ReportFindingOptions,
+) (*ReportFindingResult, error) {
+	if _, err := a.AddIssueNote(projectPath, existing.IID, &AddIssueNoteOptions{
+		Body: "New occurrence of this finding detected.\n\n" + opts.Description,
+	}); err != nil {
+		a.logger.Error("Failed to post occurrence note",
+			"error", err, "project_path", projectPath, "issue_iid", existing.IID)
+	}
+
+	issue := existing
+	if opts.Severity != "" {
+		bumped, err := a.applySeverityLabel(projectPath, existing, opts.Severity)
+		if err != nil {
+			return nil, err
+		}
+		issue = bumped
+	}
+
+	return &ReportFindingResult{Issue: *issue, Action: "updated"}, nil
+}
+
+// applySeverityLabel bumps issue's severity label to severity if it ranks higher than whatever
+// severity label (if any) the issue already carries, leaving it unchanged otherwise.
+func (a *App) applySeverityLabel(projectPath string, issue *Issue, severity string) (*Issue, error) {
+	newLabel := a.reportConfig.severityLabel(severity)
+	currentLabel, currentRank := a.currentSeverityLabel(issue.Labels)
+
+	if currentLabel == newLabel {
+		return issue, nil
+	}
+	if currentLabel != "" && a.reportConfig.severityRank(severity) <= currentRank {
+		return issue, nil
+	}
+
+	diff := []string{"+" + newLabel}
+	if currentLabel != "" {
+		diff = append(diff, "-"+currentLabel)
+	}
+
+	return a.UpdateProjectIssue(projectPath, issue.IID, &UpdateIssueOptions{Labels: diff})
+}
+
+// currentSeverityLabel finds whichever of labels corresponds to a configured severity, returning
+// the label and its rank, or ("", -1) if none match.
+func (a *App) currentSeverityLabel(labels []string) (string, int) {
+	for _, label := range labels {
+		for severity, configured := range a.reportConfig.SeverityLabels {
+			if label == configured {
+				return label, a.reportConfig.severityRank(severity)
+			}
+		}
+	}
+	return "", -1
+}
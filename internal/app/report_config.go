@@ -0,0 +1,92 @@
+package app
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ReportConfig configures report_finding's severity label mapping, loaded from
+// ~/.config/gitlab-mcp/config.yaml.
+//
+// SeverityOrder ranks severities from least to most severe; ReportFinding consults it to decide
+// whether a fingerprint-matched issue's severity label should be bumped to a newly reported,
+// higher severity rather than left alone. SeverityLabels maps each severity name to the scoped
+// label GitLab should carry for it (e.g. "high" -> "severity::high").
+type ReportConfig struct {
+	SeverityOrder  []string          `yaml:"severity_order"`
+	SeverityLabels map[string]string `yaml:"severity_labels"`
+}
+
+// DefaultReportConfig returns the built-in severity mapping used when no config.yaml is present:
+// info < low < medium < high < critical, each labeled "severity::<name>".
+func DefaultReportConfig() ReportConfig {
+	order := []string{"info", "low", "medium", "high", "critical"}
+	labels := make(map[string]string, len(order))
+	for _, severity := range order {
+		labels[severity] = "severity::" + severity
+	}
+	return ReportConfig{SeverityOrder: order, SeverityLabels: labels}
+}
+
+// severityRank returns severity's position in SeverityOrder (higher is more severe), or -1 if
+// severity isn't configured.
+func (c ReportConfig) severityRank(severity string) int {
+	for i, sev := range c.SeverityOrder {
+		if sev == severity {
+			return i
+		}
+	}
+	return -1
+}
+
+// severityLabel returns the scoped label configured for severity, falling back to
+// "severity::<severity>" for a severity absent from SeverityLabels.
+func (c ReportConfig) severityLabel(severity string) string {
+	if label, ok := c.SeverityLabels[severity]; ok {
+		return label
+	}
+	return "severity::" + severity
+}
+
+// DefaultReportConfigPath returns ~/.config/gitlab-mcp/config.yaml, the default location
+// LoadReportConfig reads report_finding's severity mapping from.
+func DefaultReportConfigPath() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(home, ".config", "gitlab-mcp", "config.yaml")
+}
+
+// LoadReportConfig reads report_finding's severity mapping from path, layering it over
+// DefaultReportConfig (a config.yaml that only sets one of severity_order/severity_labels leaves
+// the other at its default). A missing path is not an error.
+func LoadReportConfig(path string) (ReportConfig, error) {
+	cfg := DefaultReportConfig()
+	if path == "" {
+		return cfg, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return cfg, nil
+		}
+		return cfg, fmt.Errorf("failed to read report config: %w", err)
+	}
+
+	var file ReportConfig
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return cfg, fmt.Errorf("failed to parse report config: %w", err)
+	}
+	if len(file.SeverityOrder) > 0 {
+		cfg.SeverityOrder = file.SeverityOrder
+	}
+	if len(file.SeverityLabels) > 0 {
+		cfg.SeverityLabels = file.SeverityLabels
+	}
+	return cfg, nil
+}
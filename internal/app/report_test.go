@@ -0,0 +1,197 @@
+package app
+
+import (
+	"log/slog"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gitlab.com/gitlab-org/api/client-go"
+)
+
+func TestApp_ReportFinding_Validation(t *testing.T) {
+	tests := []struct {
+		name    string
+		opts    *ReportFindingOptions
+		wantErr error
+	}{
+		{
+			name:    "nil options",
+			opts:    nil,
+			wantErr: ErrReportOptionsRequired,
+		},
+		{
+			name:    "empty title",
+			opts:    &ReportFindingOptions{Fingerprint: "abc123"},
+			wantErr: ErrFindingTitleRequired,
+		},
+		{
+			name:    "empty fingerprint",
+			opts:    &ReportFindingOptions{Title: "SQL injection"},
+			wantErr: ErrFingerprintRequired,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := &MockGitLabClient{}
+			app := NewWithClient("token", "https://gitlab.com/", mockClient)
+			app.SetLogger(slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelError})))
+
+			result, err := app.ReportFinding("test/project", tt.opts)
+
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Nil(t, result)
+			mockClient.AssertExpectations(t)
+		})
+	}
+}
+
+func TestApp_ReportFinding_CreatesNewIssue(t *testing.T) {
+	mockClient := &MockGitLabClient{}
+	mockProjects := &MockProjectsService{}
+	mockIssues := &MockIssuesService{}
+
+	mockClient.On("Projects").Return(mockProjects)
+	mockClient.On("Issues").Return(mockIssues)
+
+	mockProjects.On("GetProject", "test/project", (*gitlab.GetProjectOptions)(nil)).Return(
+		&gitlab.Project{ID: 123}, &gitlab.Response{}, nil,
+	)
+
+	mockIssues.On("ListProjectIssues", 123, mock.Anything).Return(
+		[]*gitlab.Issue{}, &gitlab.Response{}, nil,
+	)
+
+	expectedLabels := gitlab.LabelOptions([]string{"severity::high"})
+	mockIssues.On("CreateIssue", 123, &gitlab.CreateIssueOptions{
+		Title:       gitlab.Ptr("SQL injection in login form"),
+		Description: gitlab.Ptr("Found by scanner.\n\n<!-- fingerprint:sqli-login-001 -->"),
+		Labels:      &expectedLabels,
+	}).Return(
+		&gitlab.Issue{ID: 1, IID: 7, Title: "SQL injection in login form", State: "opened"},
+		&gitlab.Response{}, nil,
+	)
+
+	app := NewWithClient("token", "https://gitlab.com/", mockClient)
+	app.SetLogger(slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelError})))
+
+	result, err := app.ReportFinding("test/project", &ReportFindingOptions{
+		Title:       "SQL injection in login form",
+		Description: "Found by scanner.",
+		Severity:    "high",
+		Fingerprint: "sqli-login-001",
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "created", result.Action)
+	assert.Equal(t, int64(7), result.Issue.IID)
+
+	mockClient.AssertExpectations(t)
+	mockProjects.AssertExpectations(t)
+	mockIssues.AssertExpectations(t)
+}
+
+func TestApp_CurrentSeverityLabel(t *testing.T) {
+	app := NewWithClient("token", "https://gitlab.com/", &MockGitLabClient{})
+
+	tests := []struct {
+		name      string
+		labels    []string
+		wantLabel string
+		wantRank  int
+	}{
+		{
+			name:      "no severity label",
+			labels:    []string{"bug", "frontend"},
+			wantLabel: "",
+			wantRank:  -1,
+		},
+		{
+			name:      "matching severity label",
+			labels:    []string{"bug", "severity::medium"},
+			wantLabel: "severity::medium",
+			wantRank:  2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			label, rank := app.currentSeverityLabel(tt.labels)
+			assert.Equal(t, tt.wantLabel, label)
+			assert.Equal(t, tt.wantRank, rank)
+		})
+	}
+}
+
+func TestApp_ApplySeverityLabel(t *testing.T) {
+	tests := []struct {
+		name       string
+		labels     []string
+		severity   string
+		wantUpdate bool
+	}{
+		{
+			name:       "no prior severity label bumps to new one",
+			labels:     []string{"bug"},
+			severity:   "high",
+			wantUpdate: true,
+		},
+		{
+			name:       "higher severity bumps the label",
+			labels:     []string{"bug", "severity::low"},
+			severity:   "critical",
+			wantUpdate: true,
+		},
+		{
+			name:       "lower severity leaves the label alone",
+			labels:     []string{"bug", "severity::high"},
+			severity:   "low",
+			wantUpdate: false,
+		},
+		{
+			name:       "same severity leaves the label alone",
+			labels:     []string{"bug", "severity::high"},
+			severity:   "high",
+			wantUpdate: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := &MockGitLabClient{}
+			mockProjects := &MockProjectsService{}
+			mockIssues := &MockIssuesService{}
+
+			issue := &Issue{IID: 9, Labels: tt.labels}
+
+			if tt.wantUpdate {
+				mockClient.On("Projects").Return(mockProjects)
+				mockClient.On("Issues").Return(mockIssues)
+				mockProjects.On("GetProject", "test/project", (*gitlab.GetProjectOptions)(nil)).Return(
+					&gitlab.Project{ID: 123}, &gitlab.Response{}, nil,
+				)
+				mockIssues.On("UpdateIssue", 123, 9, mock.Anything).Return(
+					&gitlab.Issue{ID: 1, IID: 9, State: "opened"}, &gitlab.Response{}, nil,
+				)
+			}
+
+			app := NewWithClient("token", "https://gitlab.com/", mockClient)
+			app.SetLogger(slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelError})))
+
+			result, err := app.applySeverityLabel("test/project", issue, tt.severity)
+
+			assert.NoError(t, err)
+			if tt.wantUpdate {
+				assert.Equal(t, int64(9), result.IID)
+				mockIssues.AssertExpectations(t)
+			} else {
+				assert.Same(t, issue, result)
+				mockIssues.AssertNotCalled(t, "UpdateIssue")
+			}
+			mockClient.AssertExpectations(t)
+			mockProjects.AssertExpectations(t)
+		})
+	}
+}
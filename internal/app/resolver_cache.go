@@ -0,0 +1,136 @@
+package app
+
+import (
+	"os"
+	"sync"
+	"time"
+)
+
+// defaultResolverCacheTTL is the TTL applied to successful resolver lookups (user, milestone,
+// label) when GITLAB_RESOLVER_CACHE_TTL is unset or invalid.
+const defaultResolverCacheTTL = 5 * time.Minute
+
+// negativeResolverCacheTTL is the (much shorter) TTL applied to failed resolver lookups, so a
+// typo'd username or milestone title isn't replayed from cache once the caller fixes it, while
+// still sparing GitLab a repeat round-trip for the next few identical typos in a batch.
+const negativeResolverCacheTTL = 10 * time.Second
+
+// resolverCacheTTLFromEnv parses GITLAB_RESOLVER_CACHE_TTL (e.g. "5m", "90s") into a duration,
+// falling back to defaultResolverCacheTTL when the variable is unset or not a valid duration.
+func resolverCacheTTLFromEnv() time.Duration {
+	raw := os.Getenv("GITLAB_RESOLVER_CACHE_TTL")
+	if raw == "" {
+		return defaultResolverCacheTTL
+	}
+	ttl, err := time.ParseDuration(raw)
+	if err != nil || ttl <= 0 {
+		return defaultResolverCacheTTL
+	}
+	return ttl
+}
+
+// resolverKind distinguishes the lookup kind in a resolverCacheKey: usernames, milestone titles,
+// iteration titles, and label sets share the same (projectID, name) shape but are not
+// interchangeable.
+type resolverKind int
+
+const (
+	resolverKindUser resolverKind = iota
+	resolverKindMilestone
+	resolverKindLabels
+	resolverKindIteration
+)
+
+// resolverCacheKey identifies a single cached resolver lookup.
+type resolverCacheKey struct {
+	kind      resolverKind
+	projectID int64
+	name      string
+}
+
+// resolverCacheResult is a single cached resolver outcome: either a resolved value (err nil) -
+// an int64 ID for the user/milestone kinds, or a []string of label names for the labels kind -
+// or a cached failure (err set, e.g. ErrUserNotFound), so batch flows don't hammer GitLab with
+// identical lookups for an assignee or milestone that doesn't exist.
+type resolverCacheResult struct {
+	value any
+	err   error
+}
+
+// resolverCacheItem pairs a cached result with its expiry.
+type resolverCacheItem struct {
+	result    resolverCacheResult
+	expiresAt time.Time
+}
+
+// resolverCache caches the outcome of App's identifier resolvers (findUserByUsername,
+// findMilestoneByTitle, validateLabels) keyed by (kind, projectID, name), so that e.g. creating
+// five merge requests for the same assignee issues one ListUsers call instead of five. Successful
+// lookups are cached for ttl (default 5 minutes, overridable via GITLAB_RESOLVER_CACHE_TTL);
+// failed lookups are cached for the much shorter negativeResolverCacheTTL.
+type resolverCache struct {
+	mu      sync.RWMutex
+	ttl     time.Duration
+	entries map[resolverCacheKey]resolverCacheItem
+}
+
+// newResolverCache creates a resolverCache that caches successful lookups for ttl.
+func newResolverCache(ttl time.Duration) *resolverCache {
+	return &resolverCache{
+		ttl:     ttl,
+		entries: make(map[resolverCacheKey]resolverCacheItem),
+	}
+}
+
+// get returns the cached result for key if present and not expired.
+func (c *resolverCache) get(key resolverCacheKey) (resolverCacheResult, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	item, ok := c.entries[key]
+	if !ok || time.Now().After(item.expiresAt) {
+		return resolverCacheResult{}, false
+	}
+	return item.result, true
+}
+
+// setSuccess caches a resolved value for key under the cache's configured TTL.
+func (c *resolverCache) setSuccess(key resolverCacheKey, value any) {
+	c.set(key, resolverCacheResult{value: value}, c.ttl)
+}
+
+// setFailure caches a resolution failure for key under negativeResolverCacheTTL.
+func (c *resolverCache) setFailure(key resolverCacheKey, err error) {
+	c.set(key, resolverCacheResult{err: err}, negativeResolverCacheTTL)
+}
+
+func (c *resolverCache) set(key resolverCacheKey, result resolverCacheResult, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries[key] = resolverCacheItem{result: result, expiresAt: time.Now().Add(ttl)}
+}
+
+// invalidate clears every cached resolver entry. Exposed on App as InvalidateResolverCache for
+// tests and long-running MCP sessions that need to force a fresh lookup (e.g. after a user is
+// renamed or a milestone is created mid-session).
+func (c *resolverCache) invalidate() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries = make(map[resolverCacheKey]resolverCacheItem)
+}
+
+// InvalidateResolverCache clears every cached user/milestone/label resolver result, forcing the
+// next lookup of each to hit GitLab again.
+func (a *App) InvalidateResolverCache() {
+	a.resolvers.invalidate()
+}
+
+// cachedProjectLabelNames returns the project's label names from the resolver cache, if present.
+func (a *App) cachedProjectLabelNames(key resolverCacheKey) ([]string, bool) {
+	cached, ok := a.resolvers.get(key)
+	if !ok || cached.err != nil {
+		return nil, false
+	}
+	names, ok := cached.value.([]string)
+	return names, ok
+}
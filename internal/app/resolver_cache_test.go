@@ -0,0 +1,122 @@
+package app
+
+import (
+	"errors"
+	"log/slog"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gitlab.com/gitlab-org/api/client-go"
+)
+
+// TestApp_FindUserByUsername_CachesResult tests that a second lookup of the same username is
+// served from the resolver cache instead of issuing another ListUsers call.
+func TestApp_FindUserByUsername_CachesResult(t *testing.T) {
+	mockClient := &MockGitLabClient{}
+	mockUsers := &MockUsersService{}
+
+	mockClient.On("Users").Return(mockUsers)
+	mockUsers.On("ListUsers", &gitlab.ListUsersOptions{
+		Username:    gitlab.Ptr("alice"),
+		ListOptions: gitlab.ListOptions{PerPage: 1, Page: 1},
+	}).Return([]*gitlab.User{{ID: 10, Username: "alice"}}, &gitlab.Response{}, nil).Once()
+
+	app := NewWithClient("token", "https://gitlab.com/", mockClient)
+	app.SetLogger(slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelError})))
+
+	id, err := app.findUserByUsername("alice")
+	require.NoError(t, err)
+	assert.Equal(t, int64(10), id)
+
+	id, err = app.findUserByUsername("alice")
+	require.NoError(t, err)
+	assert.Equal(t, int64(10), id)
+
+	mockUsers.AssertExpectations(t)
+}
+
+// TestApp_FindUserByUsername_CachesNotFound tests that a not-found result is also cached, so a
+// typo'd username doesn't issue a fresh ListUsers call on every subsequent reference.
+func TestApp_FindUserByUsername_CachesNotFound(t *testing.T) {
+	mockClient := &MockGitLabClient{}
+	mockUsers := &MockUsersService{}
+
+	mockClient.On("Users").Return(mockUsers)
+	mockUsers.On("ListUsers", &gitlab.ListUsersOptions{
+		Username:    gitlab.Ptr("ghost"),
+		ListOptions: gitlab.ListOptions{PerPage: 1, Page: 1},
+	}).Return([]*gitlab.User{}, &gitlab.Response{}, nil).Once()
+
+	app := NewWithClient("token", "https://gitlab.com/", mockClient)
+	app.SetLogger(slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelError})))
+
+	_, err := app.findUserByUsername("ghost")
+	require.ErrorIs(t, err, ErrUserNotFound)
+
+	_, err = app.findUserByUsername("ghost")
+	require.ErrorIs(t, err, ErrUserNotFound)
+
+	mockUsers.AssertExpectations(t)
+}
+
+// TestApp_InvalidateResolverCache tests that InvalidateResolverCache forces a fresh lookup.
+func TestApp_InvalidateResolverCache(t *testing.T) {
+	mockClient := &MockGitLabClient{}
+	mockUsers := &MockUsersService{}
+
+	mockClient.On("Users").Return(mockUsers)
+	mockUsers.On("ListUsers", &gitlab.ListUsersOptions{
+		Username:    gitlab.Ptr("alice"),
+		ListOptions: gitlab.ListOptions{PerPage: 1, Page: 1},
+	}).Return([]*gitlab.User{{ID: 10, Username: "alice"}}, &gitlab.Response{}, nil).Twice()
+
+	app := NewWithClient("token", "https://gitlab.com/", mockClient)
+	app.SetLogger(slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelError})))
+
+	_, err := app.findUserByUsername("alice")
+	require.NoError(t, err)
+
+	app.InvalidateResolverCache()
+
+	_, err = app.findUserByUsername("alice")
+	require.NoError(t, err)
+
+	mockUsers.AssertExpectations(t)
+}
+
+// TestResolverCache_TTLExpiry tests that an entry is no longer served once its TTL has elapsed.
+func TestResolverCache_TTLExpiry(t *testing.T) {
+	cache := newResolverCache(-1) // negative TTL: entries are expired the instant they're set
+	key := resolverCacheKey{kind: resolverKindUser, name: "alice"}
+	cache.setSuccess(key, int64(10))
+
+	_, ok := cache.get(key)
+	assert.False(t, ok)
+}
+
+// TestResolverCache_CachesFailure tests that setFailure stores the error for retrieval via get.
+func TestResolverCache_CachesFailure(t *testing.T) {
+	cache := newResolverCache(defaultResolverCacheTTL)
+	key := resolverCacheKey{kind: resolverKindMilestone, projectID: 1, name: "v1.0"}
+	wantErr := errors.New("milestone not found: v1.0")
+	cache.setFailure(key, wantErr)
+
+	result, ok := cache.get(key)
+	require.True(t, ok)
+	assert.Equal(t, wantErr, result.err)
+}
+
+// TestResolverCacheTTLFromEnv tests parsing of GITLAB_RESOLVER_CACHE_TTL.
+func TestResolverCacheTTLFromEnv(t *testing.T) {
+	t.Setenv("GITLAB_RESOLVER_CACHE_TTL", "")
+	assert.Equal(t, defaultResolverCacheTTL, resolverCacheTTLFromEnv())
+
+	t.Setenv("GITLAB_RESOLVER_CACHE_TTL", "2m")
+	assert.Equal(t, 2*time.Minute, resolverCacheTTLFromEnv())
+
+	t.Setenv("GITLAB_RESOLVER_CACHE_TTL", "not-a-duration")
+	assert.Equal(t, defaultResolverCacheTTL, resolverCacheTTLFromEnv())
+}
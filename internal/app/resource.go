@@ -0,0 +1,137 @@
+package app
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// ResourceKind identifies which GitLab entity a resource URI refers to.
+type ResourceKind string
+
+// Resource kinds exposed by the ResourceManager.
+const (
+	ResourceKindIssue        ResourceKind = "issues"
+	ResourceKindMergeRequest ResourceKind = "merge_requests"
+	ResourceKindPipeline     ResourceKind = "pipelines"
+)
+
+// ResourceURI builds the gitlab://project/{id}/{kind}/{iid} URI identifying a single issue,
+// merge request, or pipeline resource.
+func ResourceURI(projectID int64, kind ResourceKind, iid int64) string {
+	return fmt.Sprintf("gitlab://project/%d/%s/%d", projectID, kind, iid)
+}
+
+// ResourceUpdate is delivered by a ResourceDriver when a subscribed resource's content changes.
+type ResourceUpdate struct {
+	URI string
+}
+
+// ParseResourceURI splits a gitlab://project/{id}/{kind}/{iid} URI into its parts, for callers
+// (e.g. the MCP resource read handler) outside this package.
+func ParseResourceURI(uri string) (projectID int64, kind ResourceKind, iid int64, err error) {
+	return parseResourceURI(uri)
+}
+
+// parseResourceURI splits a gitlab://project/{id}/{kind}/{iid} URI into its parts.
+func parseResourceURI(uri string) (projectID int64, kind ResourceKind, iid int64, err error) {
+	const prefix = "gitlab://project/"
+	if !strings.HasPrefix(uri, prefix) {
+		return 0, "", 0, fmt.Errorf("%w: %q", ErrUnknownResourceURI, uri)
+	}
+
+	parts := strings.Split(strings.TrimPrefix(uri, prefix), "/")
+	if len(parts) != 3 {
+		return 0, "", 0, fmt.Errorf("%w: %q", ErrUnknownResourceURI, uri)
+	}
+
+	projectID, err = strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return 0, "", 0, fmt.Errorf("%w: %q", ErrUnknownResourceURI, uri)
+	}
+
+	kind = ResourceKind(parts[1])
+	if kind != ResourceKindIssue && kind != ResourceKindMergeRequest && kind != ResourceKindPipeline {
+		return 0, "", 0, fmt.Errorf("%w: %q", ErrUnknownResourceURI, uri)
+	}
+
+	iid, err = strconv.ParseInt(parts[2], 10, 64)
+	if err != nil {
+		return 0, "", 0, fmt.Errorf("%w: %q", ErrUnknownResourceURI, uri)
+	}
+
+	return projectID, kind, iid, nil
+}
+
+// ResourceDriver watches the set of subscribed resource URIs for changes and delivers a
+// ResourceUpdate on Updates() for each one that changes. PollingDriver and WebhookDriver are the
+// two implementations offered by this package.
+type ResourceDriver interface {
+	// Subscribe starts watching uri for changes.
+	Subscribe(uri string) error
+	// Unsubscribe stops watching uri.
+	Unsubscribe(uri string) error
+	// Updates delivers a ResourceUpdate for every subscribed URI whose content changes. It is
+	// closed when the driver is closed.
+	Updates() <-chan ResourceUpdate
+	// Close stops the driver and releases any resources it holds (tickers, listeners, ...).
+	Close() error
+}
+
+// ResourceManager exposes GitLab issues, merge requests and pipelines as MCP resources and
+// tracks per-URI subscriptions, forwarding its driver's change notifications to notify (which
+// callers wire to the MCP server's notifications/resources/updated broadcast).
+type ResourceManager struct {
+	driver ResourceDriver
+
+	mu   sync.Mutex
+	done chan struct{}
+}
+
+// NewResourceManager starts forwarding driver's updates to notify until the returned
+// ResourceManager is closed.
+func NewResourceManager(driver ResourceDriver, notify func(uri string)) *ResourceManager {
+	m := &ResourceManager{
+		driver: driver,
+		done:   make(chan struct{}),
+	}
+	go m.run(notify)
+	return m
+}
+
+func (m *ResourceManager) run(notify func(uri string)) {
+	for {
+		select {
+		case update, ok := <-m.driver.Updates():
+			if !ok {
+				return
+			}
+			notify(update.URI)
+		case <-m.done:
+			return
+		}
+	}
+}
+
+// Subscribe registers uri for change notifications.
+func (m *ResourceManager) Subscribe(uri string) error {
+	return m.driver.Subscribe(uri)
+}
+
+// Unsubscribe removes uri from change notifications.
+func (m *ResourceManager) Unsubscribe(uri string) error {
+	return m.driver.Unsubscribe(uri)
+}
+
+// Close stops the manager's forwarding goroutine and its driver.
+func (m *ResourceManager) Close() error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	select {
+	case <-m.done:
+	default:
+		close(m.done)
+	}
+	return m.driver.Close()
+}
@@ -0,0 +1,167 @@
+package app
+
+import (
+	"errors"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// defaultPollInterval is how often PollingDriver re-checks subscribed resources when the caller
+// doesn't specify one.
+const defaultPollInterval = 30 * time.Second
+
+// ErrUnknownResourceURI is returned when a resource URI doesn't match the gitlab://project/{id}/
+// {kind}/{iid} shape PollingDriver and WebhookDriver understand.
+var ErrUnknownResourceURI = errors.New("unknown resource URI")
+
+// pollTarget tracks one subscribed resource's last-seen ETag so PollingDriver can tell whether it
+// changed since the previous poll without re-delivering unchanged resources.
+type pollTarget struct {
+	projectID int64
+	kind      ResourceKind
+	iid       int64
+	etag      string
+}
+
+// PollingDriver implements ResourceDriver by periodically re-fetching each subscribed resource
+// through the App's existing cached getters and comparing an ETag-like fingerprint (the
+// resource's updated_at, or status for pipelines) against the last poll, emitting an update only
+// when it moved. Subscribing a resource primes its fingerprint without emitting an update for the
+// initial fetch.
+type PollingDriver struct {
+	app      *App
+	interval time.Duration
+
+	mu      sync.Mutex
+	targets map[string]*pollTarget
+
+	updates chan ResourceUpdate
+	done    chan struct{}
+	stop    sync.Once
+}
+
+// NewPollingDriver starts a PollingDriver that re-checks subscribed resources every interval
+// (interval <= 0 uses defaultPollInterval).
+func NewPollingDriver(a *App, interval time.Duration) *PollingDriver {
+	if interval <= 0 {
+		interval = defaultPollInterval
+	}
+	d := &PollingDriver{
+		app:      a,
+		interval: interval,
+		targets:  make(map[string]*pollTarget),
+		updates:  make(chan ResourceUpdate, 16),
+		done:     make(chan struct{}),
+	}
+	go d.run()
+	return d
+}
+
+func (d *PollingDriver) run() {
+	ticker := time.NewTicker(d.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			d.pollAll()
+		case <-d.done:
+			return
+		}
+	}
+}
+
+func (d *PollingDriver) pollAll() {
+	d.mu.Lock()
+	targets := make(map[string]*pollTarget, len(d.targets))
+	for uri, t := range d.targets {
+		targets[uri] = t
+	}
+	d.mu.Unlock()
+
+	for uri, t := range targets {
+		etag, err := d.fetchETag(t)
+		if err != nil {
+			d.app.logger.Error("Failed to poll resource", "uri", uri, "error", err)
+			continue
+		}
+
+		d.mu.Lock()
+		changed := etag != "" && etag != t.etag
+		if changed {
+			t.etag = etag
+		}
+		d.mu.Unlock()
+
+		if !changed {
+			continue
+		}
+		select {
+		case d.updates <- ResourceUpdate{URI: uri}:
+		case <-d.done:
+			return
+		}
+	}
+}
+
+func (d *PollingDriver) fetchETag(t *pollTarget) (string, error) {
+	switch t.kind {
+	case ResourceKindIssue:
+		issue, err := d.app.getIssueCached(t.projectID, int(t.iid))
+		if err != nil {
+			return "", err
+		}
+		return effectiveTimestamp(issue.CreatedAt, issue.UpdatedAt).Format(time.RFC3339), nil
+	case ResourceKindMergeRequest:
+		mr, err := d.app.GetProjectMergeRequest(strconv.FormatInt(t.projectID, 10), t.iid)
+		if err != nil {
+			return "", err
+		}
+		return mr.UpdatedAt, nil
+	case ResourceKindPipeline:
+		pipeline, err := d.app.GetPipeline(strconv.FormatInt(t.projectID, 10), t.iid)
+		if err != nil {
+			return "", err
+		}
+		return pipeline.Status, nil
+	default:
+		return "", ErrUnknownResourceURI
+	}
+}
+
+// Subscribe implements ResourceDriver.
+func (d *PollingDriver) Subscribe(uri string) error {
+	projectID, kind, iid, err := parseResourceURI(uri)
+	if err != nil {
+		return err
+	}
+
+	t := &pollTarget{projectID: projectID, kind: kind, iid: iid}
+	if etag, err := d.fetchETag(t); err == nil {
+		t.etag = etag
+	}
+
+	d.mu.Lock()
+	d.targets[uri] = t
+	d.mu.Unlock()
+	return nil
+}
+
+// Unsubscribe implements ResourceDriver.
+func (d *PollingDriver) Unsubscribe(uri string) error {
+	d.mu.Lock()
+	delete(d.targets, uri)
+	d.mu.Unlock()
+	return nil
+}
+
+// Updates implements ResourceDriver.
+func (d *PollingDriver) Updates() <-chan ResourceUpdate {
+	return d.updates
+}
+
+// Close implements ResourceDriver.
+func (d *PollingDriver) Close() error {
+	d.stop.Do(func() { close(d.done) })
+	return nil
+}
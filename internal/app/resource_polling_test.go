@@ -0,0 +1,71 @@
+package app
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gitlab.com/gitlab-org/api/client-go"
+)
+
+// TestPollingDriver_SubscribePrimesFingerprintWithoutEmitting tests that Subscribe fetches the
+// resource once to record its starting fingerprint, without delivering an update for it.
+func TestPollingDriver_SubscribePrimesFingerprintWithoutEmitting(t *testing.T) {
+	mockClient := new(MockGitLabClient)
+	mockIssues := new(MockIssuesService)
+
+	mockClient.On("Issues").Return(mockIssues)
+
+	mockIssues.On("GetIssue", int64(7), 5).Return(
+		&gitlab.Issue{IID: 5, UpdatedAt: &time.Time{}}, &gitlab.Response{}, nil,
+	)
+
+	a := NewWithClient("token", "https://gitlab.com/", mockClient)
+	driver := NewPollingDriver(a, time.Hour)
+	defer func() { _ = driver.Close() }()
+
+	require.NoError(t, driver.Subscribe(ResourceURI(7, ResourceKindIssue, 5)))
+
+	select {
+	case update := <-driver.Updates():
+		t.Fatalf("unexpected update on subscribe: %+v", update)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+// TestPollingDriver_Unsubscribe tests that an unsubscribed resource is no longer polled.
+func TestPollingDriver_Unsubscribe(t *testing.T) {
+	mockClient := new(MockGitLabClient)
+	mockIssues := new(MockIssuesService)
+
+	mockClient.On("Issues").Return(mockIssues)
+
+	mockIssues.On("GetIssue", int64(7), 5).Return(
+		&gitlab.Issue{IID: 5, UpdatedAt: &time.Time{}}, &gitlab.Response{}, nil,
+	)
+
+	a := NewWithClient("token", "https://gitlab.com/", mockClient)
+	driver := NewPollingDriver(a, time.Hour)
+	defer func() { _ = driver.Close() }()
+
+	uri := ResourceURI(7, ResourceKindIssue, 5)
+	require.NoError(t, driver.Subscribe(uri))
+	require.NoError(t, driver.Unsubscribe(uri))
+
+	driver.mu.Lock()
+	_, ok := driver.targets[uri]
+	driver.mu.Unlock()
+	assert.False(t, ok)
+}
+
+// TestPollingDriver_Subscribe_UnknownURI tests that Subscribe rejects a malformed resource URI.
+func TestPollingDriver_Subscribe_UnknownURI(t *testing.T) {
+	a := NewWithClient("token", "https://gitlab.com/", new(MockGitLabClient))
+	driver := NewPollingDriver(a, time.Hour)
+	defer func() { _ = driver.Close() }()
+
+	err := driver.Subscribe("not-a-gitlab-uri")
+
+	assert.ErrorIs(t, err, ErrUnknownResourceURI)
+}
@@ -0,0 +1,125 @@
+package app
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestResourceURI tests that ResourceURI builds the documented gitlab://project/{id}/{kind}/{iid}
+// shape.
+func TestResourceURI(t *testing.T) {
+	got := ResourceURI(7, ResourceKindIssue, 5)
+
+	assert.Equal(t, "gitlab://project/7/issues/5", got)
+}
+
+// TestParseResourceURI tests that ParseResourceURI extracts the project ID, kind, and IID from a
+// resource URI, and rejects anything that doesn't match the expected shape.
+func TestParseResourceURI(t *testing.T) {
+	tests := []struct {
+		name     string
+		uri      string
+		wantID   int64
+		wantKind ResourceKind
+		wantIID  int64
+		wantErr  bool
+	}{
+		{
+			name: "issue uri", uri: "gitlab://project/7/issues/5",
+			wantID: 7, wantKind: ResourceKindIssue, wantIID: 5,
+		},
+		{
+			name: "merge request uri", uri: "gitlab://project/7/merge_requests/3",
+			wantID: 7, wantKind: ResourceKindMergeRequest, wantIID: 3,
+		},
+		{
+			name: "pipeline uri", uri: "gitlab://project/7/pipelines/42",
+			wantID: 7, wantKind: ResourceKindPipeline, wantIID: 42,
+		},
+		{name: "wrong scheme", uri: "https://project/7/issues/5", wantErr: true},
+		{name: "unknown kind", uri: "gitlab://project/7/notes/5", wantErr: true},
+		{name: "non-numeric project id", uri: "gitlab://project/abc/issues/5", wantErr: true},
+		{name: "missing iid", uri: "gitlab://project/7/issues", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotID, gotKind, gotIID, err := ParseResourceURI(tt.uri)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantID, gotID)
+			assert.Equal(t, tt.wantKind, gotKind)
+			assert.Equal(t, tt.wantIID, gotIID)
+		})
+	}
+}
+
+// fakeResourceDriver is a minimal ResourceDriver test double that lets tests push updates and
+// observe Subscribe/Unsubscribe/Close calls without any real polling or network I/O.
+type fakeResourceDriver struct {
+	updates    chan ResourceUpdate
+	subscribed []string
+	closed     bool
+}
+
+func newFakeResourceDriver() *fakeResourceDriver {
+	return &fakeResourceDriver{updates: make(chan ResourceUpdate, 4)}
+}
+
+func (f *fakeResourceDriver) Subscribe(uri string) error {
+	f.subscribed = append(f.subscribed, uri)
+	return nil
+}
+
+func (f *fakeResourceDriver) Unsubscribe(_ string) error {
+	return nil
+}
+
+func (f *fakeResourceDriver) Updates() <-chan ResourceUpdate {
+	return f.updates
+}
+
+func (f *fakeResourceDriver) Close() error {
+	f.closed = true
+	close(f.updates)
+	return nil
+}
+
+// TestResourceManager_ForwardsUpdates tests that a ResourceManager forwards every ResourceUpdate
+// its driver emits to the notify callback, and that Subscribe delegates to the driver.
+func TestResourceManager_ForwardsUpdates(t *testing.T) {
+	driver := newFakeResourceDriver()
+	notified := make(chan string, 1)
+
+	manager := NewResourceManager(driver, func(uri string) { notified <- uri })
+	defer func() { _ = manager.Close() }()
+
+	require.NoError(t, manager.Subscribe("gitlab://project/7/issues/5"))
+	assert.Equal(t, []string{"gitlab://project/7/issues/5"}, driver.subscribed)
+
+	driver.updates <- ResourceUpdate{URI: "gitlab://project/7/issues/5"}
+
+	select {
+	case uri := <-notified:
+		assert.Equal(t, "gitlab://project/7/issues/5", uri)
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for notification")
+	}
+}
+
+// TestResourceManager_Close tests that Close stops the manager's driver.
+func TestResourceManager_Close(t *testing.T) {
+	driver := newFakeResourceDriver()
+	manager := NewResourceManager(driver, func(string) {})
+
+	require.NoError(t, manager.Close())
+	assert.True(t, driver.closed)
+}
@@ -0,0 +1,234 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+
+	"github.com/sgaunet/gitlab-mcp/internal/gitlaberr"
+	"gitlab.com/gitlab-org/api/client-go"
+)
+
+// ErrWebhookSecretMismatch is returned when an inbound webhook request's X-Gitlab-Token header
+// doesn't match the secret the hook was registered with.
+var ErrWebhookSecretMismatch = errors.New("webhook secret mismatch")
+
+// webhookPayload covers the subset of GitLab's issue, merge request, and pipeline webhook event
+// payloads (https://docs.gitlab.com/ee/user/project/integrations/webhook_events.html) needed to
+// translate a delivery into the resource it changed.
+type webhookPayload struct {
+	ObjectKind string `json:"object_kind"`
+	Project    struct {
+		ID int64 `json:"id"`
+	} `json:"project"`
+	ObjectAttributes struct {
+		IID int64 `json:"iid"`
+		ID  int64 `json:"id"`
+	} `json:"object_attributes"`
+}
+
+// resourceKind maps a webhook's object_kind to the ResourceKind it updates, and reports whether
+// the payload is one WebhookDriver understands.
+func (p webhookPayload) resourceKind() (ResourceKind, bool) {
+	switch p.ObjectKind {
+	case "issue":
+		return ResourceKindIssue, true
+	case "merge_request":
+		return ResourceKindMergeRequest, true
+	case "pipeline":
+		return ResourceKindPipeline, true
+	default:
+		return "", false
+	}
+}
+
+// iid returns the resource's IID. Pipeline payloads carry their ID (pipelines have no IID) under
+// object_attributes.id instead.
+func (p webhookPayload) iid() int64 {
+	if p.ObjectKind == "pipeline" {
+		return p.ObjectAttributes.ID
+	}
+	return p.ObjectAttributes.IID
+}
+
+// WebhookDriver implements ResourceDriver by registering a GitLab project webhook per subscribed
+// project and running an HTTP listener that translates inbound hook deliveries into
+// ResourceUpdates. Unlike PollingDriver it requires no periodic re-fetch, at the cost of needing
+// a publicly reachable listener address to register with GitLab.
+type WebhookDriver struct {
+	app        *App
+	listenAddr string
+	secret     string
+
+	mu            sync.Mutex
+	subscribed    map[string]struct{}
+	hookIDsByProj map[int64]int64
+
+	updates chan ResourceUpdate
+	server  *http.Server
+	stop    sync.Once
+}
+
+// NewWebhookDriver starts an HTTP listener on listenAddr that receives GitLab project webhook
+// deliveries authenticated with secret (sent back as the X-Gitlab-Token header). Call Subscribe
+// per resource URI to register the underlying project's webhook with GitLab.
+func NewWebhookDriver(a *App, listenAddr, secret string) (*WebhookDriver, error) {
+	d := &WebhookDriver{
+		app:           a,
+		listenAddr:    listenAddr,
+		secret:        secret,
+		subscribed:    make(map[string]struct{}),
+		hookIDsByProj: make(map[int64]int64),
+		updates:       make(chan ResourceUpdate, 16),
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/webhooks/gitlab", d.handleWebhook)
+	d.server = &http.Server{Addr: listenAddr, Handler: mux} //nolint:gosec // internal listener, no client-facing timeouts needed
+
+	listener, err := net.Listen("tcp", listenAddr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start webhook listener: %w", err)
+	}
+	go func() {
+		if err := d.server.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			a.logger.Error("Webhook listener stopped", "error", err)
+		}
+	}()
+
+	return d, nil
+}
+
+func (d *WebhookDriver) handleWebhook(w http.ResponseWriter, r *http.Request) {
+	if d.secret != "" && r.Header.Get("X-Gitlab-Token") != d.secret {
+		http.Error(w, ErrWebhookSecretMismatch.Error(), http.StatusUnauthorized)
+		return
+	}
+
+	var payload webhookPayload
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		http.Error(w, "invalid webhook payload", http.StatusBadRequest)
+		return
+	}
+
+	kind, ok := payload.resourceKind()
+	if !ok {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	uri := ResourceURI(payload.Project.ID, kind, payload.iid())
+
+	d.mu.Lock()
+	_, subscribed := d.subscribed[uri]
+	d.mu.Unlock()
+	if !subscribed {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	select {
+	case d.updates <- ResourceUpdate{URI: uri}:
+	default:
+		d.app.logger.Warn("Dropped webhook-triggered resource update, updates channel full", "uri", uri)
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// Subscribe implements ResourceDriver, registering a project webhook with GitLab the first time
+// any resource belonging to that project is subscribed.
+func (d *WebhookDriver) Subscribe(uri string) error {
+	projectID, _, _, err := parseResourceURI(uri)
+	if err != nil {
+		return err
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.subscribed[uri] = struct{}{}
+
+	if _, ok := d.hookIDsByProj[projectID]; ok {
+		return nil
+	}
+
+	hookURL := "http://" + d.listenAddr + "/webhooks/gitlab"
+	hook, _, err := retryCall(d.app, context.Background(), "AddProjectHook",
+		func() (*gitlab.ProjectHook, *gitlab.Response, error) {
+			return d.app.client.ProjectHooks().AddProjectHook(projectID, &gitlab.AddProjectHookOptions{
+				URL:                 &hookURL,
+				Token:               &d.secret,
+				IssuesEvents:        gitlab.Ptr(true),
+				MergeRequestsEvents: gitlab.Ptr(true),
+				PipelineEvents:      gitlab.Ptr(true),
+			})
+		})
+	if err != nil {
+		return gitlaberr.Classify(err, "failed to register project webhook")
+	}
+
+	d.hookIDsByProj[projectID] = hook.ID
+	return nil
+}
+
+// Unsubscribe implements ResourceDriver, deregistering the project's webhook once its last
+// subscribed resource is removed.
+func (d *WebhookDriver) Unsubscribe(uri string) error {
+	projectID, _, _, err := parseResourceURI(uri)
+	if err != nil {
+		return err
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	delete(d.subscribed, uri)
+
+	if d.stillSubscribed(projectID) {
+		return nil
+	}
+
+	hookID, ok := d.hookIDsByProj[projectID]
+	if !ok {
+		return nil
+	}
+	delete(d.hookIDsByProj, projectID)
+
+	if _, err := d.app.client.ProjectHooks().DeleteProjectHook(projectID, hookID); err != nil {
+		return gitlaberr.Classify(err, "failed to remove project webhook")
+	}
+	return nil
+}
+
+// stillSubscribed reports whether any resource under projectID remains subscribed. Callers must
+// hold d.mu.
+func (d *WebhookDriver) stillSubscribed(projectID int64) bool {
+	prefix := "gitlab://project/" + strconv.FormatInt(projectID, 10) + "/"
+	for uri := range d.subscribed {
+		if len(uri) > len(prefix) && uri[:len(prefix)] == prefix {
+			return true
+		}
+	}
+	return false
+}
+
+// Updates implements ResourceDriver.
+func (d *WebhookDriver) Updates() <-chan ResourceUpdate {
+	return d.updates
+}
+
+// Close implements ResourceDriver, shutting down the HTTP listener. It does not deregister
+// already-registered project webhooks; callers that want that should Unsubscribe each URI first.
+func (d *WebhookDriver) Close() error {
+	var err error
+	d.stop.Do(func() {
+		err = d.server.Close()
+	})
+	if err != nil {
+		return fmt.Errorf("failed to close webhook listener: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,188 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sgaunet/gitlab-mcp/internal/gitlaberr"
+	"gitlab.com/gitlab-org/api/client-go"
+)
+
+// RetryPolicy controls how many times, and how long, retryCall waits between attempts for a
+// given method.
+type RetryPolicy struct {
+	MaxAttempts int
+	BaseDelay   time.Duration
+	MaxDelay    time.Duration
+}
+
+// NoRetryPolicy disables retries: a single attempt, no backoff. It is the default for
+// NewWithClient, NewWithClientAndValidation, and NewWithClientAndCache so mock-based unit tests
+// keep asserting exactly one call per GitLab API method without needing to know about retries.
+func NoRetryPolicy() RetryPolicy {
+	return RetryPolicy{MaxAttempts: 1}
+}
+
+// DefaultRetryPolicy is the policy used by New(): up to 4 attempts with exponential backoff
+// between 200ms and 5s.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{MaxAttempts: 4, BaseDelay: 200 * time.Millisecond, MaxDelay: 5 * time.Second}
+}
+
+// RetryConfig is the App-wide retry configuration: a default policy plus optional per-method
+// overrides, keyed by the method name passed to retryCall (e.g. "CreateMergeRequest").
+type RetryConfig struct {
+	Default   RetryPolicy
+	Overrides map[string]RetryPolicy
+}
+
+// DefaultRetryConfig builds a RetryConfig using policy as the default, with no overrides.
+func DefaultRetryConfig(policy RetryPolicy) RetryConfig {
+	return RetryConfig{Default: policy}
+}
+
+func (c RetryConfig) policyFor(method string) RetryPolicy {
+	if policy, ok := c.Overrides[method]; ok {
+		return policy
+	}
+	return c.Default
+}
+
+// Clock abstracts the passage of time so tests can make retryCall's backoff instantaneous
+// instead of actually sleeping.
+type Clock interface {
+	After(d time.Duration) <-chan time.Time
+}
+
+// realClock is the Clock used in production.
+type realClock struct{}
+
+func (realClock) After(d time.Duration) <-chan time.Time {
+	return time.After(d)
+}
+
+// retryCall calls fn, retrying it per a's policy for method while fn returns a retryable error
+// (see isRetryableForMethod, which only retries network-level errors for idempotent "ListX"/
+// "GetX" methods), sleeping with exponential backoff and jitter between attempts - honoring a
+// Retry-After or RateLimit-Reset response header when present - and logging a structured warning
+// before each retry. It stops early, returning ctx.Err(), if ctx is canceled while waiting.
+func retryCall[T any](
+	a *App, ctx context.Context, method string, fn func() (T, *gitlab.Response, error),
+) (T, *gitlab.Response, error) {
+	policy := a.retryConfig.policyFor(method)
+	maxAttempts := policy.MaxAttempts
+	if maxAttempts <= 0 {
+		maxAttempts = 1
+	}
+
+	var zero T
+	for attempt := 1; ; attempt++ {
+		value, resp, err := fn()
+		if err == nil {
+			return value, resp, nil
+		}
+
+		if attempt == maxAttempts || !isRetryableForMethod(err, method) {
+			return zero, resp, err
+		}
+
+		delay := retryDelay(policy, attempt, resp)
+		a.logger.WarnContext(ctx, "Retrying GitLab API call",
+			"method", method, "attempt", attempt, "max_attempts", maxAttempts,
+			"delay", delay, "reason", err)
+
+		select {
+		case <-ctx.Done():
+			return zero, resp, ctx.Err()
+		case <-a.clock.After(delay):
+		}
+	}
+}
+
+// retryDelay computes the backoff before the next attempt: the Retry-After response header when
+// present, falling back to the RateLimit-Reset header (a Unix timestamp of when GitLab's rate
+// limit window resets), otherwise exponential backoff from policy.BaseDelay with up to 20%
+// jitter, capped at policy.MaxDelay.
+func retryDelay(policy RetryPolicy, attempt int, resp *gitlab.Response) time.Duration {
+	if resp != nil && resp.Response != nil {
+		if raw := resp.Header.Get("Retry-After"); raw != "" {
+			if seconds, err := strconv.Atoi(raw); err == nil {
+				return time.Duration(seconds) * time.Second
+			}
+		}
+		if raw := resp.Header.Get("RateLimit-Reset"); raw != "" {
+			if resetUnix, err := strconv.ParseInt(raw, 10, 64); err == nil {
+				if wait := time.Until(time.Unix(resetUnix, 0)); wait > 0 {
+					return wait
+				}
+			}
+		}
+	}
+
+	base := policy.BaseDelay
+	if base <= 0 {
+		base = DefaultRetryPolicy().BaseDelay
+	}
+	delay := base * time.Duration(int64(1)<<(attempt-1))
+	if policy.MaxDelay > 0 && delay > policy.MaxDelay {
+		delay = policy.MaxDelay
+	}
+	jitter := time.Duration(rand.Int63n(int64(delay)/5 + 1)) //nolint:gosec // jitter, not security-sensitive
+	return delay + jitter
+}
+
+// isRetryableError reports whether err looks like a transient GitLab API failure (429, 502,
+// 503, 504, or a network-level error) that is worth retrying.
+func isRetryableError(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return true
+	}
+	return hasRetryableStatusCode(err)
+}
+
+// hasRetryableStatusCode reports whether err carries a rate-limit (429) or 502/503/504 GitLab response status,
+// regardless of whether it also looks like a network-level failure.
+func hasRetryableStatusCode(err error) bool {
+	if errors.Is(err, gitlaberr.ErrRateLimited) {
+		return true
+	}
+
+	msg := err.Error()
+	for _, code := range []string{"429", "502", "503", "504"} {
+		if strings.Contains(msg, code) {
+			return true
+		}
+	}
+	return false
+}
+
+// isIdempotentMethod reports whether method follows this repo's "ListX"/"GetX" naming convention
+// for read-only GitLab API calls, as opposed to "CreateX"/"UpdateX"/"DeleteX"-style writes.
+func isIdempotentMethod(method string) bool {
+	return strings.HasPrefix(method, "List") || strings.HasPrefix(method, "Get")
+}
+
+// isRetryableForMethod narrows isRetryableError for non-idempotent methods (creates, updates,
+// deletes): a network-level error (timeout, connection reset) is not retried, since the original
+// write may already have been applied server-side and the response simply never arrived. Only an
+// explicit 429/502/503/504 response - which GitLab returns without having processed the request - is
+// retried. Idempotent reads retry on any transient error, network-level included.
+func isRetryableForMethod(err error, method string) bool {
+	if err == nil {
+		return false
+	}
+	if isIdempotentMethod(method) {
+		return isRetryableError(err)
+	}
+	return hasRetryableStatusCode(err)
+}
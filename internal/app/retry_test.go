@@ -0,0 +1,176 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net"
+	"net/http"
+	"os"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gitlab.com/gitlab-org/api/client-go"
+)
+
+// instantClock is a Clock whose After channel is already closed, so retryCall's backoff never
+// actually sleeps in tests.
+type instantClock struct{}
+
+func (instantClock) After(time.Duration) <-chan time.Time {
+	ch := make(chan time.Time, 1)
+	ch <- time.Time{}
+	return ch
+}
+
+func newTestAppForRetry(policy RetryPolicy) *App {
+	a := NewWithClient("token", "https://gitlab.com/", &MockGitLabClient{})
+	a.SetLogger(slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelError})))
+	a.SetClock(instantClock{})
+	a.SetRetryConfig(DefaultRetryConfig(policy))
+	return a
+}
+
+// TestRetryCall_SucceedsAfterTransientErrors tests that retryCall retries a retryable error and
+// returns the eventual success.
+func TestRetryCall_SucceedsAfterTransientErrors(t *testing.T) {
+	a := newTestAppForRetry(RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond})
+
+	attempts := 0
+	value, _, err := retryCall(a, context.Background(), "TestMethod", func() (string, *gitlab.Response, error) {
+		attempts++
+		if attempts < 3 {
+			return "", nil, errors.New("503 Service Unavailable")
+		}
+		return "ok", nil, nil
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "ok", value)
+	assert.Equal(t, 3, attempts)
+}
+
+// TestRetryCall_StopsOnNonRetryableError tests that retryCall does not retry a non-retryable
+// error, matching the "existing mock-based tests assert a single call" requirement.
+func TestRetryCall_StopsOnNonRetryableError(t *testing.T) {
+	a := newTestAppForRetry(RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond})
+
+	attempts := 0
+	_, _, err := retryCall(a, context.Background(), "TestMethod", func() (string, *gitlab.Response, error) {
+		attempts++
+		return "", nil, errors.New("404 Not Found")
+	})
+
+	require.Error(t, err)
+	assert.Equal(t, 1, attempts)
+}
+
+// TestRetryCall_NoRetryPolicy tests that NoRetryPolicy makes a single attempt even on a
+// retryable error, which is what keeps existing mock-based tests (built via NewWithClient)
+// asserting exactly one call per GitLab API method.
+func TestRetryCall_NoRetryPolicy(t *testing.T) {
+	a := newTestAppForRetry(NoRetryPolicy())
+
+	attempts := 0
+	_, _, err := retryCall(a, context.Background(), "TestMethod", func() (string, *gitlab.Response, error) {
+		attempts++
+		return "", nil, errors.New("503 Service Unavailable")
+	})
+
+	require.Error(t, err)
+	assert.Equal(t, 1, attempts)
+}
+
+// TestRetryCall_ContextCanceled tests that retryCall stops waiting and returns ctx.Err() once
+// the context is canceled between attempts.
+func TestRetryCall_ContextCanceled(t *testing.T) {
+	a := newTestAppForRetry(RetryPolicy{MaxAttempts: 5, BaseDelay: time.Hour})
+	a.SetClock(realClock{})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	attempts := 0
+	_, _, err := retryCall(a, ctx, "TestMethod", func() (string, *gitlab.Response, error) {
+		attempts++
+		return "", nil, errors.New("503 Service Unavailable")
+	})
+
+	require.Error(t, err)
+	assert.Equal(t, context.Canceled, err)
+	assert.Equal(t, 1, attempts)
+}
+
+// TestIsRetryableError tests the classification of retryable vs. permanent GitLab errors.
+func TestIsRetryableError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{name: "429 is retryable", err: errors.New("429 Too Many Requests"), want: true},
+		{name: "502 is retryable", err: errors.New("502 Bad Gateway"), want: true},
+		{name: "503 is retryable", err: errors.New("503 Service Unavailable"), want: true},
+		{name: "504 is retryable", err: errors.New("504 Gateway Timeout"), want: true},
+		{name: "404 is not retryable", err: errors.New("404 Not Found"), want: false},
+		{name: "nil is not retryable", err: nil, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, isRetryableError(tt.err))
+		})
+	}
+}
+
+// TestRetryDelay_HonorsRetryAfterHeader tests that retryDelay uses the Retry-After response
+// header when present instead of computing an exponential backoff.
+func TestRetryDelay_HonorsRetryAfterHeader(t *testing.T) {
+	resp := &gitlab.Response{
+		Response: &http.Response{Header: http.Header{"Retry-After": []string{"3"}}},
+	}
+
+	delay := retryDelay(DefaultRetryPolicy(), 1, resp)
+
+	assert.Equal(t, 3*time.Second, delay)
+}
+
+// TestRetryDelay_HonorsRateLimitResetHeader tests that retryDelay falls back to the
+// RateLimit-Reset header when Retry-After is absent.
+func TestRetryDelay_HonorsRateLimitResetHeader(t *testing.T) {
+	reset := time.Now().Add(5 * time.Second)
+	resp := &gitlab.Response{
+		Response: &http.Response{
+			Header: http.Header{"Ratelimit-Reset": []string{strconv.FormatInt(reset.Unix(), 10)}},
+		},
+	}
+
+	delay := retryDelay(DefaultRetryPolicy(), 1, resp)
+
+	assert.InDelta(t, 5*time.Second, delay, float64(2*time.Second))
+}
+
+// TestIsIdempotentMethod tests the ListX/GetX naming convention used to tell reads from writes.
+func TestIsIdempotentMethod(t *testing.T) {
+	assert.True(t, isIdempotentMethod("ListMilestones"))
+	assert.True(t, isIdempotentMethod("GetNamespace"))
+	assert.False(t, isIdempotentMethod("CreateMilestone"))
+	assert.False(t, isIdempotentMethod("UpdateMilestone"))
+	assert.False(t, isIdempotentMethod("DeleteMilestone"))
+}
+
+// TestIsRetryableForMethod_NonIdempotentIgnoresNetworkErrors tests that a non-idempotent (write)
+// method does not retry a bare network error, since the write may have already landed.
+func TestIsRetryableForMethod_NonIdempotentIgnoresNetworkErrors(t *testing.T) {
+	assert.False(t, isRetryableForMethod(&net.DNSError{IsTimeout: true}, "CreateMilestone"))
+	assert.True(t, isRetryableForMethod(errors.New("503 Service Unavailable"), "CreateMilestone"))
+}
+
+// TestIsRetryableForMethod_IdempotentRetriesNetworkErrors tests that an idempotent (read) method
+// still retries on a bare network error, matching isRetryableError.
+func TestIsRetryableForMethod_IdempotentRetriesNetworkErrors(t *testing.T) {
+	assert.True(t, isRetryableForMethod(&net.DNSError{IsTimeout: true}, "ListMilestones"))
+}
@@ -0,0 +1,408 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/sgaunet/gitlab-mcp/internal/gitlaberr"
+	"gitlab.com/gitlab-org/api/client-go"
+)
+
+// GitLab's time-tracking conventions: an 8-hour working day and a 5-day (40-hour) working week.
+const (
+	secondsPerMinute = 60
+	secondsPerHour   = 60 * secondsPerMinute
+	secondsPerDay    = 8 * secondsPerHour
+	secondsPerWeek   = 5 * secondsPerDay
+)
+
+// ErrInvalidDuration is returned when a human-readable duration string cannot be parsed.
+var ErrInvalidDuration = errors.New(
+	`invalid duration: expected a combination of <n>w, <n>d, <n>h, <n>m (e.g. "1h30m", "2d")`,
+)
+
+// ErrUnsupportedTimeTrackingResource is returned when a time-tracking operation is requested for
+// a resource kind other than "issue" or "merge_request".
+var ErrUnsupportedTimeTrackingResource = errors.New(`resource must be "issue" or "merge_request"`)
+
+// TimeTrackingResource identifies whether a time-tracking operation targets an issue or a merge
+// request.
+type TimeTrackingResource string
+
+// Supported TimeTrackingResource values.
+const (
+	TimeTrackingIssue        TimeTrackingResource = "issue"
+	TimeTrackingMergeRequest TimeTrackingResource = "merge_request"
+)
+
+// TimeStats represents the aggregated time-tracking totals for an issue or merge request.
+type TimeStats struct {
+	HumanTimeEstimate   string `json:"human_time_estimate"`
+	HumanTotalTimeSpent string `json:"human_total_time_spent"`
+	TimeEstimate        int    `json:"time_estimate"`
+	TotalTimeSpent      int    `json:"total_time_spent"`
+}
+
+// convertGitLabTimeStats converts GitLab time stats to our TimeStats struct.
+func convertGitLabTimeStats(stats *gitlab.TimeStats) TimeStats {
+	return TimeStats{
+		HumanTimeEstimate:   stats.HumanTimeEstimate,
+		HumanTotalTimeSpent: stats.HumanTotalTimeSpent,
+		TimeEstimate:        stats.TimeEstimate,
+		TotalTimeSpent:      stats.TotalTimeSpent,
+	}
+}
+
+// parseGitLabDuration parses a human-readable duration string using GitLab's time-tracking
+// conventions (1w = 5d, 1d = 8h) into the number of seconds it represents. A leading "-" negates
+// the result, letting callers subtract previously logged time.
+func parseGitLabDuration(duration string) (int64, error) {
+	s := strings.TrimSpace(duration)
+	if s == "" {
+		return 0, ErrInvalidDuration
+	}
+
+	negative := false
+	if strings.HasPrefix(s, "-") {
+		negative = true
+		s = s[1:]
+	}
+	if s == "" {
+		return 0, ErrInvalidDuration
+	}
+
+	units := map[byte]int64{
+		'w': secondsPerWeek,
+		'd': secondsPerDay,
+		'h': secondsPerHour,
+		'm': secondsPerMinute,
+	}
+
+	var total int64
+	var digits strings.Builder
+	matchedUnit := false
+
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c >= '0' && c <= '9':
+			digits.WriteByte(c)
+		case units[c] != 0:
+			if digits.Len() == 0 {
+				return 0, ErrInvalidDuration
+			}
+			n, err := strconv.ParseInt(digits.String(), 10, 64)
+			if err != nil {
+				return 0, fmt.Errorf("%w: %w", ErrInvalidDuration, err)
+			}
+			total += n * units[c]
+			digits.Reset()
+			matchedUnit = true
+		default:
+			return 0, ErrInvalidDuration
+		}
+	}
+	if !matchedUnit || digits.Len() > 0 {
+		return 0, ErrInvalidDuration
+	}
+
+	if negative {
+		total = -total
+	}
+	return total, nil
+}
+
+// formatGitLabDuration renders a number of seconds as a GitLab-style human-readable duration
+// string (e.g. "1d2h30m"), using GitLab's 8-hour day and 5-day week conventions.
+func formatGitLabDuration(seconds int64) string {
+	var b strings.Builder
+	if seconds < 0 {
+		b.WriteByte('-')
+		seconds = -seconds
+	}
+	prefixLen := b.Len()
+
+	weeks := seconds / secondsPerWeek
+	seconds %= secondsPerWeek
+	days := seconds / secondsPerDay
+	seconds %= secondsPerDay
+	hours := seconds / secondsPerHour
+	seconds %= secondsPerHour
+	minutes := seconds / secondsPerMinute
+
+	if weeks > 0 {
+		fmt.Fprintf(&b, "%dw", weeks)
+	}
+	if days > 0 {
+		fmt.Fprintf(&b, "%dd", days)
+	}
+	if hours > 0 {
+		fmt.Fprintf(&b, "%dh", hours)
+	}
+	if minutes > 0 || b.Len() == prefixLen {
+		fmt.Fprintf(&b, "%dm", minutes)
+	}
+	return b.String()
+}
+
+// validateTimeTrackingIID checks the IID against the validation error used by the given
+// resource's own domain (ErrInvalidIssueIID or ErrInvalidMergeRequestIID).
+func validateTimeTrackingIID(resource TimeTrackingResource, iid int64) error {
+	if iid > 0 {
+		return nil
+	}
+	switch resource {
+	case TimeTrackingIssue:
+		return ErrInvalidIssueIID
+	case TimeTrackingMergeRequest:
+		return ErrInvalidMergeRequestIID
+	default:
+		return ErrUnsupportedTimeTrackingResource
+	}
+}
+
+// SetTimeEstimate sets the time estimate on an issue or merge request from a human-readable
+// duration (e.g. "1h30m", "2d").
+func (a *App) SetTimeEstimate(
+	resource TimeTrackingResource,
+	projectPath string,
+	iid int64,
+	duration string,
+) (*TimeStats, error) {
+	if err := validateTimeTrackingIID(resource, iid); err != nil {
+		return nil, err
+	}
+	seconds, err := parseGitLabDuration(duration)
+	if err != nil {
+		return nil, err
+	}
+	normalized := formatGitLabDuration(seconds)
+
+	a.logger.Debug("Setting time estimate",
+		"project_path", projectPath, "resource", resource, "iid", iid, "duration", normalized)
+
+	project, err := a.getProjectCached(projectPath)
+	if err != nil {
+		a.logger.Error("Failed to get project", "error", err, "project_path", projectPath)
+		return nil, err
+	}
+
+	opt := &gitlab.SetTimeEstimateOptions{Duration: &normalized}
+
+	var stats *gitlab.TimeStats
+	switch resource {
+	case TimeTrackingIssue:
+		stats, _, err = retryCall(a, context.Background(), "SetIssueTimeEstimate",
+			func() (*gitlab.TimeStats, *gitlab.Response, error) {
+				return a.client.TimeStats().SetIssueTimeEstimate(project.ID, int(iid), opt)
+			})
+	case TimeTrackingMergeRequest:
+		stats, _, err = retryCall(a, context.Background(), "SetMergeRequestTimeEstimate",
+			func() (*gitlab.TimeStats, *gitlab.Response, error) {
+				return a.client.TimeStats().SetMergeRequestTimeEstimate(project.ID, iid, opt)
+			})
+	default:
+		return nil, ErrUnsupportedTimeTrackingResource
+	}
+	if err != nil {
+		a.logger.Error("Failed to set time estimate",
+			"error", err, "project_id", project.ID, "resource", resource, "iid", iid)
+		return nil, gitlaberr.Classify(err, "failed to set time estimate")
+	}
+
+	result := convertGitLabTimeStats(stats)
+	a.logger.Info("Successfully set time estimate", "resource", resource, "iid", iid, "duration", normalized)
+	return &result, nil
+}
+
+// ResetTimeEstimate clears the time estimate on an issue or merge request.
+func (a *App) ResetTimeEstimate(
+	resource TimeTrackingResource,
+	projectPath string,
+	iid int64,
+) (*TimeStats, error) {
+	if err := validateTimeTrackingIID(resource, iid); err != nil {
+		return nil, err
+	}
+
+	a.logger.Debug("Resetting time estimate", "project_path", projectPath, "resource", resource, "iid", iid)
+
+	project, err := a.getProjectCached(projectPath)
+	if err != nil {
+		a.logger.Error("Failed to get project", "error", err, "project_path", projectPath)
+		return nil, err
+	}
+
+	var stats *gitlab.TimeStats
+	switch resource {
+	case TimeTrackingIssue:
+		stats, _, err = retryCall(a, context.Background(), "ResetIssueTimeEstimate",
+			func() (*gitlab.TimeStats, *gitlab.Response, error) {
+				return a.client.TimeStats().ResetIssueTimeEstimate(project.ID, int(iid))
+			})
+	case TimeTrackingMergeRequest:
+		stats, _, err = retryCall(a, context.Background(), "ResetMergeRequestTimeEstimate",
+			func() (*gitlab.TimeStats, *gitlab.Response, error) {
+				return a.client.TimeStats().ResetMergeRequestTimeEstimate(project.ID, iid)
+			})
+	default:
+		return nil, ErrUnsupportedTimeTrackingResource
+	}
+	if err != nil {
+		a.logger.Error("Failed to reset time estimate",
+			"error", err, "project_id", project.ID, "resource", resource, "iid", iid)
+		return nil, gitlaberr.Classify(err, "failed to reset time estimate")
+	}
+
+	result := convertGitLabTimeStats(stats)
+	a.logger.Info("Successfully reset time estimate", "resource", resource, "iid", iid)
+	return &result, nil
+}
+
+// AddSpentTime logs time spent on an issue or merge request from a human-readable duration.
+// A negative duration (e.g. "-1h") subtracts previously logged time.
+func (a *App) AddSpentTime(
+	resource TimeTrackingResource,
+	projectPath string,
+	iid int64,
+	duration string,
+) (*TimeStats, error) {
+	if err := validateTimeTrackingIID(resource, iid); err != nil {
+		return nil, err
+	}
+	seconds, err := parseGitLabDuration(duration)
+	if err != nil {
+		return nil, err
+	}
+	normalized := formatGitLabDuration(seconds)
+
+	a.logger.Debug("Adding spent time",
+		"project_path", projectPath, "resource", resource, "iid", iid, "duration", normalized)
+
+	project, err := a.getProjectCached(projectPath)
+	if err != nil {
+		a.logger.Error("Failed to get project", "error", err, "project_path", projectPath)
+		return nil, err
+	}
+
+	opt := &gitlab.AddSpentTimeOptions{Duration: &normalized}
+
+	var stats *gitlab.TimeStats
+	switch resource {
+	case TimeTrackingIssue:
+		stats, _, err = retryCall(a, context.Background(), "AddIssueSpentTime",
+			func() (*gitlab.TimeStats, *gitlab.Response, error) {
+				return a.client.TimeStats().AddIssueSpentTime(project.ID, int(iid), opt)
+			})
+	case TimeTrackingMergeRequest:
+		stats, _, err = retryCall(a, context.Background(), "AddMergeRequestSpentTime",
+			func() (*gitlab.TimeStats, *gitlab.Response, error) {
+				return a.client.TimeStats().AddMergeRequestSpentTime(project.ID, iid, opt)
+			})
+	default:
+		return nil, ErrUnsupportedTimeTrackingResource
+	}
+	if err != nil {
+		a.logger.Error("Failed to add spent time", "error", err, "project_id", project.ID, "resource", resource, "iid", iid)
+		return nil, gitlaberr.Classify(err, "failed to add spent time")
+	}
+
+	result := convertGitLabTimeStats(stats)
+	a.logger.Info("Successfully added spent time", "resource", resource, "iid", iid, "duration", normalized)
+	return &result, nil
+}
+
+// ResetSpentTime clears all logged time spent on an issue or merge request.
+func (a *App) ResetSpentTime(resource TimeTrackingResource, projectPath string, iid int64) (*TimeStats, error) {
+	if err := validateTimeTrackingIID(resource, iid); err != nil {
+		return nil, err
+	}
+
+	a.logger.Debug("Resetting spent time", "project_path", projectPath, "resource", resource, "iid", iid)
+
+	project, err := a.getProjectCached(projectPath)
+	if err != nil {
+		a.logger.Error("Failed to get project", "error", err, "project_path", projectPath)
+		return nil, err
+	}
+
+	var stats *gitlab.TimeStats
+	switch resource {
+	case TimeTrackingIssue:
+		stats, _, err = retryCall(a, context.Background(), "ResetIssueSpentTime",
+			func() (*gitlab.TimeStats, *gitlab.Response, error) {
+				return a.client.TimeStats().ResetIssueSpentTime(project.ID, int(iid))
+			})
+	case TimeTrackingMergeRequest:
+		stats, _, err = retryCall(a, context.Background(), "ResetMergeRequestSpentTime",
+			func() (*gitlab.TimeStats, *gitlab.Response, error) {
+				return a.client.TimeStats().ResetMergeRequestSpentTime(project.ID, iid)
+			})
+	default:
+		return nil, ErrUnsupportedTimeTrackingResource
+	}
+	if err != nil {
+		a.logger.Error("Failed to reset spent time", "error", err, "project_id", project.ID, "resource", resource, "iid", iid)
+		return nil, gitlaberr.Classify(err, "failed to reset spent time")
+	}
+
+	result := convertGitLabTimeStats(stats)
+	a.logger.Info("Successfully reset spent time", "resource", resource, "iid", iid)
+	return &result, nil
+}
+
+// ResetTimeStats clears both the time estimate and the logged spent time on an issue or merge
+// request in one call, returning the resulting (zeroed) totals.
+func (a *App) ResetTimeStats(resource TimeTrackingResource, projectPath string, iid int64) (*TimeStats, error) {
+	if err := validateTimeTrackingIID(resource, iid); err != nil {
+		return nil, err
+	}
+
+	if _, err := a.ResetTimeEstimate(resource, projectPath, iid); err != nil {
+		return nil, err
+	}
+
+	return a.ResetSpentTime(resource, projectPath, iid)
+}
+
+// GetTimeSpent retrieves the time-tracking totals for an issue or merge request.
+func (a *App) GetTimeSpent(resource TimeTrackingResource, projectPath string, iid int64) (*TimeStats, error) {
+	if err := validateTimeTrackingIID(resource, iid); err != nil {
+		return nil, err
+	}
+
+	a.logger.Debug("Getting time spent", "project_path", projectPath, "resource", resource, "iid", iid)
+
+	project, err := a.getProjectCached(projectPath)
+	if err != nil {
+		a.logger.Error("Failed to get project", "error", err, "project_path", projectPath)
+		return nil, err
+	}
+
+	var stats *gitlab.TimeStats
+	switch resource {
+	case TimeTrackingIssue:
+		stats, _, err = retryCall(a, context.Background(), "GetIssueTimeStats",
+			func() (*gitlab.TimeStats, *gitlab.Response, error) {
+				return a.client.TimeStats().GetIssueTimeStats(project.ID, int(iid))
+			})
+	case TimeTrackingMergeRequest:
+		stats, _, err = retryCall(a, context.Background(), "GetMergeRequestTimeStats",
+			func() (*gitlab.TimeStats, *gitlab.Response, error) {
+				return a.client.TimeStats().GetMergeRequestTimeStats(project.ID, iid)
+			})
+	default:
+		return nil, ErrUnsupportedTimeTrackingResource
+	}
+	if err != nil {
+		a.logger.Error("Failed to get time spent", "error", err, "project_id", project.ID, "resource", resource, "iid", iid)
+		return nil, gitlaberr.Classify(err, "failed to get time spent")
+	}
+
+	result := convertGitLabTimeStats(stats)
+	a.logger.Info("Successfully retrieved time spent", "resource", resource, "iid", iid)
+	return &result, nil
+}
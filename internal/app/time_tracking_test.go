@@ -0,0 +1,181 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gitlab.com/gitlab-org/api/client-go"
+)
+
+// TestParseGitLabDuration tests parseGitLabDuration's handling of GitLab's 8h-day/5d-week
+// conventions, negative durations, and malformed input.
+func TestParseGitLabDuration(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    int64
+		wantErr bool
+	}{
+		{name: "hours and minutes", input: "1h30m", want: secondsPerHour + 30*secondsPerMinute},
+		{name: "single day", input: "2d", want: 2 * secondsPerDay},
+		{name: "single week", input: "1w", want: secondsPerWeek},
+		{name: "week day hour minute combined", input: "1w2d3h4m", want: secondsPerWeek + 2*secondsPerDay + 3*secondsPerHour + 4*secondsPerMinute},
+		{name: "negative duration to subtract", input: "-1h", want: -secondsPerHour},
+		{name: "negative combined duration", input: "-2d1h", want: -(2*secondsPerDay + secondsPerHour)},
+		{name: "whitespace is trimmed", input: "  1h  ", want: secondsPerHour},
+		{name: "empty string", input: "", wantErr: true},
+		{name: "bare sign", input: "-", wantErr: true},
+		{name: "missing unit", input: "90", wantErr: true},
+		{name: "missing number", input: "h", wantErr: true},
+		{name: "unknown unit", input: "1x", wantErr: true},
+		{name: "trailing digits without unit", input: "1h30", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseGitLabDuration(tt.input)
+
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidDuration)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+// TestFormatGitLabDuration tests that formatGitLabDuration renders seconds back into GitLab's
+// week/day/hour/minute notation, including zero and negative values.
+func TestFormatGitLabDuration(t *testing.T) {
+	tests := []struct {
+		name    string
+		seconds int64
+		want    string
+	}{
+		{name: "hours and minutes", seconds: secondsPerHour + 30*secondsPerMinute, want: "1h30m"},
+		{name: "exactly one day", seconds: secondsPerDay, want: "1d"},
+		{name: "exactly one week", seconds: secondsPerWeek, want: "1w"},
+		{name: "zero seconds", seconds: 0, want: "0m"},
+		{name: "negative duration", seconds: -secondsPerHour, want: "-1h"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, formatGitLabDuration(tt.seconds))
+		})
+	}
+}
+
+// TestApp_SetTimeEstimate tests the App.SetTimeEstimate method for both resource kinds.
+func TestApp_SetTimeEstimate(t *testing.T) {
+	mockClient := new(MockGitLabClient)
+	mockProjects := new(MockProjectsService)
+	mockTimeStats := new(MockTimeStatsService)
+
+	mockClient.On("Projects").Return(mockProjects)
+	mockClient.On("TimeStats").Return(mockTimeStats)
+
+	mockProjects.On("GetProject", "test/project", (*gitlab.GetProjectOptions)(nil)).Return(
+		&gitlab.Project{ID: 7}, &gitlab.Response{}, nil,
+	)
+
+	duration := "1h30m"
+	mockTimeStats.On("SetIssueTimeEstimate", int64(7), 5, &gitlab.SetTimeEstimateOptions{Duration: &duration}).Return(
+		&gitlab.TimeStats{HumanTimeEstimate: "1h 30m"}, &gitlab.Response{}, nil,
+	)
+
+	a := newTestAppForMergeRequest(mockClient)
+
+	got, err := a.SetTimeEstimate(TimeTrackingIssue, "test/project", 5, duration)
+
+	require.NoError(t, err)
+	require.NotNil(t, got)
+	assert.Equal(t, "1h 30m", got.HumanTimeEstimate)
+}
+
+// TestApp_SetTimeEstimate_InvalidDuration tests that SetTimeEstimate rejects a malformed
+// duration before calling the GitLab API.
+func TestApp_SetTimeEstimate_InvalidDuration(t *testing.T) {
+	a := newTestAppForMergeRequest(new(MockGitLabClient))
+
+	_, err := a.SetTimeEstimate(TimeTrackingIssue, "test/project", 5, "not-a-duration")
+
+	assert.ErrorIs(t, err, ErrInvalidDuration)
+}
+
+// TestApp_AddSpentTime tests the App.AddSpentTime method against a merge request, including the
+// week/day conversion applied to the normalized duration sent to GitLab.
+func TestApp_AddSpentTime(t *testing.T) {
+	mockClient := new(MockGitLabClient)
+	mockProjects := new(MockProjectsService)
+	mockTimeStats := new(MockTimeStatsService)
+
+	mockClient.On("Projects").Return(mockProjects)
+	mockClient.On("TimeStats").Return(mockTimeStats)
+
+	mockProjects.On("GetProject", "test/project", (*gitlab.GetProjectOptions)(nil)).Return(
+		&gitlab.Project{ID: 7}, &gitlab.Response{}, nil,
+	)
+
+	normalized := "1w2d"
+	mockTimeStats.On(
+		"AddMergeRequestSpentTime", int64(7), int64(9), &gitlab.AddSpentTimeOptions{Duration: &normalized},
+	).Return(&gitlab.TimeStats{HumanTotalTimeSpent: "1w 2d"}, &gitlab.Response{}, nil)
+
+	a := newTestAppForMergeRequest(mockClient)
+
+	got, err := a.AddSpentTime(TimeTrackingMergeRequest, "test/project", 9, "1w2d")
+
+	require.NoError(t, err)
+	require.NotNil(t, got)
+	assert.Equal(t, "1w 2d", got.HumanTotalTimeSpent)
+}
+
+// TestApp_AddSpentTime_NegativeDuration tests that a negative duration is forwarded as-is so
+// GitLab subtracts previously logged time.
+func TestApp_AddSpentTime_NegativeDuration(t *testing.T) {
+	mockClient := new(MockGitLabClient)
+	mockProjects := new(MockProjectsService)
+	mockTimeStats := new(MockTimeStatsService)
+
+	mockClient.On("Projects").Return(mockProjects)
+	mockClient.On("TimeStats").Return(mockTimeStats)
+
+	mockProjects.On("GetProject", "test/project", (*gitlab.GetProjectOptions)(nil)).Return(
+		&gitlab.Project{ID: 7}, &gitlab.Response{}, nil,
+	)
+
+	normalized := "-1h"
+	mockTimeStats.On("AddIssueSpentTime", int64(7), 5, &gitlab.AddSpentTimeOptions{Duration: &normalized}).Return(
+		&gitlab.TimeStats{HumanTotalTimeSpent: "0m"}, &gitlab.Response{}, nil,
+	)
+
+	a := newTestAppForMergeRequest(mockClient)
+
+	_, err := a.AddSpentTime(TimeTrackingIssue, "test/project", 5, "-1h")
+
+	require.NoError(t, err)
+}
+
+// TestApp_GetTimeSpent_InvalidIID tests that GetTimeSpent rejects a non-positive IID before
+// calling the GitLab API.
+func TestApp_GetTimeSpent_InvalidIID(t *testing.T) {
+	a := newTestAppForMergeRequest(new(MockGitLabClient))
+
+	_, err := a.GetTimeSpent(TimeTrackingIssue, "test/project", 0)
+
+	assert.ErrorIs(t, err, ErrInvalidIssueIID)
+}
+
+// TestApp_ResetSpentTime_UnsupportedResource tests that an unrecognised resource kind is
+// rejected before calling the GitLab API.
+func TestApp_ResetSpentTime_UnsupportedResource(t *testing.T) {
+	a := newTestAppForMergeRequest(new(MockGitLabClient))
+
+	_, err := a.ResetSpentTime(TimeTrackingResource("epic"), "test/project", 5)
+
+	assert.ErrorIs(t, err, ErrUnsupportedTimeTrackingResource)
+}
@@ -0,0 +1,33 @@
+package app
+
+import "time"
+
+// gitlabTimeLayout is the string format used when rendering a GitLab CreatedAt/UpdatedAt
+// timestamp as a JSON field.
+const gitlabTimeLayout = "2006-01-02T15:04:05Z"
+
+// formatGitLabTime formats a GitLab timestamp pointer as gitlabTimeLayout, returning the
+// formatted zero time when t is nil instead of panicking. Real GitLab payloads occasionally omit
+// a timestamp field (notably UpdatedAt on some MR review notes), so every conversion function
+// that renders CreatedAt/UpdatedAt should go through this rather than calling t.Format directly.
+func formatGitLabTime(t *time.Time) string {
+	if t == nil {
+		return time.Time{}.Format(gitlabTimeLayout)
+	}
+	return t.Format(gitlabTimeLayout)
+}
+
+// effectiveTimestamp returns updatedAt, falling back to createdAt when updatedAt is nil, and to
+// the zero time when both are nil. Sort/dedup logic that needs a single comparable "last
+// activity" instant from a resource with possibly-partial timestamps (e.g. merging project- and
+// group-level issue listings, or future MR/note aggregation) should use this rather than
+// dereferencing UpdatedAt directly.
+func effectiveTimestamp(createdAt, updatedAt *time.Time) time.Time {
+	if updatedAt != nil {
+		return *updatedAt
+	}
+	if createdAt != nil {
+		return *createdAt
+	}
+	return time.Time{}
+}
@@ -0,0 +1,60 @@
+package app
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"gitlab.com/gitlab-org/api/client-go"
+)
+
+func TestFormatGitLabTime(t *testing.T) {
+	testTime := time.Date(2024, 3, 15, 12, 30, 0, 0, time.UTC)
+
+	assert.Equal(t, "2024-03-15T12:30:00Z", formatGitLabTime(&testTime))
+	assert.Equal(t, "0001-01-01T00:00:00Z", formatGitLabTime(nil))
+}
+
+func TestEffectiveTimestamp(t *testing.T) {
+	createdAt := time.Date(2024, 3, 15, 12, 30, 0, 0, time.UTC)
+	updatedAt := time.Date(2024, 3, 16, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		createdAt *time.Time
+		updatedAt *time.Time
+		want      time.Time
+	}{
+		{name: "both set prefers updatedAt", createdAt: &createdAt, updatedAt: &updatedAt, want: updatedAt},
+		{name: "nil updatedAt falls back to createdAt", createdAt: &createdAt, updatedAt: nil, want: createdAt},
+		{name: "both nil is the zero time", createdAt: nil, updatedAt: nil, want: time.Time{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, effectiveTimestamp(tt.createdAt, tt.updatedAt))
+		})
+	}
+}
+
+// TestConvertGitLabIssue_NilTimestamps locks in that convertGitLabIssue doesn't panic on a
+// payload missing CreatedAt/UpdatedAt, which real GitLab responses occasionally omit.
+func TestConvertGitLabIssue_NilTimestamps(t *testing.T) {
+	issue := &gitlab.Issue{ID: 1, IID: 1, Title: "No timestamps", State: "opened"}
+
+	result := convertGitLabIssue(issue)
+
+	assert.Equal(t, "0001-01-01T00:00:00Z", result.CreatedAt)
+	assert.Equal(t, "0001-01-01T00:00:00Z", result.UpdatedAt)
+}
+
+// TestConvertGitLabMergeRequest_NilTimestamps mirrors TestConvertGitLabIssue_NilTimestamps for
+// merge requests, whose review-related payloads sometimes omit UpdatedAt.
+func TestConvertGitLabMergeRequest_NilTimestamps(t *testing.T) {
+	mr := &gitlab.MergeRequest{BasicMergeRequest: gitlab.BasicMergeRequest{ID: 1, IID: 1, Title: "No timestamps", State: "opened"}}
+
+	result := convertGitLabMergeRequest(mr)
+
+	assert.Equal(t, "0001-01-01T00:00:00Z", result.CreatedAt)
+	assert.Equal(t, "0001-01-01T00:00:00Z", result.UpdatedAt)
+}
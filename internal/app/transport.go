@@ -0,0 +1,92 @@
+package app
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/sgaunet/gitlab-mcp/internal/logger"
+)
+
+// instrumentedTransport wraps an http.RoundTripper so every outgoing GitLab API call is logged
+// at debug level and observed through app's metrics sink, without any call site needing to know
+// about logging or metrics. It reads app.logger and app.metricsSink fresh on every request
+// rather than copying them, since the transport is built once in New(), before SetLogger or
+// SetMetricsSink has necessarily been called.
+type instrumentedTransport struct {
+	next http.RoundTripper
+	app  *App
+}
+
+// newInstrumentedTransport wraps next (http.DefaultTransport if nil) with request logging and
+// metrics sourced from app.
+func newInstrumentedTransport(next http.RoundTripper, app *App) *instrumentedTransport {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	return &instrumentedTransport{next: next, app: app}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *instrumentedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	start := time.Now()
+	endpoint := sanitizeURL(req.URL)
+	requestID, _ := logger.MdcGet(req.Context(), "request_id")
+
+	resp, err := t.next.RoundTrip(req)
+	elapsed := time.Since(start)
+
+	status := 0
+	if resp != nil {
+		status = resp.StatusCode
+	}
+
+	t.app.logger.DebugContext(req.Context(), "GitLab API request",
+		"method", req.Method,
+		"url", endpoint,
+		"request_id", requestID,
+		"status", status,
+		"elapsed_ms", elapsed.Milliseconds(),
+	)
+
+	labels := map[string]string{"method": req.Method, "status": strconv.Itoa(status), "endpoint": endpoint}
+	t.app.metricsSink.IncCounter("gitlab_api_requests_total", labels)
+	t.app.metricsSink.ObserveHistogram("gitlab_api_request_duration_seconds", elapsed.Seconds(), labels)
+
+	if resp != nil {
+		rateLimitLabels := map[string]string{"endpoint": endpoint}
+		if remaining, ok := parseHeaderFloat(resp.Header, "RateLimit-Remaining"); ok {
+			t.app.metricsSink.SetGauge("gitlab_api_rate_limit_remaining", remaining, rateLimitLabels)
+		}
+		if retryAfter, ok := parseHeaderFloat(resp.Header, "Retry-After"); ok {
+			t.app.metricsSink.SetGauge("gitlab_api_retry_after_seconds", retryAfter, rateLimitLabels)
+		}
+	}
+
+	return resp, err
+}
+
+// sanitizeURL returns u with its query string and any userinfo stripped, since GitLab allows
+// passing a private token as a query parameter and a raw URL is otherwise a poor high-cardinality
+// label compared to method+status+endpoint.
+func sanitizeURL(u *url.URL) string {
+	sanitized := *u
+	sanitized.RawQuery = ""
+	sanitized.User = nil
+	return sanitized.String()
+}
+
+// parseHeaderFloat parses the named response header as a float64, reporting whether it was
+// present and well-formed.
+func parseHeaderFloat(h http.Header, key string) (float64, bool) {
+	raw := h.Get(key)
+	if raw == "" {
+		return 0, false
+	}
+	v, err := strconv.ParseFloat(raw, 64)
+	if err != nil {
+		return 0, false
+	}
+	return v, true
+}
@@ -0,0 +1,72 @@
+// Package gitlaberr classifies raw go-gitlab errors into typed sentinel errors so callers can
+// use errors.Is instead of matching on error message text. Classification is done by scanning
+// the error's message with a small set of regexes, similar to how transport layers in other Go
+// Git tooling map messages like "The project you were looking for could not be found" or
+// "404 Project Not Found" to a typed ErrRepositoryNotFound.
+package gitlaberr
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+)
+
+// Sentinel errors for well-known GitLab API failure classes.
+var (
+	ErrProjectNotFound       = errors.New("project not found")
+	ErrGroupNotFound         = errors.New("group not found")
+	ErrEpicNotFound          = errors.New("epic not found")
+	ErrIssueNotFound         = errors.New("issue not found")
+	ErrMergeRequestNotFound  = errors.New("merge request not found")
+	ErrInsufficientScope     = errors.New("token has insufficient OAuth scope for this operation")
+	ErrRateLimited           = errors.New("rate limited by GitLab")
+	ErrConfidentialForbidden = errors.New("confidential issue or epic is not visible to this token")
+	ErrAlreadyApproved       = errors.New("merge request has already been approved by this user")
+)
+
+// rule maps a compiled pattern to the sentinel error it classifies. Rules are evaluated in
+// order, most specific first, since several patterns can overlap (e.g. a generic "403 Forbidden"
+// versus the more specific "confidential").
+type rule struct {
+	pattern  *regexp.Regexp
+	sentinel error
+}
+
+var rules = []rule{
+	{regexp.MustCompile(`(?i)confidential`), ErrConfidentialForbidden},
+	{regexp.MustCompile(`(?i)insufficient_scope|insufficient scope`), ErrInsufficientScope},
+	{regexp.MustCompile(`(?i)429|too many requests|rate limit`), ErrRateLimited},
+	{regexp.MustCompile(`(?i)404.*project|project.*not found`), ErrProjectNotFound},
+	{regexp.MustCompile(`(?i)404.*group|group.*not found`), ErrGroupNotFound},
+	{regexp.MustCompile(`(?i)404.*epic|epic.*not found`), ErrEpicNotFound},
+	{regexp.MustCompile(`(?i)404.*merge request|merge request.*not found`), ErrMergeRequestNotFound},
+	{regexp.MustCompile(`(?i)404.*issue|issue.*not found`), ErrIssueNotFound},
+	{regexp.MustCompile(`(?i)already approved|401.*approv|409.*approv`), ErrAlreadyApproved},
+}
+
+// Classify scans err's message against a set of known GitLab error patterns and, on a match,
+// wraps err with both msg and the matching sentinel so callers can use errors.Is against the
+// sentinel while still retaining the original error in the chain. If nothing matches, err is
+// wrapped with msg alone, same as a plain fmt.Errorf("%s: %w", msg, err) call. Classify returns
+// nil if err is nil.
+func Classify(err error, msg string) error {
+	if err == nil {
+		return nil
+	}
+
+	if sentinel := match(err.Error()); sentinel != nil {
+		return fmt.Errorf("%s: %w: %w", msg, sentinel, err)
+	}
+
+	return fmt.Errorf("%s: %w", msg, err)
+}
+
+// match returns the sentinel for the first rule whose pattern matches message, or nil if none do.
+func match(message string) error {
+	for _, r := range rules {
+		if r.pattern.MatchString(message) {
+			return r.sentinel
+		}
+	}
+	return nil
+}
@@ -0,0 +1,55 @@
+package gitlaberr
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestClassify tests that Classify maps known GitLab error messages to their sentinel errors.
+func TestClassify(t *testing.T) {
+	tests := []struct {
+		name    string
+		rawErr  error
+		errType error
+	}{
+		{name: "project not found", rawErr: errors.New("404 Project Not Found"), errType: ErrProjectNotFound},
+		{name: "group not found", rawErr: errors.New("GET /groups/foo: 404 Group Not Found"), errType: ErrGroupNotFound},
+		{name: "epic not found", rawErr: errors.New("404 Epic Not Found"), errType: ErrEpicNotFound},
+		{name: "issue not found", rawErr: errors.New("404 Issue Not Found"), errType: ErrIssueNotFound},
+		{
+			name:    "merge request not found",
+			rawErr:  errors.New("404 Merge Request Not Found"),
+			errType: ErrMergeRequestNotFound,
+		},
+		{name: "insufficient scope", rawErr: errors.New("403 insufficient_scope"), errType: ErrInsufficientScope},
+		{name: "rate limited", rawErr: errors.New("429 Too Many Requests"), errType: ErrRateLimited},
+		{
+			name:    "confidential forbidden",
+			rawErr:  errors.New("403 Forbidden: issue is confidential"),
+			errType: ErrConfidentialForbidden,
+		},
+		{
+			name:    "already approved",
+			rawErr:  errors.New("409 Conflict: Merge request has already been approved"),
+			errType: ErrAlreadyApproved,
+		},
+		{name: "unmatched error passes through", rawErr: errors.New("500 Internal Server Error"), errType: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			classified := Classify(tt.rawErr, "failed to do the thing")
+			assert.ErrorIs(t, classified, tt.rawErr)
+			if tt.errType != nil {
+				assert.ErrorIs(t, classified, tt.errType)
+			}
+		})
+	}
+}
+
+// TestClassify_NilError tests that Classify returns nil for a nil error.
+func TestClassify_NilError(t *testing.T) {
+	assert.NoError(t, Classify(nil, "failed to do the thing"))
+}
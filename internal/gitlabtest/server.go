@@ -0,0 +1,161 @@
+// Package gitlabtest provides an httptest.Server-backed fake of the small slice of the GitLab
+// REST API this repo's integration tests exercise (currently GET/PUT /api/v4/projects/:id), so
+// App can be driven end-to-end through a real *gitlab.Client without mocking the GitLabClient
+// interface at all. Projects are seeded from fixture files under testdata via LoadProjectFixture,
+// and error paths are exercised by forcing the next matching request to fail with a given status
+// code rather than by returning a canned Go error.
+package gitlabtest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+
+	"gitlab.com/gitlab-org/api/client-go"
+)
+
+// Server is a fake GitLab instance backed by an in-memory project store.
+type Server struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	projects map[int64]*gitlab.Project
+	byPath   map[string]int64
+	failures map[string]int
+}
+
+// NewServer starts a fake GitLab server with no projects registered. Call WithProject to seed it.
+func NewServer() *Server {
+	s := &Server{
+		projects: make(map[int64]*gitlab.Project),
+		byPath:   make(map[string]int64),
+		failures: make(map[string]int),
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v4/projects/", s.handleProject)
+	s.Server = httptest.NewServer(mux)
+	return s
+}
+
+// BaseURL returns the server's URL with a trailing slash, matching the shape App/Credential
+// expect for GITLAB_URI (e.g. "https://gitlab.com/").
+func (s *Server) BaseURL() string {
+	return s.Server.URL + "/"
+}
+
+// WithProject registers project as the fake's backing state, resolvable by both its numeric ID
+// and its PathWithNamespace (e.g. "group/project"), matching how App looks projects up by path.
+func (s *Server) WithProject(project *gitlab.Project) *Server {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.projects[project.ID] = project
+	s.byPath[project.PathWithNamespace] = project.ID
+	return s
+}
+
+// FailNextWithStatus makes the next request matching method and path (e.g. "PUT /api/v4/projects/7")
+// fail with status instead of being served normally, so a caller can assert App's error handling
+// against a real HTTP response rather than a mocked error value.
+func (s *Server) FailNextWithStatus(method, path string, status int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.failures[method+" "+path] = status
+}
+
+// LoadProjectFixture reads testdata/<name>.json (relative to this package) and unmarshals it
+// into a *gitlab.Project.
+func LoadProjectFixture(name string) (*gitlab.Project, error) {
+	data, err := os.ReadFile(filepath.Join("testdata", name+".json"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read fixture %q: %w", name, err)
+	}
+	var project gitlab.Project
+	if err := json.Unmarshal(data, &project); err != nil {
+		return nil, fmt.Errorf("failed to parse fixture %q: %w", name, err)
+	}
+	return &project, nil
+}
+
+func (s *Server) handleProject(w http.ResponseWriter, r *http.Request) {
+	idOrPath, err := url.PathUnescape(strings.TrimPrefix(r.URL.EscapedPath(), "/api/v4/projects/"))
+	if err != nil {
+		http.Error(w, "invalid project identifier", http.StatusBadRequest)
+		return
+	}
+
+	s.mu.Lock()
+	if status, ok := s.failures[r.Method+" "+r.URL.Path]; ok {
+		delete(s.failures, r.Method+" "+r.URL.Path)
+		s.mu.Unlock()
+		http.Error(w, http.StatusText(status), status)
+		return
+	}
+	s.mu.Unlock()
+
+	project, ok := s.lookupProject(idOrPath)
+	if !ok {
+		http.Error(w, "404 Project Not Found", http.StatusNotFound)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, project)
+	case http.MethodPut:
+		s.handleEditProject(w, r, project)
+	default:
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+func (s *Server) lookupProject(idOrPath string) (*gitlab.Project, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if id, err := strconv.ParseInt(idOrPath, 10, 64); err == nil {
+		project, ok := s.projects[id]
+		return project, ok
+	}
+	id, ok := s.byPath[idOrPath]
+	if !ok {
+		return nil, false
+	}
+	return s.projects[id], true
+}
+
+// editProjectRequest mirrors the subset of gitlab.EditProjectOptions this fake understands.
+type editProjectRequest struct {
+	Description *string   `json:"description"`
+	Topics      *[]string `json:"topics"`
+}
+
+func (s *Server) handleEditProject(w http.ResponseWriter, r *http.Request, project *gitlab.Project) {
+	var body editProjectRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		http.Error(w, "invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	s.mu.Lock()
+	if body.Description != nil {
+		project.Description = *body.Description
+	}
+	if body.Topics != nil {
+		project.Topics = *body.Topics
+	}
+	s.mu.Unlock()
+
+	writeJSON(w, project)
+}
+
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
@@ -0,0 +1,89 @@
+package gitlabtest
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestLoadProjectFixture tests that the project fixture loads with the fields the integration
+// tests in internal/app depend on.
+func TestLoadProjectFixture(t *testing.T) {
+	project, err := LoadProjectFixture("project")
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(7), project.ID)
+	assert.Equal(t, "test/project", project.PathWithNamespace)
+	assert.Equal(t, []string{"golang", "mcp"}, project.Topics)
+}
+
+// TestServer_GetProjectByIDOrPath tests that a registered project is served by both its numeric
+// ID and its namespaced path.
+func TestServer_GetProjectByIDOrPath(t *testing.T) {
+	project, err := LoadProjectFixture("project")
+	require.NoError(t, err)
+
+	server := NewServer().WithProject(project)
+	defer server.Close()
+
+	for _, idOrPath := range []string{"7", "test%2Fproject"} {
+		resp, err := http.Get(server.BaseURL() + "api/v4/projects/" + idOrPath)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+	}
+}
+
+// TestServer_EditProjectAppliesTopicsAndDescription tests that a PUT request updates the stored
+// project's description and topics, and that subsequent GETs reflect the change.
+func TestServer_EditProjectAppliesTopicsAndDescription(t *testing.T) {
+	project, err := LoadProjectFixture("project")
+	require.NoError(t, err)
+
+	server := NewServer().WithProject(project)
+	defer server.Close()
+
+	req, err := http.NewRequest(
+		http.MethodPut,
+		server.BaseURL()+"api/v4/projects/7",
+		strings.NewReader(`{"description":"updated","topics":["go"]}`),
+	)
+	require.NoError(t, err)
+
+	resp, err := server.Client().Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	getResp, err := http.Get(server.BaseURL() + "api/v4/projects/7")
+	require.NoError(t, err)
+	defer getResp.Body.Close()
+	body, err := io.ReadAll(getResp.Body)
+	require.NoError(t, err)
+	assert.Contains(t, string(body), `"description":"updated"`)
+}
+
+// TestServer_FailNextWithStatus tests that a forced failure is served exactly once, after which
+// the request is handled normally again.
+func TestServer_FailNextWithStatus(t *testing.T) {
+	project, err := LoadProjectFixture("project")
+	require.NoError(t, err)
+
+	server := NewServer().WithProject(project)
+	defer server.Close()
+	server.FailNextWithStatus(http.MethodGet, "/api/v4/projects/7", http.StatusForbidden)
+
+	failed, err := http.Get(server.BaseURL() + "api/v4/projects/7")
+	require.NoError(t, err)
+	defer failed.Body.Close()
+	assert.Equal(t, http.StatusForbidden, failed.StatusCode)
+
+	ok, err := http.Get(server.BaseURL() + "api/v4/projects/7")
+	require.NoError(t, err)
+	defer ok.Body.Close()
+	assert.Equal(t, http.StatusOK, ok.StatusCode)
+}
@@ -0,0 +1,150 @@
+// Package logger provides the structured logging subsystem used by the GitLab MCP server.
+// It builds a slog.Logger that emits newline-delimited JSON (or plain text) records with
+// stable field names, and supports a per-request mapped diagnostic context (MDC) that is
+// threaded through context.Context and automatically stamped onto every record logged with
+// the *Context methods (DebugContext, InfoContext, WarnContext, ErrorContext).
+package logger
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"os"
+	"strings"
+)
+
+const (
+	// formatEnvVar selects the log handler: "json" (default) or "text".
+	formatEnvVar = "GITLAB_MCP_LOG_FORMAT"
+	// levelEnvVar overrides the log level (debug, info, warn, error) at startup.
+	levelEnvVar = "GITLAB_MCP_LOG_LEVEL"
+	// levelFileEnvVar points to a file whose trimmed contents is a log level, checked before
+	// levelEnvVar so a mounted config file can be rotated without restarting the process env.
+	levelFileEnvVar = "GITLAB_MCP_LOG_LEVEL_FILE"
+)
+
+// NoLogger returns a logger that discards all output, used as the App's default logger
+// before SetLogger/SetCache-style wiring replaces it.
+func NoLogger() *slog.Logger {
+	return slog.New(slog.NewTextHandler(io.Discard, &slog.HandlerOptions{Level: slog.LevelError + 1}))
+}
+
+// NewLevelVar builds a slog.LevelVar seeded from GITLAB_MCP_LOG_LEVEL_FILE or GITLAB_MCP_LOG_LEVEL
+// if set, falling back to defaultLevel. The returned LevelVar can be shared with NewLogger and
+// adjusted at runtime (e.g. via App.SetLogLevel) to change the level of every logger built from it.
+func NewLevelVar(defaultLevel string) *slog.LevelVar {
+	var levelVar slog.LevelVar
+	levelVar.Set(loadLevel(defaultLevel))
+	return &levelVar
+}
+
+// NewLogger builds a logger whose level tracks levelVar and whose handler is chosen by
+// GITLAB_MCP_LOG_FORMAT ("json" by default, or "text"). Every record is wrapped with an
+// mdcHandler so that fields set via MdcSet on a record's context are automatically attached to
+// that record.
+func NewLogger(levelVar *slog.LevelVar) *slog.Logger {
+	opts := &slog.HandlerOptions{
+		Level:       levelVar,
+		ReplaceAttr: replaceAttr,
+	}
+
+	var handler slog.Handler
+	if strings.EqualFold(os.Getenv(formatEnvVar), "text") {
+		handler = slog.NewTextHandler(os.Stderr, opts)
+	} else {
+		handler = slog.NewJSONHandler(os.Stderr, opts)
+	}
+
+	return slog.New(newMdcHandler(handler))
+}
+
+// replaceAttr renames the handler's built-in "time" attribute to "ts" so every record, JSON or
+// text, uses the same stable field names (ts, level, msg) regardless of handler.
+func replaceAttr(_ []string, a slog.Attr) slog.Attr {
+	if a.Key == slog.TimeKey {
+		a.Key = "ts"
+	}
+	return a
+}
+
+// loadLevel resolves the startup log level: the level file (if set and readable), then the
+// level env var, then defaultLevel, then "info".
+func loadLevel(defaultLevel string) slog.Level {
+	if path := os.Getenv(levelFileEnvVar); path != "" {
+		if data, err := os.ReadFile(path); err == nil {
+			if level, ok := parseLevel(strings.TrimSpace(string(data))); ok {
+				return level
+			}
+		}
+	}
+
+	if raw := os.Getenv(levelEnvVar); raw != "" {
+		if level, ok := parseLevel(raw); ok {
+			return level
+		}
+	}
+
+	if level, ok := parseLevel(defaultLevel); ok {
+		return level
+	}
+
+	return slog.LevelInfo
+}
+
+// ParseLevel parses a case-insensitive level name ("debug", "info", "warn"/"warning", "error")
+// into a slog.Level.
+func ParseLevel(raw string) (slog.Level, bool) {
+	return parseLevel(raw)
+}
+
+// parseLevel parses a case-insensitive level name into a slog.Level.
+func parseLevel(raw string) (slog.Level, bool) {
+	switch strings.ToLower(strings.TrimSpace(raw)) {
+	case "debug":
+		return slog.LevelDebug, true
+	case "info":
+		return slog.LevelInfo, true
+	case "warn", "warning":
+		return slog.LevelWarn, true
+	case "error":
+		return slog.LevelError, true
+	default:
+		return slog.LevelInfo, false
+	}
+}
+
+// mdcKeyType is an unexported type so context keys set by this package never collide with keys
+// set by other packages.
+type mdcKeyType struct{}
+
+var mdcKey = mdcKeyType{}
+
+// MdcSet returns a copy of ctx with key set to value in the mapped diagnostic context. The
+// original ctx's MDC, if any, is copied rather than mutated so concurrent tool calls sharing a
+// parent context never see each other's fields.
+func MdcSet(ctx context.Context, key string, value any) context.Context {
+	fields := mdcFrom(ctx)
+	next := make(map[string]any, len(fields)+1)
+	for k, v := range fields {
+		next[k] = v
+	}
+	next[key] = value
+	return context.WithValue(ctx, mdcKey, next)
+}
+
+// MdcGet returns the value stored under key in ctx's mapped diagnostic context, if any.
+func MdcGet(ctx context.Context, key string) (any, bool) {
+	v, ok := mdcFrom(ctx)[key]
+	return v, ok
+}
+
+// MdcClear returns a copy of ctx with its mapped diagnostic context removed.
+func MdcClear(ctx context.Context) context.Context {
+	return context.WithValue(ctx, mdcKey, map[string]any{})
+}
+
+// mdcFrom returns the mapped diagnostic context stored in ctx, or an empty map if none is set.
+func mdcFrom(ctx context.Context) map[string]any {
+	fields, _ := ctx.Value(mdcKey).(map[string]any)
+	return fields
+}
@@ -0,0 +1,82 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestMdcSetGetClear tests the MdcSet/MdcGet/MdcClear context helpers.
+func TestMdcSetGetClear(t *testing.T) {
+	ctx := context.Background()
+
+	_, ok := MdcGet(ctx, "tool_name")
+	assert.False(t, ok)
+
+	ctx = MdcSet(ctx, "tool_name", "list_issues")
+	ctx = MdcSet(ctx, "project_id", int64(42))
+
+	value, ok := MdcGet(ctx, "tool_name")
+	require.True(t, ok)
+	assert.Equal(t, "list_issues", value)
+
+	value, ok = MdcGet(ctx, "project_id")
+	require.True(t, ok)
+	assert.Equal(t, int64(42), value)
+
+	cleared := MdcClear(ctx)
+	_, ok = MdcGet(cleared, "tool_name")
+	assert.False(t, ok)
+
+	// The original ctx is unaffected by MdcClear.
+	_, ok = MdcGet(ctx, "tool_name")
+	assert.True(t, ok)
+}
+
+// TestMdcSetDoesNotLeakBetweenContexts tests that MdcSet on a derived context never mutates the
+// parent's MDC, so concurrent tool calls sharing a parent context don't see each other's fields.
+func TestMdcSetDoesNotLeakBetweenContexts(t *testing.T) {
+	parent := MdcSet(context.Background(), "tool_name", "list_issues")
+
+	childA := MdcSet(parent, "issue_iid", int64(1))
+	childB := MdcSet(parent, "issue_iid", int64(2))
+
+	valueA, _ := MdcGet(childA, "issue_iid")
+	valueB, _ := MdcGet(childB, "issue_iid")
+
+	assert.Equal(t, int64(1), valueA)
+	assert.Equal(t, int64(2), valueB)
+}
+
+// TestMdcHandlerStampsFieldsOntoRecord tests that fields set via MdcSet are attached to records
+// logged through a context-aware call, including the promoted "id" field.
+func TestMdcHandlerStampsFieldsOntoRecord(t *testing.T) {
+	var buf bytes.Buffer
+	base := slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug, ReplaceAttr: replaceAttr})
+	log := slog.New(newMdcHandler(base))
+
+	ctx := MdcSet(context.Background(), "request_id", "req-123")
+	ctx = MdcSet(ctx, "tool_name", "list_issues")
+
+	log.InfoContext(ctx, "handling request")
+
+	var record map[string]any
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &record))
+
+	assert.Equal(t, "req-123", record["id"])
+	assert.Equal(t, "list_issues", record["tool_name"])
+	assert.Equal(t, "handling request", record["msg"])
+	assert.Contains(t, record, "ts")
+}
+
+// TestLoadLevel tests level resolution precedence between the default and parsed values.
+func TestLoadLevel(t *testing.T) {
+	assert.Equal(t, slog.LevelDebug, loadLevel("debug"))
+	assert.Equal(t, slog.LevelInfo, loadLevel(""))
+	assert.Equal(t, slog.LevelInfo, loadLevel("not-a-level"))
+}
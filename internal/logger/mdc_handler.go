@@ -0,0 +1,52 @@
+package logger
+
+import (
+	"context"
+	"log/slog"
+)
+
+// idMdcKey is the MDC field promoted to the record's top-level "id" attribute, giving every log
+// line a stable correlation field even when no other MDC fields have been set.
+const idMdcKey = "request_id"
+
+// mdcHandler wraps another slog.Handler and, for every record, attaches the fields set on the
+// record's context via MdcSet - so a tool handler that calls MdcSet once has every subsequent
+// log line (including ones emitted deep inside the GitLab API client) automatically carry those
+// fields, without each call site needing to pass them explicitly.
+type mdcHandler struct {
+	next slog.Handler
+}
+
+// newMdcHandler wraps next with MDC field injection.
+func newMdcHandler(next slog.Handler) *mdcHandler {
+	return &mdcHandler{next: next}
+}
+
+// Enabled delegates to the wrapped handler.
+func (h *mdcHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return h.next.Enabled(ctx, level)
+}
+
+// Handle adds the context's MDC fields to record, then delegates to the wrapped handler.
+func (h *mdcHandler) Handle(ctx context.Context, record slog.Record) error {
+	fields := mdcFrom(ctx)
+
+	id, _ := fields[idMdcKey].(string)
+	record.AddAttrs(slog.String("id", id))
+
+	for key, value := range fields {
+		record.AddAttrs(slog.Any(key, value))
+	}
+
+	return h.next.Handle(ctx, record)
+}
+
+// WithAttrs returns a new mdcHandler wrapping the result of adding attrs to the inner handler.
+func (h *mdcHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &mdcHandler{next: h.next.WithAttrs(attrs)}
+}
+
+// WithGroup returns a new mdcHandler wrapping the result of adding the group to the inner handler.
+func (h *mdcHandler) WithGroup(name string) slog.Handler {
+	return &mdcHandler{next: h.next.WithGroup(name)}
+}
@@ -0,0 +1,29 @@
+// Package metrics defines a pluggable sink for the operational metrics emitted by the GitLab
+// MCP server, so the rest of the codebase can record counters, histograms, and gauges without
+// depending on a specific metrics backend. Operators wire in a Prometheus or statsd sink at
+// startup; a no-op sink is used until then.
+package metrics
+
+// Sink receives counter, histogram, and gauge observations. Implementations must be safe for
+// concurrent use, since GitLab API calls can run concurrently (see app.AddIssuesToEpic).
+type Sink interface {
+	// IncCounter increments the named counter by one, tagged with labels.
+	IncCounter(name string, labels map[string]string)
+	// ObserveHistogram records value in the named histogram, tagged with labels.
+	ObserveHistogram(name string, value float64, labels map[string]string)
+	// SetGauge sets the named gauge to value, tagged with labels.
+	SetGauge(name string, value float64, labels map[string]string)
+}
+
+// NoopSink discards every observation. It is the default Sink so metrics instrumentation is
+// always safe to call even when no backend has been configured.
+type NoopSink struct{}
+
+// NewNoopSink creates a Sink that discards all observations.
+func NewNoopSink() *NoopSink {
+	return &NoopSink{}
+}
+
+func (*NoopSink) IncCounter(string, map[string]string)                {}
+func (*NoopSink) ObserveHistogram(string, float64, map[string]string) {}
+func (*NoopSink) SetGauge(string, float64, map[string]string)         {}
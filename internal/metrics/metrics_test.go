@@ -0,0 +1,27 @@
+package metrics
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestNoopSink tests that NoopSink's methods are safe to call and never panic.
+func TestNoopSink(t *testing.T) {
+	sink := NewNoopSink()
+
+	assert.NotPanics(t, func() {
+		sink.IncCounter("requests_total", map[string]string{"method": "GET"})
+		sink.ObserveHistogram("request_duration_seconds", 0.42, map[string]string{"method": "GET"})
+		sink.SetGauge("rate_limit_remaining", 100, map[string]string{"endpoint": "/projects"})
+	})
+}
+
+// TestTags tests that tags renders labels as sorted, Datadog-style statsd tags.
+func TestTags(t *testing.T) {
+	assert.Equal(t, "", tags(nil))
+	assert.Equal(t, "|#endpoint:/projects,method:GET", tags(map[string]string{
+		"method":   "GET",
+		"endpoint": "/projects",
+	}))
+}
@@ -0,0 +1,80 @@
+package metrics
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// PrometheusSink adapts Sink to Prometheus client_golang metrics. It lazily creates a
+// CounterVec/HistogramVec/GaugeVec the first time a metric name is observed, since Prometheus
+// requires a metric's label names to be fixed for its lifetime - they're taken from whatever
+// labels accompany that first observation.
+type PrometheusSink struct {
+	registerer prometheus.Registerer
+
+	mu         sync.Mutex
+	counters   map[string]*prometheus.CounterVec
+	histograms map[string]*prometheus.HistogramVec
+	gauges     map[string]*prometheus.GaugeVec
+}
+
+// NewPrometheusSink creates a Sink that registers its metrics against registerer, typically
+// prometheus.DefaultRegisterer.
+func NewPrometheusSink(registerer prometheus.Registerer) *PrometheusSink {
+	return &PrometheusSink{
+		registerer: registerer,
+		counters:   make(map[string]*prometheus.CounterVec),
+		histograms: make(map[string]*prometheus.HistogramVec),
+		gauges:     make(map[string]*prometheus.GaugeVec),
+	}
+}
+
+func labelNames(labels map[string]string) []string {
+	names := make([]string, 0, len(labels))
+	for name := range labels {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (p *PrometheusSink) IncCounter(name string, labels map[string]string) {
+	p.mu.Lock()
+	c, ok := p.counters[name]
+	if !ok {
+		c = prometheus.NewCounterVec(prometheus.CounterOpts{Name: name}, labelNames(labels))
+		p.registerer.MustRegister(c)
+		p.counters[name] = c
+	}
+	p.mu.Unlock()
+
+	c.With(labels).Inc()
+}
+
+func (p *PrometheusSink) ObserveHistogram(name string, value float64, labels map[string]string) {
+	p.mu.Lock()
+	h, ok := p.histograms[name]
+	if !ok {
+		h = prometheus.NewHistogramVec(prometheus.HistogramOpts{Name: name}, labelNames(labels))
+		p.registerer.MustRegister(h)
+		p.histograms[name] = h
+	}
+	p.mu.Unlock()
+
+	h.With(labels).Observe(value)
+}
+
+func (p *PrometheusSink) SetGauge(name string, value float64, labels map[string]string) {
+	p.mu.Lock()
+	g, ok := p.gauges[name]
+	if !ok {
+		g = prometheus.NewGaugeVec(prometheus.GaugeOpts{Name: name}, labelNames(labels))
+		p.registerer.MustRegister(g)
+		p.gauges[name] = g
+	}
+	p.mu.Unlock()
+
+	g.With(labels).Set(value)
+}
@@ -0,0 +1,65 @@
+package metrics
+
+import (
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+)
+
+// StatsdSink sends metrics as UDP packets in StatsD line protocol, with labels encoded as
+// Datadog-style tags ("|#k:v,k2:v2") since that convention is understood by most
+// statsd-compatible collectors (Datadog Agent, Telegraf, VictoriaMetrics).
+type StatsdSink struct {
+	conn net.Conn
+}
+
+// NewStatsdSink dials addr ("host:port") over UDP. Because UDP is connectionless, a bad address
+// only surfaces as a write error later, not here.
+func NewStatsdSink(addr string) (*StatsdSink, error) {
+	conn, err := net.Dial("udp", addr)
+	if err != nil {
+		return nil, fmt.Errorf("statsd: %w", err)
+	}
+	return &StatsdSink{conn: conn}, nil
+}
+
+// Close releases the underlying UDP socket.
+func (s *StatsdSink) Close() error {
+	return s.conn.Close()
+}
+
+func (s *StatsdSink) IncCounter(name string, labels map[string]string) {
+	s.send(name + ":1|c" + tags(labels))
+}
+
+func (s *StatsdSink) ObserveHistogram(name string, value float64, labels map[string]string) {
+	s.send(fmt.Sprintf("%s:%g|h%s", name, value, tags(labels)))
+}
+
+func (s *StatsdSink) SetGauge(name string, value float64, labels map[string]string) {
+	s.send(fmt.Sprintf("%s:%g|g%s", name, value, tags(labels)))
+}
+
+// send fires the packet and drops it on error: a lost metric should never fail or slow down the
+// GitLab API call it describes.
+func (s *StatsdSink) send(line string) {
+	_, _ = s.conn.Write([]byte(line))
+}
+
+func tags(labels map[string]string) string {
+	if len(labels) == 0 {
+		return ""
+	}
+	keys := make([]string, 0, len(labels))
+	for key := range labels {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	pairs := make([]string, 0, len(keys))
+	for _, key := range keys {
+		pairs = append(pairs, key+":"+labels[key])
+	}
+	return "|#" + strings.Join(pairs, ",")
+}
@@ -0,0 +1,107 @@
+// Package migrate shapes a GitLab project's data into a portable directory dump compatible with
+// the Forgejo/Gitea migration downloader schema (repo.json, topics.json, milestones.json,
+// labels.json, issues/<number>.json, pull_requests/<number>.json, releases.json).
+//
+// This package deliberately knows nothing about the GitLab API or the app package's types - it
+// only defines the target records and writes them to disk. The caller (app.App.ExportProject)
+// does the walking and the conversion, so this package stays a leaf with no import back into app.
+package migrate
+
+import "strings"
+
+// Options controls which optional resources a migration dump includes.
+type Options struct {
+	IncludeReleases bool
+}
+
+// Repo is the repo.json record.
+type Repo struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+// Label is a single labels.json record. Scoped GitLab labels ("scope::value") should be passed
+// through FormatScopedLabel before being stored here.
+type Label struct {
+	Name        string `json:"name"`
+	Color       string `json:"color"`
+	Description string `json:"description,omitempty"`
+}
+
+// Milestone is a single milestones.json record.
+type Milestone struct {
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	State       string `json:"state"`
+	DueDate     string `json:"due_on,omitempty"`
+}
+
+// Comment is a single plain comment attached to an issue or pull request.
+type Comment struct {
+	Poster    string `json:"poster"`
+	Content   string `json:"content"`
+	CreatedAt string `json:"created_at"`
+}
+
+// ReviewComment is a single comment within a pull request's code review thread.
+type ReviewComment struct {
+	Poster    string `json:"poster"`
+	Content   string `json:"content"`
+	CreatedAt string `json:"created_at"`
+}
+
+// Issue is a single issues/<number>.json record.
+type Issue struct {
+	Number    int64     `json:"number"`
+	Title     string    `json:"title"`
+	Content   string    `json:"content"`
+	Poster    string    `json:"poster"`
+	State     string    `json:"state"`
+	Labels    []string  `json:"labels,omitempty"`
+	Milestone string    `json:"milestone,omitempty"`
+	CreatedAt string    `json:"created_at"`
+	UpdatedAt string    `json:"updated_at"`
+	Comments  []Comment `json:"comments,omitempty"`
+}
+
+// PullRequest is a single pull_requests/<number>.json record.
+type PullRequest struct {
+	Number         int64           `json:"number"`
+	Title          string          `json:"title"`
+	Content        string          `json:"content"`
+	Poster         string          `json:"poster"`
+	State          string          `json:"state"`
+	Head           string          `json:"head"`
+	Base           string          `json:"base"`
+	Labels         []string        `json:"labels,omitempty"`
+	Milestone      string          `json:"milestone,omitempty"`
+	CreatedAt      string          `json:"created_at"`
+	UpdatedAt      string          `json:"updated_at"`
+	Comments       []Comment       `json:"comments,omitempty"`
+	ReviewComments []ReviewComment `json:"review_comments,omitempty"`
+}
+
+// Release is a single releases.json record.
+type Release struct {
+	TagName   string `json:"tag_name"`
+	Name      string `json:"name"`
+	Content   string `json:"content"`
+	CreatedAt string `json:"created_at"`
+}
+
+// Dump is the full set of records a caller walks out of a project, ready for WriteDump.
+type Dump struct {
+	Repo         Repo
+	Topics       []string
+	Milestones   []Milestone
+	Labels       []Label
+	Issues       []Issue
+	PullRequests []PullRequest
+	Releases     []Release
+}
+
+// FormatScopedLabel rewrites a GitLab scoped label ("scope::value") into the "scope/value" form
+// Forgejo/Gitea expects, leaving unscoped labels untouched.
+func FormatScopedLabel(name string) string {
+	return strings.ReplaceAll(name, "::", "/")
+}
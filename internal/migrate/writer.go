@@ -0,0 +1,73 @@
+package migrate
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// dirMode and fileMode are the permissions WriteDump creates the dump tree with.
+const (
+	dirMode  = 0o755
+	fileMode = 0o644
+)
+
+// WriteDump writes dump to destDir as a Forgejo/Gitea migration-downloader-compatible directory
+// tree: repo.json, topics.json, milestones.json, labels.json, issues/<number>.json,
+// pull_requests/<number>.json and, when dump.Releases is non-empty, releases.json.
+func WriteDump(destDir string, dump *Dump) error {
+	if err := os.MkdirAll(filepath.Join(destDir, "issues"), dirMode); err != nil {
+		return fmt.Errorf("failed to create issues directory: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Join(destDir, "pull_requests"), dirMode); err != nil {
+		return fmt.Errorf("failed to create pull_requests directory: %w", err)
+	}
+
+	if err := writeJSONFile(filepath.Join(destDir, "repo.json"), dump.Repo); err != nil {
+		return err
+	}
+	if err := writeJSONFile(filepath.Join(destDir, "topics.json"), dump.Topics); err != nil {
+		return err
+	}
+	if err := writeJSONFile(filepath.Join(destDir, "milestones.json"), dump.Milestones); err != nil {
+		return err
+	}
+	if err := writeJSONFile(filepath.Join(destDir, "labels.json"), dump.Labels); err != nil {
+		return err
+	}
+
+	for _, issue := range dump.Issues {
+		path := filepath.Join(destDir, "issues", fmt.Sprintf("%d.json", issue.Number))
+		if err := writeJSONFile(path, issue); err != nil {
+			return err
+		}
+	}
+
+	for _, pr := range dump.PullRequests {
+		path := filepath.Join(destDir, "pull_requests", fmt.Sprintf("%d.json", pr.Number))
+		if err := writeJSONFile(path, pr); err != nil {
+			return err
+		}
+	}
+
+	if len(dump.Releases) > 0 {
+		if err := writeJSONFile(filepath.Join(destDir, "releases.json"), dump.Releases); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// writeJSONFile marshals v as indented JSON and writes it to path.
+func writeJSONFile(path string, v any) error {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal %s: %w", path, err)
+	}
+	if err := os.WriteFile(path, data, fileMode); err != nil {
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	return nil
+}
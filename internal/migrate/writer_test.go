@@ -0,0 +1,95 @@
+package migrate
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestWriteDump verifies WriteDump lays out the full directory tree, including the
+// conditionally-written releases.json.
+func TestWriteDump(t *testing.T) {
+	destDir := t.TempDir()
+
+	dump := &Dump{
+		Repo:       Repo{Name: "demo", Description: "a demo project"},
+		Topics:     []string{"go", "cli"},
+		Milestones: []Milestone{{Title: "v1.0", State: "active"}},
+		Labels:     []Label{{Name: "bug", Color: "#ff0000"}},
+		Issues: []Issue{
+			{Number: 1, Title: "first issue", Comments: []Comment{{Poster: "alice", Content: "hi"}}},
+		},
+		PullRequests: []PullRequest{
+			{Number: 2, Title: "first pr", ReviewComments: []ReviewComment{{Poster: "bob", Content: "lgtm"}}},
+		},
+		Releases: []Release{{TagName: "v1.0.0", Name: "v1.0.0"}},
+	}
+
+	require.NoError(t, WriteDump(destDir, dump))
+
+	var repo Repo
+	readJSON(t, filepath.Join(destDir, "repo.json"), &repo)
+	assert.Equal(t, dump.Repo, repo)
+
+	var topics []string
+	readJSON(t, filepath.Join(destDir, "topics.json"), &topics)
+	assert.Equal(t, dump.Topics, topics)
+
+	var issue Issue
+	readJSON(t, filepath.Join(destDir, "issues", "1.json"), &issue)
+	assert.Equal(t, "first issue", issue.Title)
+	require.Len(t, issue.Comments, 1)
+	assert.Equal(t, "alice", issue.Comments[0].Poster)
+
+	var pr PullRequest
+	readJSON(t, filepath.Join(destDir, "pull_requests", "2.json"), &pr)
+	assert.Equal(t, "first pr", pr.Title)
+	require.Len(t, pr.ReviewComments, 1)
+	assert.Equal(t, "bob", pr.ReviewComments[0].Poster)
+
+	var releases []Release
+	readJSON(t, filepath.Join(destDir, "releases.json"), &releases)
+	require.Len(t, releases, 1)
+	assert.Equal(t, "v1.0.0", releases[0].TagName)
+}
+
+// TestWriteDump_OmitsReleasesFileWhenEmpty verifies releases.json is only written when the dump
+// actually has releases, so importers that don't care about them never see an empty file.
+func TestWriteDump_OmitsReleasesFileWhenEmpty(t *testing.T) {
+	destDir := t.TempDir()
+
+	require.NoError(t, WriteDump(destDir, &Dump{Repo: Repo{Name: "demo"}}))
+
+	_, err := os.Stat(filepath.Join(destDir, "releases.json"))
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestFormatScopedLabel(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{name: "scoped label", in: "priority::high", want: "priority/high"},
+		{name: "unscoped label", in: "bug", want: "bug"},
+		{name: "multiple scopes", in: "team::a::b", want: "team/a/b"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, FormatScopedLabel(tc.in))
+		})
+	}
+}
+
+// readJSON reads and unmarshals the JSON file at path into v, failing the test on error.
+func readJSON(t *testing.T, path string, v any) {
+	t.Helper()
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(data, v))
+}
@@ -0,0 +1,97 @@
+// Package render formats a list tool's result for the model, so a single fetch from the app
+// layer can be returned as compact JSON, YAML, a fixed-column table, or a caller-supplied Go
+// template instead of always paying the token cost of a full JSON blob.
+package render
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"text/tabwriter"
+	"text/template"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Format selects how List renders its data.
+type Format string
+
+// Supported formats. JSON is the default, matching the pre-existing behavior of the list tools.
+const (
+	JSON     Format = "json"
+	YAML     Format = "yaml"
+	Table    Format = "table"
+	Template Format = "template"
+)
+
+// ErrUnknownFormat is returned when List is asked to render an unsupported format.
+var ErrUnknownFormat = errors.New("unknown render format")
+
+// ErrTemplateRequired is returned when Template format is selected without template source.
+var ErrTemplateRequired = errors.New("template format requires a non-empty template argument")
+
+// Row is a single fixed-column row rendered by the table format. Callers that list something
+// other than issues/merge requests (e.g. labels) map their fields onto the closest column.
+type Row struct {
+	IID      string
+	Title    string
+	State    string
+	Labels   string
+	Assignee string
+}
+
+// List renders data in the requested format. rows supplies the fixed columns used by the table
+// format; tmplSrc supplies the Go text/template source used by the template format. Both are
+// ignored by the other formats. An empty format defaults to JSON.
+func List(format Format, data any, rows []Row, tmplSrc string) (string, error) {
+	switch format {
+	case "", JSON:
+		b, err := json.Marshal(data)
+		if err != nil {
+			return "", fmt.Errorf("render json: %w", err)
+		}
+		return string(b), nil
+	case YAML:
+		b, err := yaml.Marshal(data)
+		if err != nil {
+			return "", fmt.Errorf("render yaml: %w", err)
+		}
+		return string(b), nil
+	case Table:
+		return table(rows), nil
+	case Template:
+		if tmplSrc == "" {
+			return "", ErrTemplateRequired
+		}
+		return renderTemplate(tmplSrc, data)
+	default:
+		return "", fmt.Errorf("%w: %q", ErrUnknownFormat, format)
+	}
+}
+
+// table renders rows as a fixed-width, tab-aligned table with a header row.
+func table(rows []Row) string {
+	var buf bytes.Buffer
+	w := tabwriter.NewWriter(&buf, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "IID\tTITLE\tSTATE\tLABELS\tASSIGNEE")
+	for _, r := range rows {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", r.IID, r.Title, r.State, r.Labels, r.Assignee)
+	}
+	w.Flush() //nolint:errcheck // tabwriter over a bytes.Buffer never fails to write.
+	return buf.String()
+}
+
+// renderTemplate executes tmplSrc against data, the same shape that would otherwise be
+// JSON/YAML-marshaled.
+func renderTemplate(tmplSrc string, data any) (string, error) {
+	tmpl, err := template.New("list").Parse(tmplSrc)
+	if err != nil {
+		return "", fmt.Errorf("parse template: %w", err)
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return "", fmt.Errorf("execute template: %w", err)
+	}
+	return buf.String(), nil
+}
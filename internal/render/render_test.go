@@ -0,0 +1,59 @@
+package render
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testItem struct {
+	Name string `json:"name" yaml:"name"`
+}
+
+// TestListJSON tests that an empty/default format renders compact JSON.
+func TestListJSON(t *testing.T) {
+	out, err := List(JSON, []testItem{{Name: "a"}}, nil, "")
+	require.NoError(t, err)
+	assert.Equal(t, `[{"name":"a"}]`, out)
+
+	out, err = List("", []testItem{{Name: "a"}}, nil, "")
+	require.NoError(t, err)
+	assert.Equal(t, `[{"name":"a"}]`, out)
+}
+
+// TestListYAML tests that YAML format renders the same data as YAML.
+func TestListYAML(t *testing.T) {
+	out, err := List(YAML, []testItem{{Name: "a"}}, nil, "")
+	require.NoError(t, err)
+	assert.Equal(t, "- name: a\n", out)
+}
+
+// TestListTable tests that table format renders a header and one row per item.
+func TestListTable(t *testing.T) {
+	out, err := List(Table, nil, []Row{
+		{IID: "1", Title: "fix bug", State: "opened", Labels: "bug", Assignee: "alice"},
+	}, "")
+	require.NoError(t, err)
+	assert.Contains(t, out, "IID  TITLE    STATE   LABELS  ASSIGNEE")
+	assert.Contains(t, out, "1    fix bug  opened  bug     alice")
+}
+
+// TestListTemplate tests that template format executes the supplied template against the data.
+func TestListTemplate(t *testing.T) {
+	out, err := List(Template, []testItem{{Name: "a"}, {Name: "b"}}, nil, `{{range .}}{{.Name}} {{end}}`)
+	require.NoError(t, err)
+	assert.Equal(t, "a b ", out)
+}
+
+// TestListTemplateRequiresSource tests that template format without a template string errors.
+func TestListTemplateRequiresSource(t *testing.T) {
+	_, err := List(Template, []testItem{}, nil, "")
+	require.ErrorIs(t, err, ErrTemplateRequired)
+}
+
+// TestListUnknownFormat tests that an unsupported format errors.
+func TestListUnknownFormat(t *testing.T) {
+	_, err := List(Format("xml"), []testItem{}, nil, "")
+	require.ErrorIs(t, err, ErrUnknownFormat)
+}
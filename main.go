@@ -1,19 +1,30 @@
 package main
 
 import (
+	"bytes"
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"flag"
 	"fmt"
 	"log"
 	"log/slog"
+	"net/http"
 	"os"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
 
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/sgaunet/gitlab-mcp/internal/app"
 	"github.com/sgaunet/gitlab-mcp/internal/logger"
+	"github.com/sgaunet/gitlab-mcp/internal/metrics"
+	"github.com/sgaunet/gitlab-mcp/internal/render"
 )
 
 // Version information injected at build time.
@@ -23,9 +34,27 @@ const (
 	defaultLimit = 100
 )
 
+// requestCounter generates process-unique request IDs stamped into each tool call's MDC so
+// operators can grep a single invocation across its nested GitLab API calls.
+var requestCounter int64
+
+// nextRequestID returns the next process-unique request ID.
+func nextRequestID() string {
+	return fmt.Sprintf("req-%d", atomic.AddInt64(&requestCounter, 1))
+}
+
+// mdcForTool returns a context carrying the MDC fields common to every tool invocation: a fresh
+// request_id and the MCP tool_name.
+func mdcForTool(ctx context.Context, toolName string) context.Context {
+	ctx = logger.MdcSet(ctx, "request_id", nextRequestID())
+	return logger.MdcSet(ctx, "tool_name", toolName)
+}
+
 // Error variables for static errors.
 var (
 	ErrInvalidStateValue = errors.New("state must be 'opened' or 'closed'")
+	ErrIssuesRequired     = errors.New("issues must be a non-empty array of {project_path, issue_iid} objects")
+	ErrUnknownTransport   = errors.New("transport must be 'stdio', 'sse', or 'http'")
 )
 
 // setupListIssuesTool creates and registers the list_issues tool.
@@ -45,16 +74,52 @@ func setupListIssuesTool(s *server.MCPServer, appInstance *app.App, debugLogger
 		mcp.WithNumber("limit",
 			mcp.Description("Maximum number of issues to return (default: 100, max: 100)"),
 		),
+		mcp.WithNumber("page",
+			mcp.Description("Page number to fetch, 1-indexed (default: 1); ignored when list_all is set"),
+		),
+		mcp.WithBoolean("include_group_issues",
+			mcp.Description(
+				"Also merge in issues from the project's ancestor group, deduplicated by issue ID "+
+					"(default: false)",
+			),
+		),
+		mcp.WithNumber("iteration_id",
+			mcp.Description("Filter by iteration ID"),
+		),
+		mcp.WithString("iteration_title",
+			mcp.Description(
+				"Filter by iteration title instead of ID, resolved against the project's ancestor "+
+					"group's iterations; ignored when iteration_id is set",
+			),
+		),
+		mcp.WithString("milestone_title",
+			mcp.Description("Filter by milestone title"),
+		),
+		mcp.WithBoolean("list_all",
+			mcp.Description(
+				"Walk every page instead of just the first, bounded by max_items (default: false)",
+			),
+		),
+		mcp.WithNumber("max_items",
+			mcp.Description("Item cap applied when list_all is set (default: a few hundred)"),
+		),
+		mcp.WithString("format",
+			mcp.Description("Output format: json (default), yaml, table, or template"),
+		),
+		mcp.WithString("template",
+			mcp.Description("Go text/template source, used when format is template"),
+		),
 	)
 
 	s.AddTool(listIssuesTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		ctx = mdcForTool(ctx, "list_issues")
 		args := request.GetArguments()
-		debugLogger.Debug("Received list_issues tool request", "args", args)
+		debugLogger.DebugContext(ctx, "Received list_issues tool request", "args", args)
 
 		// Extract project_path
 		projectPath, ok := args["project_path"].(string)
 		if !ok || projectPath == "" {
-			debugLogger.Error("project_path is not a valid string", "value", args["project_path"])
+			debugLogger.ErrorContext(ctx, "project_path is not a valid string", "value", args["project_path"])
 			return mcp.NewToolResultError("project_path must be a non-empty string"), nil
 		}
 
@@ -76,27 +141,189 @@ func setupListIssuesTool(s *server.MCPServer, appInstance *app.App, debugLogger
 			opts.Limit = int(limitFloat)
 		}
 
-		debugLogger.Debug("Processing list_issues request", "project_path", projectPath, "opts", opts)
+		if pageFloat, ok := args["page"].(float64); ok {
+			opts.Page = int64(pageFloat)
+		}
+
+		if includeGroupIssues, ok := args["include_group_issues"].(bool); ok {
+			opts.IncludeGroupIssues = includeGroupIssues
+		}
+
+		if iterationIDFloat, ok := args["iteration_id"].(float64); ok {
+			iterationID := int64(iterationIDFloat)
+			opts.IterationID = &iterationID
+		}
+
+		if iterationTitle, ok := args["iteration_title"].(string); ok && iterationTitle != "" {
+			opts.IterationTitle = &iterationTitle
+		}
+
+		if milestoneTitle, ok := args["milestone_title"].(string); ok && milestoneTitle != "" {
+			opts.MilestoneTitle = milestoneTitle
+		}
+
+		if listAll, ok := args["list_all"].(bool); ok {
+			opts.ListAll = listAll
+		}
+
+		if maxItemsFloat, ok := args["max_items"].(float64); ok {
+			opts.MaxItems = int(maxItemsFloat)
+		}
+
+		debugLogger.DebugContext(ctx, "Processing list_issues request", "project_path", projectPath, "opts", opts)
 
 		// Call the app method
 		issues, err := appInstance.ListProjectIssues(projectPath, opts)
 		if err != nil {
-			debugLogger.Error("Failed to list project issues", "error", err, "project_path", projectPath)
+			debugLogger.ErrorContext(ctx, "Failed to list project issues", "error", err, "project_path", projectPath)
 			return mcp.NewToolResultError(fmt.Sprintf("Failed to list project issues: %v", err)), nil
 		}
 
-		// Convert issues to JSON
-		jsonData, err := json.Marshal(issues)
+		// Render issues in the requested format
+		format, _ := args["format"].(string)
+		tmplSrc, _ := args["template"].(string)
+		meta := listMeta{NextPage: opts.NextPage, TotalItems: opts.TotalItems, Truncated: opts.Truncated}
+		rendered, err := renderPaginatedListResult(format, tmplSrc, issues, meta, issuesToRows(issues))
 		if err != nil {
-			debugLogger.Error("Failed to marshal issues to JSON", "error", err)
-			return mcp.NewToolResultError("Failed to format issues response"), nil
+			debugLogger.ErrorContext(ctx, "Failed to render issues", "error", err, "format", format)
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to format issues response: %v", err)), nil
 		}
 
-		debugLogger.Info("Successfully retrieved project issues", "count", len(issues), "project_path", projectPath)
-		return mcp.NewToolResultText(string(jsonData)), nil
+		debugLogger.InfoContext(ctx, "Successfully retrieved project issues", "count", len(issues), "project_path", projectPath)
+		return mcp.NewToolResultText(rendered), nil
+	})
+}
+
+// marshalListResult marshals a list tool's items, wrapping them with a truncation marker when
+// the underlying fetch stopped early due to a list_all item/byte cap.
+func marshalListResult(items any, truncated bool) ([]byte, error) {
+	if !truncated {
+		return json.Marshal(items)
+	}
+	return json.Marshal(map[string]any{
+		"items":     items,
+		"truncated": true,
+		"note":      "Result truncated by the list_all cap; narrow your query (e.g. add filters) to see more.",
 	})
 }
 
+// renderListResult renders a list tool's items in the requested format. json (the default)
+// reuses marshalListResult so its output is byte-for-byte unchanged from before the format
+// argument existed; yaml/table/template see the same truncation-wrapped shape. rows supplies the
+// fixed columns used by the table format and is ignored by the others.
+func renderListResult(format, tmplSrc string, items any, truncated bool, rows []render.Row) (string, error) {
+	rf := render.Format(format)
+	if rf == render.JSON || rf == "" {
+		b, err := marshalListResult(items, truncated)
+		if err != nil {
+			return "", err
+		}
+		return string(b), nil
+	}
+
+	data := items
+	if truncated {
+		data = map[string]any{
+			"items":     items,
+			"truncated": true,
+			"note":      "Result truncated by the list_all cap; narrow your query (e.g. add filters) to see more.",
+		}
+	}
+	return render.List(rf, data, rows, tmplSrc)
+}
+
+// listMeta carries the output-side pagination/truncation fields shared by ListIssuesOptions and
+// ListLabelsOptions, so renderPaginatedListResult can build one wrapper shape for both.
+type listMeta struct {
+	NextPage   int64
+	TotalItems int64
+	Truncated  bool
+}
+
+// renderPaginatedListResult renders a list tool's items alongside pagination metadata (next_page,
+// total) derived from GitLab's response headers, always wrapped as {items, next_page, total} so a
+// caller paging through a large project doesn't have to guess whether more results exist. Fields
+// that GitLab didn't report (e.g. total on a keyset-paginated endpoint) are omitted rather than
+// sent as zero.
+func renderPaginatedListResult(
+	format, tmplSrc string, items any, meta listMeta, rows []render.Row,
+) (string, error) {
+	data := map[string]any{"items": items}
+	if meta.NextPage > 0 {
+		data["next_page"] = meta.NextPage
+	}
+	if meta.TotalItems > 0 {
+		data["total"] = meta.TotalItems
+	}
+	if meta.Truncated {
+		data["truncated"] = true
+		data["note"] = "Result truncated by the list_all cap; narrow your query (e.g. add filters) to see more."
+	}
+
+	rf := render.Format(format)
+	if rf == render.JSON || rf == "" {
+		b, err := json.Marshal(data)
+		if err != nil {
+			return "", fmt.Errorf("render json: %w", err)
+		}
+		return string(b), nil
+	}
+	return render.List(rf, data, rows, tmplSrc)
+}
+
+// firstAssigneeUsername returns the username of the first assignee, or "" if there is none.
+func firstAssigneeUsername(assignees []map[string]any) string {
+	if len(assignees) == 0 {
+		return ""
+	}
+	username, _ := assignees[0]["username"].(string)
+	return username
+}
+
+// issuesToRows maps issues onto the table format's fixed columns.
+func issuesToRows(issues []app.Issue) []render.Row {
+	rows := make([]render.Row, 0, len(issues))
+	for _, issue := range issues {
+		rows = append(rows, render.Row{
+			IID:      fmt.Sprintf("%d", issue.IID),
+			Title:    issue.Title,
+			State:    issue.State,
+			Labels:   strings.Join(issue.Labels, ","),
+			Assignee: firstAssigneeUsername(issue.Assignees),
+		})
+	}
+	return rows
+}
+
+// labelsToRows maps labels onto the table format's fixed columns. Labels have no iid/assignee,
+// so color and description fill the closest columns instead.
+func labelsToRows(labels []app.Label) []render.Row {
+	rows := make([]render.Row, 0, len(labels))
+	for _, label := range labels {
+		rows = append(rows, render.Row{
+			Title:  label.Name,
+			State:  label.Color,
+			Labels: label.Description,
+		})
+	}
+	return rows
+}
+
+// mergeRequestsToRows maps merge requests onto the table format's fixed columns.
+func mergeRequestsToRows(mrs []app.MergeRequest) []render.Row {
+	rows := make([]render.Row, 0, len(mrs))
+	for _, mr := range mrs {
+		rows = append(rows, render.Row{
+			IID:      fmt.Sprintf("%d", mr.IID),
+			Title:    mr.Title,
+			State:    mr.State,
+			Labels:   strings.Join(mr.Labels, ","),
+			Assignee: firstAssigneeUsername(mr.Assignees),
+		})
+	}
+	return rows
+}
+
 // setupCreateIssueTool creates and registers the create_issues tool.
 func setupCreateIssueTool(s *server.MCPServer, appInstance *app.App, debugLogger *slog.Logger) {
 	createIssueTool := mcp.NewTool("create_issues",
@@ -118,6 +345,9 @@ func setupCreateIssueTool(s *server.MCPServer, appInstance *app.App, debugLogger
 		mcp.WithArray("assignees",
 			mcp.Description("Array of user IDs to assign to the issue"),
 		),
+		mcp.WithString("milestone",
+			mcp.Description("Milestone to assign, as either a title or a numeric ID"),
+		),
 	)
 
 	s.AddTool(createIssueTool, handleCreateIssueRequest(appInstance, debugLogger))
@@ -129,46 +359,47 @@ func handleCreateIssueRequest(
 	debugLogger *slog.Logger,
 ) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		ctx = mdcForTool(ctx, "create_issues")
 		args := request.GetArguments()
-		debugLogger.Debug("Received create_issues tool request", "args", args)
+		debugLogger.DebugContext(ctx, "Received create_issues tool request", "args", args)
 
 		// Extract project_path
 		projectPath, ok := args["project_path"].(string)
 		if !ok || projectPath == "" {
-			debugLogger.Error("project_path is not a valid string", "value", args["project_path"])
+			debugLogger.ErrorContext(ctx, "project_path is not a valid string", "value", args["project_path"])
 			return mcp.NewToolResultError("project_path must be a non-empty string"), nil
 		}
 
 		// Extract title (required)
 		title, ok := args["title"].(string)
 		if !ok || title == "" {
-			debugLogger.Error("title is missing or not a string", "value", args["title"])
+			debugLogger.ErrorContext(ctx, "title is missing or not a string", "value", args["title"])
 			return mcp.NewToolResultError("title must be a non-empty string"), nil
 		}
 
 		// Extract options
 		opts := extractCreateIssueOptions(args, title)
 
-		debugLogger.Debug("Processing create_issues request", "project_path", projectPath, "title", title)
+		debugLogger.DebugContext(ctx, "Processing create_issues request", "project_path", projectPath, "title", title)
 
 		// Call the app method
 		issue, err := appInstance.CreateProjectIssue(projectPath, opts)
 		if err != nil {
-			debugLogger.Error("Failed to create issue", "error", err, "project_path", projectPath, "title", title)
+			debugLogger.ErrorContext(ctx, "Failed to create issue", "error", err, "project_path", projectPath, "title", title)
 			return mcp.NewToolResultError(fmt.Sprintf("Failed to create issue: %v", err)), nil
 		}
 
 		// Convert issue to JSON
 		jsonData, err := json.Marshal(issue)
 		if err != nil {
-			debugLogger.Error("Failed to marshal issue to JSON", "error", err)
+			debugLogger.ErrorContext(ctx, "Failed to marshal issue to JSON", "error", err)
 			return mcp.NewToolResultError("Failed to format issue response"), nil
 		}
 
-		debugLogger.Info("Successfully created issue", 
-			"id", issue.ID, 
-			"iid", issue.IID, 
-			"project_path", projectPath, 
+		debugLogger.InfoContext(ctx, "Successfully created issue",
+			"id", issue.ID,
+			"iid", issue.IID,
+			"project_path", projectPath,
 			"title", issue.Title)
 		return mcp.NewToolResultText(string(jsonData)), nil
 	}
@@ -207,6 +438,13 @@ func extractCreateIssueOptions(args map[string]interface{}, title string) *app.C
 		opts.Assignees = assignees
 	}
 
+	// Extract optional milestone (title or numeric ID)
+	if milestone, ok := args["milestone"].(string); ok && milestone != "" {
+		opts.Milestone = milestone
+	} else if milestoneFloat, ok := args["milestone"].(float64); ok {
+		opts.Milestone = milestoneFloat
+	}
+
 	return opts
 }
 
@@ -215,12 +453,16 @@ func setupUpdateIssueTool(s *server.MCPServer, appInstance *app.App, debugLogger
 	updateIssueTool := mcp.NewTool("update_issues",
 		mcp.WithDescription("Update an existing issue for a GitLab project by project path"),
 		mcp.WithString("project_path",
-			mcp.Required(),
-			mcp.Description("GitLab project path (e.g., 'namespace/project-name')"),
+			mcp.Description("GitLab project path (e.g., 'namespace/project-name'). Required unless issue is set."),
 		),
 		mcp.WithNumber("issue_iid",
-			mcp.Required(),
-			mcp.Description("Issue internal ID (IID) to update"),
+			mcp.Description("Issue internal ID (IID) to update. Required unless issue is set."),
+		),
+		mcp.WithString("issue",
+			mcp.Description(
+				"Issue reference as a full URL (https://gitlab.com/namespace/project/-/issues/42) or "+
+					"short form (namespace/project#42). Alternative to project_path + issue_iid.",
+			),
 		),
 		mcp.WithString("title",
 			mcp.Description("Updated issue title"),
@@ -232,82 +474,118 @@ func setupUpdateIssueTool(s *server.MCPServer, appInstance *app.App, debugLogger
 			mcp.Description("Issue state: 'opened' or 'closed'"),
 		),
 		mcp.WithArray("labels",
-			mcp.Description("Array of labels to assign to the issue"),
+			mcp.Description(
+				"Labels to assign. Each entry is either a bare name (replaces all labels) or "+
+					"+name/-name to add/remove a single label without touching the rest",
+			),
 		),
 		mcp.WithArray("assignees",
-			mcp.Description("Array of user IDs to assign to the issue"),
+			mcp.Description(
+				"Assignee usernames. Each entry is either a bare username (replaces all assignees) or "+
+					"+username/-username to add/remove a single assignee without touching the rest",
+			),
+		),
+		mcp.WithString("milestone",
+			mcp.Description("Milestone to assign, as either a title or a numeric ID"),
 		),
 	)
 
 	s.AddTool(updateIssueTool, handleUpdateIssueRequest(appInstance, debugLogger))
 }
 
+// errIssueRefRequired is returned when a tool accepting an alternative "issue" reference argument
+// receives neither that nor a valid project_path + issue_iid pair.
+var errIssueRefRequired = errors.New("either issue, or both project_path and issue_iid, must be provided")
+
+// resolveIssueArgs extracts (projectPath, issueIID) from a tool's arguments, accepting either an
+// "issue" string (parsed via app.ParseIssueRef) or an explicit project_path + issue_iid pair.
+func resolveIssueArgs(args map[string]interface{}) (string, int64, error) {
+	if issueRef, ok := args["issue"].(string); ok && issueRef != "" {
+		return app.ParseIssueRef(issueRef)
+	}
+
+	projectPath, ok := args["project_path"].(string)
+	if !ok || projectPath == "" {
+		return "", 0, errIssueRefRequired
+	}
+
+	issueIIDFloat, ok := args["issue_iid"].(float64)
+	if !ok {
+		return "", 0, errIssueRefRequired
+	}
+
+	return projectPath, int64(issueIIDFloat), nil
+}
+
 // handleUpdateIssueRequest handles the update_issues tool request.
 func handleUpdateIssueRequest(
-	appInstance *app.App, 
+	appInstance *app.App,
 	debugLogger *slog.Logger,
 ) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		ctx = mdcForTool(ctx, "update_issues")
 		args := request.GetArguments()
-		debugLogger.Debug("Received update_issues tool request", "args", args)
-
-		// Extract project_path
-		projectPath, ok := args["project_path"].(string)
-		if !ok || projectPath == "" {
-			debugLogger.Error("project_path is not a valid string", "value", args["project_path"])
-			return mcp.NewToolResultError("project_path must be a non-empty string"), nil
-		}
+		debugLogger.DebugContext(ctx, "Received update_issues tool request", "args", args)
 
-		// Extract issue_iid (required)
-		issueIIDFloat, ok := args["issue_iid"].(float64)
-		if !ok {
-			debugLogger.Error("issue_iid is missing or not a number", "value", args["issue_iid"])
-			return mcp.NewToolResultError("issue_iid must be a number"), nil
+		projectPath, issueIID64, err := resolveIssueArgs(args)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to resolve issue reference", "error", err, "args", args)
+			return mcp.NewToolResultError(err.Error()), nil
 		}
-		issueIID := int(issueIIDFloat)
+		issueIID := int(issueIID64)
+		ctx = logger.MdcSet(ctx, "issue_iid", issueIID)
 
 		// Extract options
-		opts, err := extractUpdateIssueOptions(args, debugLogger)
+		opts, err := extractUpdateIssueOptions(ctx, args, debugLogger)
 		if err != nil {
 			return mcp.NewToolResultError(err.Error()), nil
 		}
 
-		debugLogger.Debug("Processing update_issues request", "project_path", projectPath, "issue_iid", issueIID)
+		debugLogger.DebugContext(ctx, "Processing update_issues request", "project_path", projectPath, "issue_iid", issueIID)
 
 		// Call the app method
 		issue, err := appInstance.UpdateProjectIssue(projectPath, issueIID, opts)
 		if err != nil {
-			debugLogger.Error("Failed to update issue", "error", err, "project_path", projectPath, "issue_iid", issueIID)
+			debugLogger.ErrorContext(ctx, "Failed to update issue", "error", err, "project_path", projectPath, "issue_iid", issueIID)
 			return mcp.NewToolResultError(fmt.Sprintf("Failed to update issue: %v", err)), nil
 		}
 
 		// Convert issue to JSON
 		jsonData, err := json.Marshal(issue)
 		if err != nil {
-			debugLogger.Error("Failed to marshal issue to JSON", "error", err)
+			debugLogger.ErrorContext(ctx, "Failed to marshal issue to JSON", "error", err)
 			return mcp.NewToolResultError("Failed to format issue response"), nil
 		}
 
-		debugLogger.Info("Successfully updated issue", "id", issue.ID, "iid", issue.IID, "project_path", projectPath)
+		debugLogger.InfoContext(ctx, "Successfully updated issue", "id", issue.ID, "iid", issue.IID, "project_path", projectPath)
 		return mcp.NewToolResultText(string(jsonData)), nil
 	}
 }
 
 // extractUpdateIssueOptions extracts update issue options from arguments.
-func extractUpdateIssueOptions(args map[string]interface{}, debugLogger *slog.Logger) (*app.UpdateIssueOptions, error) {
+func extractUpdateIssueOptions(
+	ctx context.Context, args map[string]interface{}, debugLogger *slog.Logger,
+) (*app.UpdateIssueOptions, error) {
 	opts := &app.UpdateIssueOptions{}
 
 	// Extract basic string fields
 	extractUpdateStringFields(args, opts)
 
 	// Extract and validate state
-	if err := extractUpdateState(args, opts, debugLogger); err != nil {
+	if err := extractUpdateState(ctx, args, opts, debugLogger); err != nil {
 		return nil, err
 	}
 
 	// Extract array fields
 	extractUpdateArrayFields(args, opts)
 
+	// Extract optional milestone (title or numeric ID)
+	if milestone, ok := args["milestone"].(string); ok && milestone != "" {
+		opts.Milestone = milestone
+	} else if milestoneFloat, ok := args["milestone"].(float64); ok {
+		opts.Milestone = milestoneFloat
+	}
+
 	return opts, nil
 }
 
@@ -323,10 +601,12 @@ func extractUpdateStringFields(args map[string]interface{}, opts *app.UpdateIssu
 }
 
 // extractUpdateState extracts and validates the state field.
-func extractUpdateState(args map[string]interface{}, opts *app.UpdateIssueOptions, debugLogger *slog.Logger) error {
+func extractUpdateState(
+	ctx context.Context, args map[string]interface{}, opts *app.UpdateIssueOptions, debugLogger *slog.Logger,
+) error {
 	if state, ok := args["state"].(string); ok && state != "" {
 		if state != "opened" && state != "closed" {
-			debugLogger.Error("invalid state value", "state", state)
+			debugLogger.ErrorContext(ctx, "invalid state value", "state", state)
 			return ErrInvalidStateValue
 		}
 		opts.State = state
@@ -347,12 +627,13 @@ func extractUpdateArrayFields(args map[string]interface{}, opts *app.UpdateIssue
 		opts.Labels = labels
 	}
 
-	// Extract optional assignees
+	// Extract optional assignees (usernames, each optionally prefixed with +/-/! to diff
+	// against the issue's existing assignees instead of replacing them outright)
 	if assigneesInterface, ok := args["assignees"].([]interface{}); ok {
-		assignees := make([]int, 0, len(assigneesInterface))
+		assignees := make([]string, 0, len(assigneesInterface))
 		for _, assignee := range assigneesInterface {
-			if assigneeFloat, ok := assignee.(float64); ok {
-				assignees = append(assignees, int(assigneeFloat))
+			if assigneeStr, ok := assignee.(string); ok {
+				assignees = append(assignees, assigneeStr)
 			}
 		}
 		opts.Assignees = assignees
@@ -379,6 +660,23 @@ func setupListLabelsTool(s *server.MCPServer, appInstance *app.App, debugLogger
 		mcp.WithNumber("limit",
 			mcp.Description("Maximum number of labels to return (default: 100, max: 100)"),
 		),
+		mcp.WithNumber("page",
+			mcp.Description("Page number to fetch, 1-indexed (default: 1); ignored when list_all is set"),
+		),
+		mcp.WithBoolean("list_all",
+			mcp.Description(
+				"Walk every page instead of just the first, bounded by max_items (default: false)",
+			),
+		),
+		mcp.WithNumber("max_items",
+			mcp.Description("Item cap applied when list_all is set (default: a few hundred)"),
+		),
+		mcp.WithString("format",
+			mcp.Description("Output format: json (default), yaml, table, or template"),
+		),
+		mcp.WithString("template",
+			mcp.Description("Go text/template source, used when format is template"),
+		),
 	)
 
 	s.AddTool(listLabelsTool, handleListLabelsRequest(appInstance, debugLogger))
@@ -390,37 +688,41 @@ func handleListLabelsRequest(
 	debugLogger *slog.Logger,
 ) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		ctx = mdcForTool(ctx, "list_labels")
 		args := request.GetArguments()
-		debugLogger.Debug("Received list_labels tool request", "args", args)
+		debugLogger.DebugContext(ctx, "Received list_labels tool request", "args", args)
 
 		// Extract project_path
 		projectPath, ok := args["project_path"].(string)
 		if !ok || projectPath == "" {
-			debugLogger.Error("project_path is not a valid string", "value", args["project_path"])
+			debugLogger.ErrorContext(ctx, "project_path is not a valid string", "value", args["project_path"])
 			return mcp.NewToolResultError("project_path must be a non-empty string"), nil
 		}
 
 		// Extract optional parameters
 		opts := extractListLabelsOptions(args)
 
-		debugLogger.Debug("Processing list_labels request", "project_path", projectPath, "opts", opts)
+		debugLogger.DebugContext(ctx, "Processing list_labels request", "project_path", projectPath, "opts", opts)
 
 		// Call the app method
 		labels, err := appInstance.ListProjectLabels(projectPath, opts)
 		if err != nil {
-			debugLogger.Error("Failed to list project labels", "error", err, "project_path", projectPath)
+			debugLogger.ErrorContext(ctx, "Failed to list project labels", "error", err, "project_path", projectPath)
 			return mcp.NewToolResultError(fmt.Sprintf("Failed to list project labels: %v", err)), nil
 		}
 
-		// Convert labels to JSON
-		jsonData, err := json.Marshal(labels)
+		// Render labels in the requested format
+		format, _ := args["format"].(string)
+		tmplSrc, _ := args["template"].(string)
+		meta := listMeta{NextPage: opts.NextPage, TotalItems: opts.TotalItems, Truncated: opts.Truncated}
+		rendered, err := renderPaginatedListResult(format, tmplSrc, labels, meta, labelsToRows(labels))
 		if err != nil {
-			debugLogger.Error("Failed to marshal labels to JSON", "error", err)
-			return mcp.NewToolResultError("Failed to format labels response"), nil
+			debugLogger.ErrorContext(ctx, "Failed to render labels", "error", err, "format", format)
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to format labels response: %v", err)), nil
 		}
 
-		debugLogger.Info("Successfully retrieved project labels", "count", len(labels), "project_path", projectPath)
-		return mcp.NewToolResultText(string(jsonData)), nil
+		debugLogger.InfoContext(ctx, "Successfully retrieved project labels", "count", len(labels), "project_path", projectPath)
+		return mcp.NewToolResultText(rendered), nil
 	}
 }
 
@@ -448,112 +750,5636 @@ func extractListLabelsOptions(args map[string]interface{}) *app.ListLabelsOption
 		opts.Limit = int(limitFloat)
 	}
 
+	if pageFloat, ok := args["page"].(float64); ok {
+		opts.Page = int64(pageFloat)
+	}
+
+	if listAll, ok := args["list_all"].(bool); ok {
+		opts.ListAll = listAll
+	}
+
+	if maxItemsFloat, ok := args["max_items"].(float64); ok {
+		opts.MaxItems = int(maxItemsFloat)
+	}
+
 	return opts
 }
 
-func printHelp() {
-	fmt.Printf(`GitLab MCP Server %s
-
-A Model Context Protocol (MCP) server that provides GitLab integration tools for Claude Code.
+// setupListEpicBoardsTool creates and registers the list_epic_boards tool.
+func setupListEpicBoardsTool(s *server.MCPServer, appInstance *app.App, debugLogger *slog.Logger) {
+	listEpicBoardsTool := mcp.NewTool("list_epic_boards",
+		mcp.WithDescription("List epic boards for a GitLab group by group path"),
+		mcp.WithString("group_path",
+			mcp.Required(),
+			mcp.Description("GitLab group path (e.g., 'namespace/group-name')"),
+		),
+	)
 
-USAGE:
-    gitlab-mcp [OPTIONS]
+	s.AddTool(listEpicBoardsTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		ctx = mdcForTool(ctx, "list_epic_boards")
+		args := request.GetArguments()
+		debugLogger.DebugContext(ctx, "Received list_epic_boards tool request", "args", args)
 
-OPTIONS:
-    -h, --help     Show this help message
-    -v, --version  Show version information
+		groupPath, ok := args["group_path"].(string)
+		if !ok || groupPath == "" {
+			debugLogger.ErrorContext(ctx, "group_path is not a valid string", "value", args["group_path"])
+			return mcp.NewToolResultError("group_path must be a non-empty string"), nil
+		}
 
-ENVIRONMENT VARIABLES:
-    GITLAB_TOKEN   GitLab API personal access token (required)
-    GITLAB_URI     GitLab instance URI (default: https://gitlab.com/)
+		debugLogger.DebugContext(ctx, "Processing list_epic_boards request", "group_path", groupPath)
 
-DESCRIPTION:
-    This MCP server provides the following tools for GitLab integration:
-    
-    • list_issues     - List issues for a GitLab project
-    • create_issues   - Create new issues with metadata
-    • update_issues   - Update existing issues
-    • list_labels     - List project labels with filtering
-    
-    The server communicates via JSON-RPC 2.0 over stdin/stdout and is designed
-    to be used with Claude Code's MCP architecture.
+		boards, err := appInstance.ListGroupEpicBoards(groupPath)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to list group epic boards", "error", err, "group_path", groupPath)
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to list group epic boards: %v", err)), nil
+		}
 
-EXAMPLES:
-    # Start the MCP server (typically called by Claude Code)
-    gitlab-mcp
-    
-    # Show help
-    gitlab-mcp -h
-    
-    # Show version
-    gitlab-mcp -v
+		jsonData, err := json.Marshal(boards)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to marshal epic boards to JSON", "error", err)
+			return mcp.NewToolResultError("Failed to format epic boards response"), nil
+		}
 
-For more information, visit: https://github.com/sgaunet/gitlab-mcp
-`, version)
+		debugLogger.InfoContext(ctx, "Successfully retrieved group epic boards", "count", len(boards), "group_path", groupPath)
+		return mcp.NewToolResultText(string(jsonData)), nil
+	})
 }
 
-func main() {
-	// Parse command line flags
-	var (
-		showHelp    = flag.Bool("h", false, "Show help message")
-		showHelpLong = flag.Bool("help", false, "Show help message")
-		showVersion = flag.Bool("v", false, "Show version information") 
-		showVersionLong = flag.Bool("version", false, "Show version information")
+// setupGetEpicBoardTool creates and registers the get_epic_board tool.
+func setupGetEpicBoardTool(s *server.MCPServer, appInstance *app.App, debugLogger *slog.Logger) {
+	getEpicBoardTool := mcp.NewTool("get_epic_board",
+		mcp.WithDescription("Get a single epic board, with its list columns and per-list epics"),
+		mcp.WithString("group_path",
+			mcp.Required(),
+			mcp.Description("GitLab group path (e.g., 'namespace/group-name')"),
+		),
+		mcp.WithNumber("board_id",
+			mcp.Required(),
+			mcp.Description("Epic board ID"),
+		),
 	)
-	
-	flag.Parse()
-	
-	// Handle help flags
-	if *showHelp || *showHelpLong {
-		printHelp()
-		os.Exit(0)
-	}
-	
-	// Handle version flags
-	if *showVersion || *showVersionLong {
-		fmt.Printf("%s\n", version)
-		os.Exit(0)
-	}
 
-	// Initialize the app
-	appInstance, err := app.New()
-	if err != nil {
-		log.Fatalf("Failed to initialize app: %v", err)
-	}
+	s.AddTool(getEpicBoardTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		ctx = mdcForTool(ctx, "get_epic_board")
+		args := request.GetArguments()
+		debugLogger.DebugContext(ctx, "Received get_epic_board tool request", "args", args)
 
-	// Set debug logger
-	debugLogger := logger.NewLogger("debug")
-	appInstance.SetLogger(debugLogger)
-	
-	debugLogger.Info("Starting GitLab MCP Server", "version", version)
+		groupPath, ok := args["group_path"].(string)
+		if !ok || groupPath == "" {
+			debugLogger.ErrorContext(ctx, "group_path is not a valid string", "value", args["group_path"])
+			return mcp.NewToolResultError("group_path must be a non-empty string"), nil
+		}
 
-	// Validate connection
-	if err := appInstance.ValidateConnection(); err != nil {
-		log.Fatalf("Failed to validate GitLab connection: %v", err)
-	}
+		boardIDFloat, ok := args["board_id"].(float64)
+		if !ok {
+			debugLogger.ErrorContext(ctx, "board_id is missing or not a number", "value", args["board_id"])
+			return mcp.NewToolResultError("board_id must be a number"), nil
+		}
+		boardID := int64(boardIDFloat)
 
-	// Create MCP server
-	s := server.NewMCPServer(
-		"GitLab MCP Server",
-		version,
-		server.WithToolCapabilities(true),
-		server.WithResourceCapabilities(true, false),
+		debugLogger.DebugContext(ctx, "Processing get_epic_board request", "group_path", groupPath, "board_id", boardID)
+
+		board, err := appInstance.GetGroupEpicBoard(groupPath, boardID)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to get group epic board", "error", err, "group_path", groupPath, "board_id", boardID)
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to get group epic board: %v", err)), nil
+		}
+
+		jsonData, err := json.Marshal(board)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to marshal epic board to JSON", "error", err)
+			return mcp.NewToolResultError("Failed to format epic board response"), nil
+		}
+
+		debugLogger.InfoContext(ctx, "Successfully retrieved group epic board", "board_id", boardID, "group_path", groupPath)
+		return mcp.NewToolResultText(string(jsonData)), nil
+	})
+}
+
+// setupFilterByIterationTool creates and registers the filter_by_iteration tool.
+func setupFilterByIterationTool(s *server.MCPServer, appInstance *app.App, debugLogger *slog.Logger) {
+	filterByIterationTool := mcp.NewTool("filter_by_iteration",
+		mcp.WithDescription("List issues for a GitLab project that belong to a specific iteration"),
+		mcp.WithString("project_path",
+			mcp.Required(),
+			mcp.Description("GitLab project path (e.g., 'namespace/project-name')"),
+		),
+		mcp.WithNumber("iteration_id",
+			mcp.Required(),
+			mcp.Description("Iteration ID to filter issues by"),
+		),
+		mcp.WithString("state",
+			mcp.Description("Filter by issue state: opened, closed, or all (default: opened)"),
+		),
 	)
 
-	// Create and register list_issues tool
-	setupListIssuesTool(s, appInstance, debugLogger)
+	s.AddTool(filterByIterationTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		ctx = mdcForTool(ctx, "filter_by_iteration")
+		args := request.GetArguments()
+		debugLogger.DebugContext(ctx, "Received filter_by_iteration tool request", "args", args)
 
-	// Create and register create_issues tool
-	setupCreateIssueTool(s, appInstance, debugLogger)
+		projectPath, ok := args["project_path"].(string)
+		if !ok || projectPath == "" {
+			debugLogger.ErrorContext(ctx, "project_path is not a valid string", "value", args["project_path"])
+			return mcp.NewToolResultError("project_path must be a non-empty string"), nil
+		}
 
-	// Create and register update_issues tool
-	setupUpdateIssueTool(s, appInstance, debugLogger)
+		iterationIDFloat, ok := args["iteration_id"].(float64)
+		if !ok {
+			debugLogger.ErrorContext(ctx, "iteration_id is missing or not a number", "value", args["iteration_id"])
+			return mcp.NewToolResultError("iteration_id must be a number"), nil
+		}
+		iterationID := int64(iterationIDFloat)
 
-	// Create and register list_labels tool
-	setupListLabelsTool(s, appInstance, debugLogger)
+		opts := &app.ListIssuesOptions{
+			State:       "opened", // default
+			Limit:       defaultLimit,
+			IterationID: &iterationID,
+		}
+
+		if state, ok := args["state"].(string); ok && state != "" {
+			opts.State = state
+		}
+
+		debugLogger.DebugContext(ctx, "Processing filter_by_iteration request", "project_path", projectPath, "iteration_id", iterationID)
+
+		issues, err := appInstance.ListProjectIssues(projectPath, opts)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to filter issues by iteration", "error", err, "project_path", projectPath)
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to filter issues by iteration: %v", err)), nil
+		}
+
+		jsonData, err := json.Marshal(issues)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to marshal issues to JSON", "error", err)
+			return mcp.NewToolResultError("Failed to format issues response"), nil
+		}
+
+		debugLogger.InfoContext(ctx, "Successfully filtered issues by iteration", "count", len(issues), "project_path", projectPath)
+		return mcp.NewToolResultText(string(jsonData)), nil
+	})
+}
+
+// setupAddIssuesToEpicTool creates and registers the add_issues_to_epic tool.
+func setupAddIssuesToEpicTool(s *server.MCPServer, appInstance *app.App, debugLogger *slog.Logger) {
+	addIssuesToEpicTool := mcp.NewTool("add_issues_to_epic",
+		mcp.WithDescription("Assign many issues to a single epic in one call, reporting per-issue successes and failures"),
+		mcp.WithString("group_path",
+			mcp.Required(),
+			mcp.Description("GitLab group path that owns the epic (e.g., 'namespace/group-name')"),
+		),
+		mcp.WithNumber("epic_iid",
+			mcp.Required(),
+			mcp.Description("Epic internal ID (IID) to assign issues to"),
+		),
+		mcp.WithArray("issues",
+			mcp.Required(),
+			mcp.Description("Array of {project_path, issue_iid} objects identifying the issues to assign"),
+		),
+		mcp.WithNumber("concurrency",
+			mcp.Description("Number of concurrent workers to use (default: 4)"),
+		),
+	)
+
+	s.AddTool(addIssuesToEpicTool, handleAddIssuesToEpicRequest(appInstance, debugLogger))
+}
+
+// handleAddIssuesToEpicRequest handles the add_issues_to_epic tool request.
+func handleAddIssuesToEpicRequest(
+	appInstance *app.App,
+	debugLogger *slog.Logger,
+) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		ctx = mdcForTool(ctx, "add_issues_to_epic")
+		args := request.GetArguments()
+		debugLogger.DebugContext(ctx, "Received add_issues_to_epic tool request", "args", args)
+
+		groupPath, ok := args["group_path"].(string)
+		if !ok || groupPath == "" {
+			debugLogger.ErrorContext(ctx, "group_path is not a valid string", "value", args["group_path"])
+			return mcp.NewToolResultError("group_path must be a non-empty string"), nil
+		}
+
+		epicIIDFloat, ok := args["epic_iid"].(float64)
+		if !ok {
+			debugLogger.ErrorContext(ctx, "epic_iid is missing or not a number", "value", args["epic_iid"])
+			return mcp.NewToolResultError("epic_iid must be a number"), nil
+		}
+		ctx = logger.MdcSet(ctx, "epic_iid", int64(epicIIDFloat))
+
+		issues, err := extractBulkIssueRefs(args)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		opts := &app.BulkAddIssuesToEpicOptions{
+			GroupPath: groupPath,
+			EpicIID:   int64(epicIIDFloat),
+			Issues:    issues,
+		}
+		if concurrencyFloat, ok := args["concurrency"].(float64); ok {
+			opts.Concurrency = int(concurrencyFloat)
+		}
+
+		debugLogger.DebugContext(ctx, "Processing add_issues_to_epic request", "group_path", groupPath, "epic_iid", opts.EpicIID)
+
+		result, err := appInstance.AddIssuesToEpic(opts)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to bulk assign issues to epic", "error", err, "group_path", groupPath)
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to bulk assign issues to epic: %v", err)), nil
+		}
+
+		jsonData, err := json.Marshal(result)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to marshal bulk assign result to JSON", "error", err)
+			return mcp.NewToolResultError("Failed to format bulk assign response"), nil
+		}
+
+		debugLogger.InfoContext(ctx, "Successfully processed bulk assign to epic",
+			"succeeded", len(result.Successes), "failed", len(result.Failures), "group_path", groupPath)
+		return mcp.NewToolResultText(string(jsonData)), nil
+	}
+}
+
+// extractBulkIssueRefs extracts the issue list for the add_issues_to_epic tool from arguments.
+func extractBulkIssueRefs(args map[string]interface{}) ([]app.BulkIssueRef, error) {
+	issuesInterface, ok := args["issues"].([]interface{})
+	if !ok || len(issuesInterface) == 0 {
+		return nil, ErrIssuesRequired
+	}
+
+	issues := make([]app.BulkIssueRef, 0, len(issuesInterface))
+	for _, item := range issuesInterface {
+		itemMap, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		projectPath, _ := itemMap["project_path"].(string)
+		issueIIDFloat, _ := itemMap["issue_iid"].(float64)
+		if projectPath == "" || issueIIDFloat == 0 {
+			continue
+		}
+
+		issues = append(issues, app.BulkIssueRef{
+			ProjectPath: projectPath,
+			IssueIID:    int64(issueIIDFloat),
+		})
+	}
+
+	return issues, nil
+}
+
+// setupUpdateProjectsDescriptionTool creates and registers the update_projects_description tool.
+func setupUpdateProjectsDescriptionTool(s *server.MCPServer, appInstance *app.App, debugLogger *slog.Logger) {
+	updateProjectsDescriptionTool := mcp.NewTool("update_projects_description",
+		mcp.WithDescription("Set the same description on many projects in one call, reporting per-project successes and failures"),
+		mcp.WithArray("project_paths",
+			mcp.Required(),
+			mcp.Description("Array of project paths (e.g., 'namespace/project') to update"),
+		),
+		mcp.WithString("description",
+			mcp.Required(),
+			mcp.Description("Description to set on every listed project"),
+		),
+		mcp.WithNumber("concurrency",
+			mcp.Description("Number of concurrent workers to use (default: 4)"),
+		),
+	)
+
+	s.AddTool(updateProjectsDescriptionTool, handleUpdateProjectsDescriptionRequest(appInstance, debugLogger))
+}
+
+// handleUpdateProjectsDescriptionRequest handles the update_projects_description tool request.
+func handleUpdateProjectsDescriptionRequest(
+	appInstance *app.App,
+	debugLogger *slog.Logger,
+) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		ctx = mdcForTool(ctx, "update_projects_description")
+		args := request.GetArguments()
+		debugLogger.DebugContext(ctx, "Received update_projects_description tool request", "args", args)
+
+		paths := extractStringArray(args, "project_paths")
+		if len(paths) == 0 {
+			debugLogger.ErrorContext(ctx, "project_paths is not a valid non-empty array", "value", args["project_paths"])
+			return mcp.NewToolResultError("project_paths must be a non-empty array of strings"), nil
+		}
+
+		description, ok := args["description"].(string)
+		if !ok {
+			debugLogger.ErrorContext(ctx, "description is not a valid string", "value", args["description"])
+			return mcp.NewToolResultError("description must be a string"), nil
+		}
+
+		opts := &app.ProjectMutationOptions{}
+		if concurrencyFloat, ok := args["concurrency"].(float64); ok {
+			opts.Concurrency = int(concurrencyFloat)
+		}
+
+		results := appInstance.UpdateProjectsDescription(ctx, paths, description, opts)
+
+		jsonData, err := json.Marshal(results)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to marshal bulk update results to JSON", "error", err)
+			return mcp.NewToolResultError("Failed to format bulk update response"), nil
+		}
+
+		debugLogger.InfoContext(ctx, "Processed update_projects_description request", "project_count", len(paths))
+		return mcp.NewToolResultText(string(jsonData)), nil
+	}
+}
+
+// setupUpdateProjectsTopicsTool creates and registers the update_projects_topics tool.
+func setupUpdateProjectsTopicsTool(s *server.MCPServer, appInstance *app.App, debugLogger *slog.Logger) {
+	updateProjectsTopicsTool := mcp.NewTool("update_projects_topics",
+		mcp.WithDescription("Apply the same topics to many projects in one call, reporting per-project successes and failures"),
+		mcp.WithArray("project_paths",
+			mcp.Required(),
+			mcp.Description("Array of project paths (e.g., 'namespace/project') to update"),
+		),
+		mcp.WithArray("topics",
+			mcp.Required(),
+			mcp.Description("Array of topic names to apply"),
+		),
+		mcp.WithString("mode",
+			mcp.Description("How to apply topics: 'replace' (default), 'add', or 'remove'"),
+		),
+		mcp.WithNumber("concurrency",
+			mcp.Description("Number of concurrent workers to use (default: 4)"),
+		),
+	)
+
+	s.AddTool(updateProjectsTopicsTool, handleUpdateProjectsTopicsRequest(appInstance, debugLogger))
+}
+
+// handleUpdateProjectsTopicsRequest handles the update_projects_topics tool request.
+func handleUpdateProjectsTopicsRequest(
+	appInstance *app.App,
+	debugLogger *slog.Logger,
+) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		ctx = mdcForTool(ctx, "update_projects_topics")
+		args := request.GetArguments()
+		debugLogger.DebugContext(ctx, "Received update_projects_topics tool request", "args", args)
+
+		paths := extractStringArray(args, "project_paths")
+		if len(paths) == 0 {
+			debugLogger.ErrorContext(ctx, "project_paths is not a valid non-empty array", "value", args["project_paths"])
+			return mcp.NewToolResultError("project_paths must be a non-empty array of strings"), nil
+		}
+
+		topics := extractStringArray(args, "topics")
+		if len(topics) == 0 {
+			debugLogger.ErrorContext(ctx, "topics is not a valid non-empty array", "value", args["topics"])
+			return mcp.NewToolResultError("topics must be a non-empty array of strings"), nil
+		}
+
+		mode := app.TopicModeReplace
+		switch modeStr, _ := args["mode"].(string); modeStr {
+		case "", "replace":
+			mode = app.TopicModeReplace
+		case "add":
+			mode = app.TopicModeAdd
+		case "remove":
+			mode = app.TopicModeRemove
+		default:
+			debugLogger.ErrorContext(ctx, "mode is not one of replace, add, remove", "value", modeStr)
+			return mcp.NewToolResultError("mode must be one of: replace, add, remove"), nil
+		}
+
+		opts := &app.ProjectMutationOptions{}
+		if concurrencyFloat, ok := args["concurrency"].(float64); ok {
+			opts.Concurrency = int(concurrencyFloat)
+		}
+
+		results := appInstance.UpdateProjectsTopics(ctx, paths, topics, mode, opts)
+
+		jsonData, err := json.Marshal(results)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to marshal bulk update results to JSON", "error", err)
+			return mcp.NewToolResultError("Failed to format bulk update response"), nil
+		}
+
+		debugLogger.InfoContext(ctx, "Processed update_projects_topics request", "project_count", len(paths))
+		return mcp.NewToolResultText(string(jsonData)), nil
+	}
+}
+
+// setupListPipelinesTool creates and registers the list_pipelines tool.
+func setupListPipelinesTool(s *server.MCPServer, appInstance *app.App, debugLogger *slog.Logger) {
+	listPipelinesTool := mcp.NewTool("list_pipelines",
+		mcp.WithDescription("List CI/CD pipelines for a GitLab project, optionally filtered by ref, status, "+
+			"source, triggering user, or update time window"),
+		mcp.WithString("project_path",
+			mcp.Required(),
+			mcp.Description("GitLab project path (e.g., 'namespace/project-name')"),
+		),
+		mcp.WithString("ref",
+			mcp.Description("Filter pipelines to a specific branch or tag"),
+		),
+		mcp.WithString("status",
+			mcp.Description("Filter pipelines by status: running, success, failed, or canceled"),
+		),
+		mcp.WithString("source",
+			mcp.Description("Filter pipelines by trigger source (e.g. push, web, schedule, api, merge_request_event)"),
+		),
+		mcp.WithString("username",
+			mcp.Description("Filter pipelines to those triggered by a specific GitLab username"),
+		),
+		mcp.WithString("updated_after",
+			mcp.Description("Filter to pipelines last updated after this RFC3339 timestamp"),
+		),
+		mcp.WithString("updated_before",
+			mcp.Description("Filter to pipelines last updated before this RFC3339 timestamp"),
+		),
+	)
+
+	s.AddTool(listPipelinesTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		ctx = mdcForTool(ctx, "list_pipelines")
+		args := request.GetArguments()
+		debugLogger.DebugContext(ctx, "Received list_pipelines tool request", "args", args)
+
+		projectPath, ok := args["project_path"].(string)
+		if !ok || projectPath == "" {
+			debugLogger.ErrorContext(ctx, "project_path is not a valid string", "value", args["project_path"])
+			return mcp.NewToolResultError("project_path must be a non-empty string"), nil
+		}
+
+		opts := &app.ListPipelinesOptions{}
+		if ref, ok := args["ref"].(string); ok {
+			opts.Ref = ref
+		}
+		if status, ok := args["status"].(string); ok {
+			opts.Status = status
+		}
+		if source, ok := args["source"].(string); ok {
+			opts.Source = source
+		}
+		if username, ok := args["username"].(string); ok {
+			opts.Username = username
+		}
+		if raw, ok := args["updated_after"].(string); ok && raw != "" {
+			updatedAfter, parseErr := time.Parse(time.RFC3339, raw)
+			if parseErr != nil {
+				debugLogger.ErrorContext(ctx, "updated_after is not a valid RFC3339 timestamp", "value", raw)
+				return mcp.NewToolResultError("updated_after must be an RFC3339 timestamp"), nil
+			}
+			opts.UpdatedAfter = &updatedAfter
+		}
+		if raw, ok := args["updated_before"].(string); ok && raw != "" {
+			updatedBefore, parseErr := time.Parse(time.RFC3339, raw)
+			if parseErr != nil {
+				debugLogger.ErrorContext(ctx, "updated_before is not a valid RFC3339 timestamp", "value", raw)
+				return mcp.NewToolResultError("updated_before must be an RFC3339 timestamp"), nil
+			}
+			opts.UpdatedBefore = &updatedBefore
+		}
+
+		debugLogger.DebugContext(ctx, "Processing list_pipelines request", "project_path", projectPath, "opts", opts)
+
+		pipelines, err := appInstance.ListProjectPipelines(projectPath, opts)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to list project pipelines", "error", err, "project_path", projectPath)
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to list project pipelines: %v", err)), nil
+		}
+
+		jsonData, err := json.Marshal(pipelines)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to marshal pipelines to JSON", "error", err)
+			return mcp.NewToolResultError("Failed to format pipelines response"), nil
+		}
+
+		debugLogger.InfoContext(ctx, "Successfully listed project pipelines", "count", len(pipelines), "project_path", projectPath)
+		return mcp.NewToolResultText(string(jsonData)), nil
+	})
+}
+
+// setupGetPipelineStatusTool creates and registers the get_pipeline_status tool.
+func setupGetPipelineStatusTool(s *server.MCPServer, appInstance *app.App, debugLogger *slog.Logger) {
+	getPipelineStatusTool := mcp.NewTool("get_pipeline_status",
+		mcp.WithDescription("Get a single pipeline's normalized status (running, success, failed, or canceled)"),
+		mcp.WithString("project_path",
+			mcp.Required(),
+			mcp.Description("GitLab project path (e.g., 'namespace/project-name')"),
+		),
+		mcp.WithNumber("pipeline_id",
+			mcp.Required(),
+			mcp.Description("Pipeline ID"),
+		),
+	)
+
+	s.AddTool(getPipelineStatusTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		ctx = mdcForTool(ctx, "get_pipeline_status")
+		args := request.GetArguments()
+		debugLogger.DebugContext(ctx, "Received get_pipeline_status tool request", "args", args)
+
+		projectPath, ok := args["project_path"].(string)
+		if !ok || projectPath == "" {
+			debugLogger.ErrorContext(ctx, "project_path is not a valid string", "value", args["project_path"])
+			return mcp.NewToolResultError("project_path must be a non-empty string"), nil
+		}
+
+		pipelineIDFloat, ok := args["pipeline_id"].(float64)
+		if !ok {
+			debugLogger.ErrorContext(ctx, "pipeline_id is missing or not a number", "value", args["pipeline_id"])
+			return mcp.NewToolResultError("pipeline_id must be a number"), nil
+		}
+		pipelineID := int64(pipelineIDFloat)
+
+		debugLogger.DebugContext(ctx, "Processing get_pipeline_status request", "project_path", projectPath, "pipeline_id", pipelineID)
+
+		pipeline, err := appInstance.GetPipeline(projectPath, pipelineID)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to get pipeline", "error", err, "project_path", projectPath, "pipeline_id", pipelineID)
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to get pipeline: %v", err)), nil
+		}
+
+		jsonData, err := json.Marshal(pipeline)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to marshal pipeline to JSON", "error", err)
+			return mcp.NewToolResultError("Failed to format pipeline response"), nil
+		}
+
+		debugLogger.InfoContext(ctx, "Successfully retrieved pipeline status",
+			"pipeline_id", pipelineID, "status", pipeline.Status, "project_path", projectPath)
+		return mcp.NewToolResultText(string(jsonData)), nil
+	})
+}
+
+// setupTriggerPipelineTool creates and registers the trigger_pipeline tool.
+func setupTriggerPipelineTool(s *server.MCPServer, appInstance *app.App, debugLogger *slog.Logger) {
+	triggerPipelineTool := mcp.NewTool("trigger_pipeline",
+		mcp.WithDescription("Trigger a new pipeline run for a branch or tag"),
+		mcp.WithString("project_path",
+			mcp.Required(),
+			mcp.Description("GitLab project path (e.g., 'namespace/project-name')"),
+		),
+		mcp.WithString("ref",
+			mcp.Required(),
+			mcp.Description("Branch or tag to run the pipeline against"),
+		),
+	)
+
+	s.AddTool(triggerPipelineTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		ctx = mdcForTool(ctx, "trigger_pipeline")
+		args := request.GetArguments()
+		debugLogger.DebugContext(ctx, "Received trigger_pipeline tool request", "args", args)
+
+		projectPath, ok := args["project_path"].(string)
+		if !ok || projectPath == "" {
+			debugLogger.ErrorContext(ctx, "project_path is not a valid string", "value", args["project_path"])
+			return mcp.NewToolResultError("project_path must be a non-empty string"), nil
+		}
+
+		ref, ok := args["ref"].(string)
+		if !ok || ref == "" {
+			debugLogger.ErrorContext(ctx, "ref is not a valid string", "value", args["ref"])
+			return mcp.NewToolResultError("ref must be a non-empty string"), nil
+		}
+
+		debugLogger.DebugContext(ctx, "Processing trigger_pipeline request", "project_path", projectPath, "ref", ref)
+
+		pipeline, err := appInstance.CreatePipeline(projectPath, ref)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to trigger pipeline", "error", err, "project_path", projectPath, "ref", ref)
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to trigger pipeline: %v", err)), nil
+		}
+
+		jsonData, err := json.Marshal(pipeline)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to marshal pipeline to JSON", "error", err)
+			return mcp.NewToolResultError("Failed to format pipeline response"), nil
+		}
+
+		debugLogger.InfoContext(ctx, "Successfully triggered pipeline", "pipeline_id", pipeline.ID, "project_path", projectPath, "ref", ref)
+		return mcp.NewToolResultText(string(jsonData)), nil
+	})
+}
+
+// setupRetryPipelineTool creates and registers the retry_pipeline tool.
+func setupRetryPipelineTool(s *server.MCPServer, appInstance *app.App, debugLogger *slog.Logger) {
+	retryPipelineTool := mcp.NewTool("retry_pipeline",
+		mcp.WithDescription("Retry the failed or canceled jobs of a pipeline"),
+		mcp.WithString("project_path",
+			mcp.Required(),
+			mcp.Description("GitLab project path (e.g., 'namespace/project-name')"),
+		),
+		mcp.WithNumber("pipeline_id",
+			mcp.Required(),
+			mcp.Description("Pipeline ID"),
+		),
+	)
+
+	s.AddTool(retryPipelineTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		ctx = mdcForTool(ctx, "retry_pipeline")
+		args := request.GetArguments()
+		debugLogger.DebugContext(ctx, "Received retry_pipeline tool request", "args", args)
+
+		projectPath, ok := args["project_path"].(string)
+		if !ok || projectPath == "" {
+			debugLogger.ErrorContext(ctx, "project_path is not a valid string", "value", args["project_path"])
+			return mcp.NewToolResultError("project_path must be a non-empty string"), nil
+		}
+
+		pipelineIDFloat, ok := args["pipeline_id"].(float64)
+		if !ok {
+			debugLogger.ErrorContext(ctx, "pipeline_id is missing or not a number", "value", args["pipeline_id"])
+			return mcp.NewToolResultError("pipeline_id must be a number"), nil
+		}
+		pipelineID := int64(pipelineIDFloat)
+
+		debugLogger.DebugContext(ctx, "Processing retry_pipeline request", "project_path", projectPath, "pipeline_id", pipelineID)
+
+		pipeline, err := appInstance.RetryPipeline(projectPath, pipelineID)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to retry pipeline", "error", err, "project_path", projectPath, "pipeline_id", pipelineID)
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to retry pipeline: %v", err)), nil
+		}
+
+		jsonData, err := json.Marshal(pipeline)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to marshal pipeline to JSON", "error", err)
+			return mcp.NewToolResultError("Failed to format pipeline response"), nil
+		}
+
+		debugLogger.InfoContext(ctx, "Successfully retried pipeline", "pipeline_id", pipelineID, "project_path", projectPath)
+		return mcp.NewToolResultText(string(jsonData)), nil
+	})
+}
+
+// setupCancelPipelineTool creates and registers the cancel_pipeline tool.
+func setupCancelPipelineTool(s *server.MCPServer, appInstance *app.App, debugLogger *slog.Logger) {
+	cancelPipelineTool := mcp.NewTool("cancel_pipeline",
+		mcp.WithDescription("Cancel a running pipeline"),
+		mcp.WithString("project_path",
+			mcp.Required(),
+			mcp.Description("GitLab project path (e.g., 'namespace/project-name')"),
+		),
+		mcp.WithNumber("pipeline_id",
+			mcp.Required(),
+			mcp.Description("Pipeline ID"),
+		),
+	)
+
+	s.AddTool(cancelPipelineTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		ctx = mdcForTool(ctx, "cancel_pipeline")
+		args := request.GetArguments()
+		debugLogger.DebugContext(ctx, "Received cancel_pipeline tool request", "args", args)
+
+		projectPath, ok := args["project_path"].(string)
+		if !ok || projectPath == "" {
+			debugLogger.ErrorContext(ctx, "project_path is not a valid string", "value", args["project_path"])
+			return mcp.NewToolResultError("project_path must be a non-empty string"), nil
+		}
+
+		pipelineIDFloat, ok := args["pipeline_id"].(float64)
+		if !ok {
+			debugLogger.ErrorContext(ctx, "pipeline_id is missing or not a number", "value", args["pipeline_id"])
+			return mcp.NewToolResultError("pipeline_id must be a number"), nil
+		}
+		pipelineID := int64(pipelineIDFloat)
+
+		debugLogger.DebugContext(ctx, "Processing cancel_pipeline request", "project_path", projectPath, "pipeline_id", pipelineID)
+
+		pipeline, err := appInstance.CancelPipeline(projectPath, pipelineID)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to cancel pipeline", "error", err, "project_path", projectPath, "pipeline_id", pipelineID)
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to cancel pipeline: %v", err)), nil
+		}
+
+		jsonData, err := json.Marshal(pipeline)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to marshal pipeline to JSON", "error", err)
+			return mcp.NewToolResultError("Failed to format pipeline response"), nil
+		}
+
+		debugLogger.InfoContext(ctx, "Successfully canceled pipeline", "pipeline_id", pipelineID, "project_path", projectPath)
+		return mcp.NewToolResultText(string(jsonData)), nil
+	})
+}
+
+// setupGetJobLogTool creates and registers the get_job_log tool.
+func setupGetJobLogTool(s *server.MCPServer, appInstance *app.App, debugLogger *slog.Logger) {
+	getJobLogTool := mcp.NewTool("get_job_log",
+		mcp.WithDescription("Get the trace log of a single CI/CD job"),
+		mcp.WithString("project_path",
+			mcp.Required(),
+			mcp.Description("GitLab project path (e.g., 'namespace/project-name')"),
+		),
+		mcp.WithNumber("job_id",
+			mcp.Required(),
+			mcp.Description("Job ID"),
+		),
+	)
+
+	s.AddTool(getJobLogTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		ctx = mdcForTool(ctx, "get_job_log")
+		args := request.GetArguments()
+		debugLogger.DebugContext(ctx, "Received get_job_log tool request", "args", args)
+
+		projectPath, ok := args["project_path"].(string)
+		if !ok || projectPath == "" {
+			debugLogger.ErrorContext(ctx, "project_path is not a valid string", "value", args["project_path"])
+			return mcp.NewToolResultError("project_path must be a non-empty string"), nil
+		}
+
+		jobIDFloat, ok := args["job_id"].(float64)
+		if !ok {
+			debugLogger.ErrorContext(ctx, "job_id is missing or not a number", "value", args["job_id"])
+			return mcp.NewToolResultError("job_id must be a number"), nil
+		}
+		jobID := int64(jobIDFloat)
+
+		debugLogger.DebugContext(ctx, "Processing get_job_log request", "project_path", projectPath, "job_id", jobID)
+
+		jobLog, err := appInstance.GetJobLog(projectPath, jobID)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to get job log", "error", err, "project_path", projectPath, "job_id", jobID)
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to get job log: %v", err)), nil
+		}
+
+		debugLogger.InfoContext(ctx, "Successfully retrieved job log", "job_id", jobID, "project_path", projectPath)
+		return mcp.NewToolResultText(jobLog), nil
+	})
+}
+
+// setupGetLastPipelineTool creates and registers the get_last_pipeline tool.
+func setupGetLastPipelineTool(s *server.MCPServer, appInstance *app.App, debugLogger *slog.Logger) {
+	getLastPipelineTool := mcp.NewTool("get_last_pipeline",
+		mcp.WithDescription("Get the most recent pipeline for a given branch or tag"),
+		mcp.WithString("project_path",
+			mcp.Required(),
+			mcp.Description("GitLab project path (e.g., 'namespace/project-name')"),
+		),
+		mcp.WithString("ref",
+			mcp.Description("Branch or tag name (default: project's default branch)"),
+		),
+	)
+
+	s.AddTool(getLastPipelineTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		ctx = mdcForTool(ctx, "get_last_pipeline")
+		args := request.GetArguments()
+		debugLogger.DebugContext(ctx, "Received get_last_pipeline tool request", "args", args)
+
+		projectPath, ok := args["project_path"].(string)
+		if !ok || projectPath == "" {
+			debugLogger.ErrorContext(ctx, "project_path is not a valid string", "value", args["project_path"])
+			return mcp.NewToolResultError("project_path must be a non-empty string"), nil
+		}
+
+		ref, _ := args["ref"].(string)
+
+		debugLogger.DebugContext(ctx, "Processing get_last_pipeline request", "project_path", projectPath, "ref", ref)
+
+		pipeline, err := appInstance.GetLastPipeline(projectPath, ref)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to get last pipeline", "error", err, "project_path", projectPath, "ref", ref)
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to get last pipeline: %v", err)), nil
+		}
+
+		jsonData, err := json.Marshal(pipeline)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to marshal pipeline", "error", err)
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to marshal pipeline: %v", err)), nil
+		}
+
+		debugLogger.InfoContext(ctx, "Successfully retrieved last pipeline", "project_path", projectPath, "ref", ref)
+		return mcp.NewToolResultText(string(jsonData)), nil
+	})
+}
+
+// setupCancelPipelineJobTool creates and registers the cancel_pipeline_job tool.
+func setupCancelPipelineJobTool(s *server.MCPServer, appInstance *app.App, debugLogger *slog.Logger) {
+	cancelPipelineJobTool := mcp.NewTool("cancel_pipeline_job",
+		mcp.WithDescription("Cancel a single running CI/CD job"),
+		mcp.WithString("project_path",
+			mcp.Required(),
+			mcp.Description("GitLab project path (e.g., 'namespace/project-name')"),
+		),
+		mcp.WithNumber("job_id",
+			mcp.Required(),
+			mcp.Description("Job ID"),
+		),
+	)
+
+	s.AddTool(cancelPipelineJobTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		ctx = mdcForTool(ctx, "cancel_pipeline_job")
+		args := request.GetArguments()
+		debugLogger.DebugContext(ctx, "Received cancel_pipeline_job tool request", "args", args)
+
+		projectPath, ok := args["project_path"].(string)
+		if !ok || projectPath == "" {
+			debugLogger.ErrorContext(ctx, "project_path is not a valid string", "value", args["project_path"])
+			return mcp.NewToolResultError("project_path must be a non-empty string"), nil
+		}
+
+		jobIDFloat, ok := args["job_id"].(float64)
+		if !ok {
+			debugLogger.ErrorContext(ctx, "job_id is missing or not a number", "value", args["job_id"])
+			return mcp.NewToolResultError("job_id must be a number"), nil
+		}
+		jobID := int64(jobIDFloat)
+
+		debugLogger.DebugContext(ctx, "Processing cancel_pipeline_job request", "project_path", projectPath, "job_id", jobID)
+
+		job, err := appInstance.CancelPipelineJob(projectPath, jobID)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to cancel pipeline job", "error", err, "project_path", projectPath, "job_id", jobID)
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to cancel pipeline job: %v", err)), nil
+		}
+
+		jsonData, err := json.Marshal(job)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to marshal job", "error", err)
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to marshal job: %v", err)), nil
+		}
+
+		debugLogger.InfoContext(ctx, "Successfully canceled pipeline job", "job_id", jobID, "project_path", projectPath)
+		return mcp.NewToolResultText(string(jsonData)), nil
+	})
+}
+
+// setupPlayJobTool creates and registers the play_job tool.
+func setupPlayJobTool(s *server.MCPServer, appInstance *app.App, debugLogger *slog.Logger) {
+	playJobTool := mcp.NewTool("play_job",
+		mcp.WithDescription("Trigger a manual CI/CD job to start running"),
+		mcp.WithString("project_path",
+			mcp.Required(),
+			mcp.Description("GitLab project path (e.g., 'namespace/project-name')"),
+		),
+		mcp.WithNumber("job_id",
+			mcp.Required(),
+			mcp.Description("Job ID"),
+		),
+	)
+
+	s.AddTool(playJobTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		ctx = mdcForTool(ctx, "play_job")
+		args := request.GetArguments()
+		debugLogger.DebugContext(ctx, "Received play_job tool request", "args", args)
+
+		projectPath, ok := args["project_path"].(string)
+		if !ok || projectPath == "" {
+			debugLogger.ErrorContext(ctx, "project_path is not a valid string", "value", args["project_path"])
+			return mcp.NewToolResultError("project_path must be a non-empty string"), nil
+		}
+
+		jobIDFloat, ok := args["job_id"].(float64)
+		if !ok {
+			debugLogger.ErrorContext(ctx, "job_id is missing or not a number", "value", args["job_id"])
+			return mcp.NewToolResultError("job_id must be a number"), nil
+		}
+		jobID := int64(jobIDFloat)
+
+		debugLogger.DebugContext(ctx, "Processing play_job request", "project_path", projectPath, "job_id", jobID)
+
+		job, err := appInstance.PlayJob(projectPath, jobID)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to play job", "error", err, "project_path", projectPath, "job_id", jobID)
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to play job: %v", err)), nil
+		}
+
+		jsonData, err := json.Marshal(job)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to marshal job", "error", err)
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to marshal job: %v", err)), nil
+		}
+
+		debugLogger.InfoContext(ctx, "Successfully played job", "job_id", jobID, "project_path", projectPath)
+		return mcp.NewToolResultText(string(jsonData)), nil
+	})
+}
+
+// setupListPipelineJobsTool creates and registers the list_pipeline_jobs tool.
+func setupListPipelineJobsTool(s *server.MCPServer, appInstance *app.App, debugLogger *slog.Logger) {
+	listPipelineJobsTool := mcp.NewTool("list_pipeline_jobs",
+		mcp.WithDescription("List the jobs belonging to a pipeline"),
+		mcp.WithString("project_path",
+			mcp.Required(),
+			mcp.Description("GitLab project path (e.g., 'namespace/project-name')"),
+		),
+		mcp.WithNumber("pipeline_id",
+			mcp.Required(),
+			mcp.Description("Pipeline ID"),
+		),
+	)
+
+	s.AddTool(listPipelineJobsTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		ctx = mdcForTool(ctx, "list_pipeline_jobs")
+		args := request.GetArguments()
+		debugLogger.DebugContext(ctx, "Received list_pipeline_jobs tool request", "args", args)
+
+		projectPath, ok := args["project_path"].(string)
+		if !ok || projectPath == "" {
+			debugLogger.ErrorContext(ctx, "project_path is not a valid string", "value", args["project_path"])
+			return mcp.NewToolResultError("project_path must be a non-empty string"), nil
+		}
+
+		pipelineIDFloat, ok := args["pipeline_id"].(float64)
+		if !ok {
+			debugLogger.ErrorContext(ctx, "pipeline_id is missing or not a number", "value", args["pipeline_id"])
+			return mcp.NewToolResultError("pipeline_id must be a number"), nil
+		}
+		pipelineID := int64(pipelineIDFloat)
+
+		debugLogger.DebugContext(ctx, "Processing list_pipeline_jobs request", "project_path", projectPath, "pipeline_id", pipelineID)
+
+		jobs, err := appInstance.ListPipelineJobs(projectPath, pipelineID)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to list pipeline jobs", "error", err, "project_path", projectPath, "pipeline_id", pipelineID)
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to list pipeline jobs: %v", err)), nil
+		}
+
+		jsonData, err := json.Marshal(jobs)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to marshal jobs", "error", err)
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to marshal jobs: %v", err)), nil
+		}
+
+		debugLogger.InfoContext(ctx, "Successfully listed pipeline jobs", "count", len(jobs), "project_path", projectPath)
+		return mcp.NewToolResultText(string(jsonData)), nil
+	})
+}
+
+// setupGetPipelineVariablesTool creates and registers the get_pipeline_variables tool.
+func setupGetPipelineVariablesTool(s *server.MCPServer, appInstance *app.App, debugLogger *slog.Logger) {
+	getPipelineVariablesTool := mcp.NewTool("get_pipeline_variables",
+		mcp.WithDescription("Get the variables used to trigger a pipeline"),
+		mcp.WithString("project_path",
+			mcp.Required(),
+			mcp.Description("GitLab project path (e.g., 'namespace/project-name')"),
+		),
+		mcp.WithNumber("pipeline_id",
+			mcp.Required(),
+			mcp.Description("Pipeline ID"),
+		),
+	)
+
+	s.AddTool(getPipelineVariablesTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		ctx = mdcForTool(ctx, "get_pipeline_variables")
+		args := request.GetArguments()
+		debugLogger.DebugContext(ctx, "Received get_pipeline_variables tool request", "args", args)
+
+		projectPath, ok := args["project_path"].(string)
+		if !ok || projectPath == "" {
+			debugLogger.ErrorContext(ctx, "project_path is not a valid string", "value", args["project_path"])
+			return mcp.NewToolResultError("project_path must be a non-empty string"), nil
+		}
+
+		pipelineIDFloat, ok := args["pipeline_id"].(float64)
+		if !ok {
+			debugLogger.ErrorContext(ctx, "pipeline_id is missing or not a number", "value", args["pipeline_id"])
+			return mcp.NewToolResultError("pipeline_id must be a number"), nil
+		}
+		pipelineID := int64(pipelineIDFloat)
+
+		debugLogger.DebugContext(ctx, "Processing get_pipeline_variables request", "project_path", projectPath, "pipeline_id", pipelineID)
+
+		variables, err := appInstance.GetPipelineVariables(projectPath, pipelineID)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to get pipeline variables", "error", err, "project_path", projectPath, "pipeline_id", pipelineID)
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to get pipeline variables: %v", err)), nil
+		}
+
+		jsonData, err := json.Marshal(variables)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to marshal variables", "error", err)
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to marshal variables: %v", err)), nil
+		}
+
+		debugLogger.InfoContext(ctx, "Successfully retrieved pipeline variables", "count", len(variables), "project_path", projectPath)
+		return mcp.NewToolResultText(string(jsonData)), nil
+	})
+}
+
+// setupListMergeRequestsTool creates and registers the list_merge_requests tool.
+func setupListMergeRequestsTool(s *server.MCPServer, appInstance *app.App, debugLogger *slog.Logger) {
+	listMergeRequestsTool := mcp.NewTool("list_merge_requests",
+		mcp.WithDescription("List merge requests for a GitLab project, with optional state/branch/author/label filters"),
+		mcp.WithString("project_path",
+			mcp.Required(),
+			mcp.Description("GitLab project path (e.g., 'namespace/project-name')"),
+		),
+		mcp.WithString("state",
+			mcp.Description("Filter by merge request state: opened, closed, merged, or all (default: opened)"),
+		),
+		mcp.WithString("target_branch",
+			mcp.Description("Filter by target branch"),
+		),
+		mcp.WithNumber("author_id",
+			mcp.Description("Filter by the numeric ID of the merge request author"),
+		),
+		mcp.WithString("labels",
+			mcp.Description("Comma-separated list of labels to filter by"),
+		),
+		mcp.WithBoolean("list_all",
+			mcp.Description(
+				"Walk every page instead of just the first, bounded by max_items (default: false)",
+			),
+		),
+		mcp.WithNumber("max_items",
+			mcp.Description("Item cap applied when list_all is set (default: a few hundred)"),
+		),
+		mcp.WithString("format",
+			mcp.Description("Output format: json (default), yaml, table, or template"),
+		),
+		mcp.WithString("template",
+			mcp.Description("Go text/template source, used when format is template"),
+		),
+	)
+
+	s.AddTool(listMergeRequestsTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		ctx = mdcForTool(ctx, "list_merge_requests")
+		args := request.GetArguments()
+		debugLogger.DebugContext(ctx, "Received list_merge_requests tool request", "args", args)
+
+		projectPath, ok := args["project_path"].(string)
+		if !ok || projectPath == "" {
+			debugLogger.ErrorContext(ctx, "project_path is not a valid string", "value", args["project_path"])
+			return mcp.NewToolResultError("project_path must be a non-empty string"), nil
+		}
+
+		opts := extractListMergeRequestsOptions(args)
+
+		debugLogger.DebugContext(ctx, "Processing list_merge_requests request", "project_path", projectPath, "opts", opts)
+
+		mrs, err := appInstance.ListProjectMergeRequests(projectPath, opts)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to list project merge requests", "error", err, "project_path", projectPath)
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to list project merge requests: %v", err)), nil
+		}
+
+		format, _ := args["format"].(string)
+		tmplSrc, _ := args["template"].(string)
+		rendered, err := renderListResult(format, tmplSrc, mrs, opts.Truncated, mergeRequestsToRows(mrs))
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to render merge requests", "error", err, "format", format)
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to format merge requests response: %v", err)), nil
+		}
+
+		debugLogger.InfoContext(ctx, "Successfully listed project merge requests", "count", len(mrs), "project_path", projectPath)
+		return mcp.NewToolResultText(rendered), nil
+	})
+}
+
+// extractListMergeRequestsOptions extracts list merge request filter options shared by the
+// project- and group-scoped list tools.
+func extractListMergeRequestsOptions(args map[string]interface{}) *app.ListMergeRequestsOptions {
+	opts := &app.ListMergeRequestsOptions{}
+	if state, ok := args["state"].(string); ok && state != "" {
+		opts.State = state
+	}
+	if targetBranch, ok := args["target_branch"].(string); ok {
+		opts.TargetBranch = targetBranch
+	}
+	if authorIDFloat, ok := args["author_id"].(float64); ok {
+		opts.AuthorID = int64(authorIDFloat)
+	}
+	if labels, ok := args["labels"].(string); ok {
+		opts.Labels = labels
+	}
+	if listAll, ok := args["list_all"].(bool); ok {
+		opts.ListAll = listAll
+	}
+	if maxItemsFloat, ok := args["max_items"].(float64); ok {
+		opts.MaxItems = int(maxItemsFloat)
+	}
+	return opts
+}
+
+// setupListGroupMergeRequestsTool creates and registers the list_group_merge_requests tool.
+func setupListGroupMergeRequestsTool(s *server.MCPServer, appInstance *app.App, debugLogger *slog.Logger) {
+	listGroupMergeRequestsTool := mcp.NewTool("list_group_merge_requests",
+		mcp.WithDescription(
+			"List merge requests across all projects in a GitLab group, "+
+				"with optional state/branch/author/label filters",
+		),
+		mcp.WithString("group_path",
+			mcp.Required(),
+			mcp.Description("GitLab group path (e.g., 'namespace/group-name')"),
+		),
+		mcp.WithString("state",
+			mcp.Description("Filter by merge request state: opened, closed, merged, or all (default: opened)"),
+		),
+		mcp.WithString("target_branch",
+			mcp.Description("Filter by target branch"),
+		),
+		mcp.WithNumber("author_id",
+			mcp.Description("Filter by the numeric ID of the merge request author"),
+		),
+		mcp.WithString("labels",
+			mcp.Description("Comma-separated list of labels to filter by"),
+		),
+		mcp.WithBoolean("list_all",
+			mcp.Description(
+				"Walk every page instead of just the first, bounded by max_items (default: false)",
+			),
+		),
+		mcp.WithNumber("max_items",
+			mcp.Description("Item cap applied when list_all is set (default: a few hundred)"),
+		),
+	)
+
+	s.AddTool(listGroupMergeRequestsTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		ctx = mdcForTool(ctx, "list_group_merge_requests")
+		args := request.GetArguments()
+		debugLogger.DebugContext(ctx, "Received list_group_merge_requests tool request", "args", args)
+
+		groupPath, ok := args["group_path"].(string)
+		if !ok || groupPath == "" {
+			debugLogger.ErrorContext(ctx, "group_path is not a valid string", "value", args["group_path"])
+			return mcp.NewToolResultError("group_path must be a non-empty string"), nil
+		}
+
+		opts := extractListMergeRequestsOptions(args)
+
+		debugLogger.DebugContext(ctx, "Processing list_group_merge_requests request", "group_path", groupPath, "opts", opts)
+
+		mrs, err := appInstance.ListGroupMergeRequests(groupPath, opts)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to list group merge requests", "error", err, "group_path", groupPath)
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to list group merge requests: %v", err)), nil
+		}
+
+		jsonData, err := marshalListResult(mrs, opts.Truncated)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to marshal merge requests to JSON", "error", err)
+			return mcp.NewToolResultError("Failed to format merge requests response"), nil
+		}
+
+		debugLogger.InfoContext(ctx, "Successfully listed group merge requests", "count", len(mrs), "group_path", groupPath)
+		return mcp.NewToolResultText(string(jsonData)), nil
+	})
+}
+
+// setupListGroupProjectsTool creates and registers the list_group_projects tool.
+func setupListGroupProjectsTool(s *server.MCPServer, appInstance *app.App, debugLogger *slog.Logger) {
+	listGroupProjectsTool := mcp.NewTool("list_group_projects",
+		mcp.WithDescription("List the projects belonging to a GitLab group, optionally including subgroups"),
+		mcp.WithString("group_path",
+			mcp.Required(),
+			mcp.Description("GitLab group path (e.g., 'namespace/group-name')"),
+		),
+		mcp.WithBoolean("include_subgroups",
+			mcp.Description("Also include projects from subgroups (default: false)"),
+		),
+		mcp.WithNumber("limit",
+			mcp.Description("Maximum number of projects to return (default: 100, max: 100)"),
+		),
+		mcp.WithBoolean("list_all",
+			mcp.Description(
+				"Walk every page instead of just the first, bounded by max_items (default: false)",
+			),
+		),
+		mcp.WithNumber("max_items",
+			mcp.Description("Item cap applied when list_all is set (default: a few hundred)"),
+		),
+	)
+
+	s.AddTool(listGroupProjectsTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		ctx = mdcForTool(ctx, "list_group_projects")
+		args := request.GetArguments()
+		debugLogger.DebugContext(ctx, "Received list_group_projects tool request", "args", args)
+
+		groupPath, ok := args["group_path"].(string)
+		if !ok || groupPath == "" {
+			debugLogger.ErrorContext(ctx, "group_path is not a valid string", "value", args["group_path"])
+			return mcp.NewToolResultError("group_path must be a non-empty string"), nil
+		}
+
+		opts := &app.ListGroupProjectsOptions{}
+
+		if includeSubgroups, ok := args["include_subgroups"].(bool); ok {
+			opts.IncludeSubgroups = includeSubgroups
+		}
+		if limitFloat, ok := args["limit"].(float64); ok {
+			opts.Limit = int64(limitFloat)
+		}
+		if listAll, ok := args["list_all"].(bool); ok {
+			opts.ListAll = listAll
+		}
+		if maxItemsFloat, ok := args["max_items"].(float64); ok {
+			opts.MaxItems = int(maxItemsFloat)
+		}
+
+		debugLogger.DebugContext(ctx, "Processing list_group_projects request", "group_path", groupPath, "opts", opts)
+
+		projects, err := appInstance.ListGroupProjects(groupPath, opts)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to list group projects", "error", err, "group_path", groupPath)
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to list group projects: %v", err)), nil
+		}
+
+		jsonData, err := marshalListResult(projects, opts.Truncated)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to marshal projects to JSON", "error", err)
+			return mcp.NewToolResultError("Failed to format projects response"), nil
+		}
+
+		debugLogger.InfoContext(ctx, "Successfully listed group projects", "count", len(projects), "group_path", groupPath)
+		return mcp.NewToolResultText(string(jsonData)), nil
+	})
+}
+
+// setupListGroupIssuesTool creates and registers the list_group_issues tool.
+func setupListGroupIssuesTool(s *server.MCPServer, appInstance *app.App, debugLogger *slog.Logger) {
+	listGroupIssuesTool := mcp.NewTool("list_group_issues",
+		mcp.WithDescription("List issues across all projects in a GitLab group"),
+		mcp.WithString("group_path",
+			mcp.Required(),
+			mcp.Description("GitLab group path (e.g., 'namespace/group-name')"),
+		),
+		mcp.WithString("state",
+			mcp.Description("Filter by issue state: opened, closed, or all (default: opened)"),
+		),
+		mcp.WithString("labels",
+			mcp.Description("Comma-separated list of labels to filter by"),
+		),
+		mcp.WithNumber("limit",
+			mcp.Description("Maximum number of issues to return (default: 100, max: 100)"),
+		),
+		mcp.WithNumber("iteration_id",
+			mcp.Description("Filter by iteration ID"),
+		),
+		mcp.WithString("iteration_title",
+			mcp.Description(
+				"Filter by iteration title instead of ID, resolved against the group's iterations; "+
+					"ignored when iteration_id is set",
+			),
+		),
+		mcp.WithString("milestone_title",
+			mcp.Description("Filter by milestone title"),
+		),
+		mcp.WithBoolean("list_all",
+			mcp.Description(
+				"Walk every page instead of just the first, bounded by max_items (default: false)",
+			),
+		),
+		mcp.WithNumber("max_items",
+			mcp.Description("Item cap applied when list_all is set (default: a few hundred)"),
+		),
+	)
+
+	s.AddTool(listGroupIssuesTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		ctx = mdcForTool(ctx, "list_group_issues")
+		args := request.GetArguments()
+		debugLogger.DebugContext(ctx, "Received list_group_issues tool request", "args", args)
+
+		groupPath, ok := args["group_path"].(string)
+		if !ok || groupPath == "" {
+			debugLogger.ErrorContext(ctx, "group_path is not a valid string", "value", args["group_path"])
+			return mcp.NewToolResultError("group_path must be a non-empty string"), nil
+		}
+
+		opts := &app.ListIssuesOptions{
+			State: "opened",
+			Limit: defaultLimit,
+		}
+
+		if state, ok := args["state"].(string); ok && state != "" {
+			opts.State = state
+		}
+		if labels, ok := args["labels"].(string); ok && labels != "" {
+			opts.Labels = labels
+		}
+		if limitFloat, ok := args["limit"].(float64); ok {
+			opts.Limit = int64(limitFloat)
+		}
+		if iterationIDFloat, ok := args["iteration_id"].(float64); ok {
+			iterationID := int64(iterationIDFloat)
+			opts.IterationID = &iterationID
+		}
+		if iterationTitle, ok := args["iteration_title"].(string); ok && iterationTitle != "" {
+			opts.IterationTitle = &iterationTitle
+		}
+		if milestoneTitle, ok := args["milestone_title"].(string); ok && milestoneTitle != "" {
+			opts.MilestoneTitle = milestoneTitle
+		}
+		if listAll, ok := args["list_all"].(bool); ok {
+			opts.ListAll = listAll
+		}
+		if maxItemsFloat, ok := args["max_items"].(float64); ok {
+			opts.MaxItems = int(maxItemsFloat)
+		}
+
+		debugLogger.DebugContext(ctx, "Processing list_group_issues request", "group_path", groupPath, "opts", opts)
+
+		issues, err := appInstance.ListGroupIssues(groupPath, opts)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to list group issues", "error", err, "group_path", groupPath)
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to list group issues: %v", err)), nil
+		}
+
+		jsonData, err := marshalListResult(issues, opts.Truncated)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to marshal issues to JSON", "error", err)
+			return mcp.NewToolResultError("Failed to format issues response"), nil
+		}
+
+		debugLogger.InfoContext(ctx, "Successfully listed group issues", "count", len(issues), "group_path", groupPath)
+		return mcp.NewToolResultText(string(jsonData)), nil
+	})
+}
+
+// setupListGroupLabelsTool creates and registers the list_group_labels tool.
+func setupListGroupLabelsTool(s *server.MCPServer, appInstance *app.App, debugLogger *slog.Logger) {
+	listGroupLabelsTool := mcp.NewTool("list_group_labels",
+		mcp.WithDescription("List labels defined at the GitLab group level"),
+		mcp.WithString("group_path",
+			mcp.Required(),
+			mcp.Description("GitLab group path (e.g., 'namespace/group-name')"),
+		),
+		mcp.WithBoolean("with_counts",
+			mcp.Description("Include issue and merge request counts (default: false)"),
+		),
+		mcp.WithBoolean("include_ancestor_groups",
+			mcp.Description("Include labels from ancestor groups (default: false)"),
+		),
+		mcp.WithString("search",
+			mcp.Description("Filter labels by search keyword"),
+		),
+		mcp.WithNumber("limit",
+			mcp.Description("Maximum number of labels to return (default: 100, max: 100)"),
+		),
+		mcp.WithBoolean("list_all",
+			mcp.Description(
+				"Walk every page instead of just the first, bounded by max_items (default: false)",
+			),
+		),
+		mcp.WithNumber("max_items",
+			mcp.Description("Item cap applied when list_all is set (default: a few hundred)"),
+		),
+	)
+
+	s.AddTool(listGroupLabelsTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		ctx = mdcForTool(ctx, "list_group_labels")
+		args := request.GetArguments()
+		debugLogger.DebugContext(ctx, "Received list_group_labels tool request", "args", args)
+
+		groupPath, ok := args["group_path"].(string)
+		if !ok || groupPath == "" {
+			debugLogger.ErrorContext(ctx, "group_path is not a valid string", "value", args["group_path"])
+			return mcp.NewToolResultError("group_path must be a non-empty string"), nil
+		}
+
+		opts := extractListLabelsOptions(args)
+
+		debugLogger.DebugContext(ctx, "Processing list_group_labels request", "group_path", groupPath, "opts", opts)
+
+		labels, err := appInstance.ListGroupLabels(groupPath, opts)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to list group labels", "error", err, "group_path", groupPath)
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to list group labels: %v", err)), nil
+		}
+
+		jsonData, err := marshalListResult(labels, opts.Truncated)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to marshal labels to JSON", "error", err)
+			return mcp.NewToolResultError("Failed to format labels response"), nil
+		}
+
+		debugLogger.InfoContext(ctx, "Successfully listed group labels", "count", len(labels), "group_path", groupPath)
+		return mcp.NewToolResultText(string(jsonData)), nil
+	})
+}
+
+// setupGetNamespaceTool creates and registers the get_namespace tool.
+func setupGetNamespaceTool(s *server.MCPServer, appInstance *app.App, debugLogger *slog.Logger) {
+	getNamespaceTool := mcp.NewTool("get_namespace",
+		mcp.WithDescription(
+			"Resolve a user, group, or \"group/subgroup\" style path to its namespace, "+
+				"reporting whether it is a user or group namespace",
+		),
+		mcp.WithString("namespace_path",
+			mcp.Required(),
+			mcp.Description("Namespace path to resolve (e.g., 'namespace' or 'namespace/subgroup')"),
+		),
+	)
+
+	s.AddTool(getNamespaceTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		ctx = mdcForTool(ctx, "get_namespace")
+		args := request.GetArguments()
+		debugLogger.DebugContext(ctx, "Received get_namespace tool request", "args", args)
+
+		namespacePath, ok := args["namespace_path"].(string)
+		if !ok || namespacePath == "" {
+			debugLogger.ErrorContext(ctx, "namespace_path is not a valid string", "value", args["namespace_path"])
+			return mcp.NewToolResultError("namespace_path must be a non-empty string"), nil
+		}
+
+		debugLogger.DebugContext(ctx, "Processing get_namespace request", "namespace_path", namespacePath)
+
+		namespace, err := appInstance.GetNamespace(namespacePath)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to get namespace", "error", err, "namespace_path", namespacePath)
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to get namespace: %v", err)), nil
+		}
+
+		jsonData, err := json.Marshal(namespace)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to marshal namespace", "error", err)
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to marshal namespace: %v", err)), nil
+		}
+
+		debugLogger.InfoContext(ctx, "Successfully retrieved namespace", "namespace_path", namespacePath)
+		return mcp.NewToolResultText(string(jsonData)), nil
+	})
+}
+
+// setupGetMergeRequestTool creates and registers the get_merge_request tool.
+func setupGetMergeRequestTool(s *server.MCPServer, appInstance *app.App, debugLogger *slog.Logger) {
+	getMergeRequestTool := mcp.NewTool("get_merge_request",
+		mcp.WithDescription("Get a single merge request by IID"),
+		mcp.WithString("project_path",
+			mcp.Required(),
+			mcp.Description("GitLab project path (e.g., 'namespace/project-name')"),
+		),
+		mcp.WithNumber("mr_iid",
+			mcp.Required(),
+			mcp.Description("Merge request internal ID (IID)"),
+		),
+	)
+
+	s.AddTool(getMergeRequestTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		ctx = mdcForTool(ctx, "get_merge_request")
+		args := request.GetArguments()
+		debugLogger.DebugContext(ctx, "Received get_merge_request tool request", "args", args)
+
+		projectPath, ok := args["project_path"].(string)
+		if !ok || projectPath == "" {
+			debugLogger.ErrorContext(ctx, "project_path is not a valid string", "value", args["project_path"])
+			return mcp.NewToolResultError("project_path must be a non-empty string"), nil
+		}
+
+		mrIIDFloat, ok := args["mr_iid"].(float64)
+		if !ok {
+			debugLogger.ErrorContext(ctx, "mr_iid is missing or not a number", "value", args["mr_iid"])
+			return mcp.NewToolResultError("mr_iid must be a number"), nil
+		}
+		mrIID := int64(mrIIDFloat)
+
+		debugLogger.DebugContext(ctx, "Processing get_merge_request request", "project_path", projectPath, "mr_iid", mrIID)
+
+		mr, err := appInstance.GetProjectMergeRequest(projectPath, mrIID)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to get merge request", "error", err, "project_path", projectPath, "mr_iid", mrIID)
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to get merge request: %v", err)), nil
+		}
+
+		jsonData, err := json.Marshal(mr)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to marshal merge request to JSON", "error", err)
+			return mcp.NewToolResultError("Failed to format merge request response"), nil
+		}
+
+		debugLogger.InfoContext(ctx, "Successfully retrieved merge request", "mr_iid", mrIID, "project_path", projectPath)
+		return mcp.NewToolResultText(string(jsonData)), nil
+	})
+}
+
+// setupGetMergeRequestChangesTool creates and registers the get_merge_request_changes tool.
+func setupGetMergeRequestChangesTool(s *server.MCPServer, appInstance *app.App, debugLogger *slog.Logger) {
+	getMergeRequestChangesTool := mcp.NewTool("get_merge_request_changes",
+		mcp.WithDescription("Get a merge request along with its file diffs"),
+		mcp.WithString("project_path",
+			mcp.Required(),
+			mcp.Description("GitLab project path (e.g., 'namespace/project-name')"),
+		),
+		mcp.WithNumber("mr_iid",
+			mcp.Required(),
+			mcp.Description("Merge request internal ID (IID)"),
+		),
+	)
+
+	s.AddTool(getMergeRequestChangesTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		ctx = mdcForTool(ctx, "get_merge_request_changes")
+		args := request.GetArguments()
+		debugLogger.DebugContext(ctx, "Received get_merge_request_changes tool request", "args", args)
+
+		projectPath, ok := args["project_path"].(string)
+		if !ok || projectPath == "" {
+			debugLogger.ErrorContext(ctx, "project_path is not a valid string", "value", args["project_path"])
+			return mcp.NewToolResultError("project_path must be a non-empty string"), nil
+		}
+
+		mrIIDFloat, ok := args["mr_iid"].(float64)
+		if !ok {
+			debugLogger.ErrorContext(ctx, "mr_iid is missing or not a number", "value", args["mr_iid"])
+			return mcp.NewToolResultError("mr_iid must be a number"), nil
+		}
+		mrIID := int64(mrIIDFloat)
+
+		debugLogger.DebugContext(ctx, "Processing get_merge_request_changes request", "project_path", projectPath, "mr_iid", mrIID)
+
+		mr, err := appInstance.GetMergeRequestChanges(projectPath, mrIID)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to get merge request changes", "error", err, "project_path", projectPath, "mr_iid", mrIID)
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to get merge request changes: %v", err)), nil
+		}
+
+		jsonData, err := json.Marshal(mr)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to marshal merge request to JSON", "error", err)
+			return mcp.NewToolResultError("Failed to format merge request response"), nil
+		}
+
+		debugLogger.InfoContext(ctx, "Successfully retrieved merge request changes", "mr_iid", mrIID, "project_path", projectPath)
+		return mcp.NewToolResultText(string(jsonData)), nil
+	})
+}
+
+// setupGetMergeRequestCommitsTool creates and registers the get_merge_request_commits tool.
+func setupGetMergeRequestCommitsTool(s *server.MCPServer, appInstance *app.App, debugLogger *slog.Logger) {
+	getMergeRequestCommitsTool := mcp.NewTool("get_merge_request_commits",
+		mcp.WithDescription("List the commits that make up a merge request"),
+		mcp.WithString("project_path",
+			mcp.Required(),
+			mcp.Description("GitLab project path (e.g., 'namespace/project-name')"),
+		),
+		mcp.WithNumber("mr_iid",
+			mcp.Required(),
+			mcp.Description("Merge request internal ID (IID)"),
+		),
+	)
+
+	s.AddTool(getMergeRequestCommitsTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		ctx = mdcForTool(ctx, "get_merge_request_commits")
+		args := request.GetArguments()
+		debugLogger.DebugContext(ctx, "Received get_merge_request_commits tool request", "args", args)
+
+		projectPath, ok := args["project_path"].(string)
+		if !ok || projectPath == "" {
+			debugLogger.ErrorContext(ctx, "project_path is not a valid string", "value", args["project_path"])
+			return mcp.NewToolResultError("project_path must be a non-empty string"), nil
+		}
+
+		mrIIDFloat, ok := args["mr_iid"].(float64)
+		if !ok {
+			debugLogger.ErrorContext(ctx, "mr_iid is missing or not a number", "value", args["mr_iid"])
+			return mcp.NewToolResultError("mr_iid must be a number"), nil
+		}
+		mrIID := int64(mrIIDFloat)
+
+		debugLogger.DebugContext(ctx, "Processing get_merge_request_commits request", "project_path", projectPath, "mr_iid", mrIID)
+
+		commits, err := appInstance.GetMergeRequestCommits(projectPath, mrIID)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to get merge request commits", "error", err, "project_path", projectPath, "mr_iid", mrIID)
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to get merge request commits: %v", err)), nil
+		}
+
+		jsonData, err := json.Marshal(commits)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to marshal merge request commits to JSON", "error", err)
+			return mcp.NewToolResultError("Failed to format merge request commits response"), nil
+		}
+
+		debugLogger.InfoContext(ctx, "Successfully retrieved merge request commits", "count", len(commits), "mr_iid", mrIID)
+		return mcp.NewToolResultText(string(jsonData)), nil
+	})
+}
+
+// setupCreateMergeRequestTool creates and registers the create_merge_request tool.
+func setupCreateMergeRequestTool(s *server.MCPServer, appInstance *app.App, debugLogger *slog.Logger) {
+	createMergeRequestTool := mcp.NewTool("create_merge_request",
+		mcp.WithDescription("Create a new merge request for a GitLab project by project path"),
+		mcp.WithString("project_path",
+			mcp.Required(),
+			mcp.Description("GitLab project path (e.g., 'namespace/project-name')"),
+		),
+		mcp.WithString("source_branch",
+			mcp.Required(),
+			mcp.Description("Branch containing the changes"),
+		),
+		mcp.WithString("target_branch",
+			mcp.Required(),
+			mcp.Description("Branch the changes should be merged into"),
+		),
+		mcp.WithString("title",
+			mcp.Required(),
+			mcp.Description("Merge request title"),
+		),
+		mcp.WithString("description",
+			mcp.Description("Merge request description"),
+		),
+		mcp.WithArray("labels",
+			mcp.Description("Array of labels to assign to the merge request"),
+		),
+		mcp.WithArray("assignees",
+			mcp.Description("Array of assignee usernames"),
+		),
+		mcp.WithArray("reviewers",
+			mcp.Description("Array of reviewer usernames"),
+		),
+		mcp.WithString("milestone",
+			mcp.Description("Milestone to assign, as either a title or a numeric ID"),
+		),
+		mcp.WithString("related_issue",
+			mcp.Description("Issue to link, e.g. '#123' or 'group/project#123'; its labels/milestone/"+
+				"assignees can be copied via the copy_issue_* options, and it is closed when this MR merges"),
+		),
+		mcp.WithBoolean("copy_issue_labels",
+			mcp.Description("Copy related_issue's labels onto the merge request"),
+		),
+		mcp.WithBoolean("copy_issue_milestone",
+			mcp.Description("Copy related_issue's milestone onto the merge request"),
+		),
+		mcp.WithBoolean("copy_issue_assignees",
+			mcp.Description("Copy related_issue's assignees onto the merge request"),
+		),
+		mcp.WithBoolean("remove_source_branch",
+			mcp.Description("Remove the source branch automatically when the merge request is merged"),
+		),
+		mcp.WithBoolean("draft",
+			mcp.Description("Create the merge request as a draft"),
+		),
+	)
+
+	s.AddTool(createMergeRequestTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		ctx = mdcForTool(ctx, "create_merge_request")
+		args := request.GetArguments()
+		debugLogger.DebugContext(ctx, "Received create_merge_request tool request", "args", args)
+
+		projectPath, ok := args["project_path"].(string)
+		if !ok || projectPath == "" {
+			debugLogger.ErrorContext(ctx, "project_path is not a valid string", "value", args["project_path"])
+			return mcp.NewToolResultError("project_path must be a non-empty string"), nil
+		}
+
+		sourceBranch, ok := args["source_branch"].(string)
+		if !ok || sourceBranch == "" {
+			debugLogger.ErrorContext(ctx, "source_branch is not a valid string", "value", args["source_branch"])
+			return mcp.NewToolResultError("source_branch must be a non-empty string"), nil
+		}
+
+		targetBranch, ok := args["target_branch"].(string)
+		if !ok || targetBranch == "" {
+			debugLogger.ErrorContext(ctx, "target_branch is not a valid string", "value", args["target_branch"])
+			return mcp.NewToolResultError("target_branch must be a non-empty string"), nil
+		}
+
+		title, ok := args["title"].(string)
+		if !ok || title == "" {
+			debugLogger.ErrorContext(ctx, "title is not a valid string", "value", args["title"])
+			return mcp.NewToolResultError("title must be a non-empty string"), nil
+		}
+
+		opts := extractCreateMergeRequestOptions(args, sourceBranch, targetBranch, title)
+
+		debugLogger.DebugContext(ctx, "Processing create_merge_request request", "project_path", projectPath, "title", title)
+
+		mr, err := appInstance.CreateProjectMergeRequest(projectPath, opts)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to create merge request", "error", err, "project_path", projectPath, "title", title)
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to create merge request: %v", err)), nil
+		}
+
+		jsonData, err := json.Marshal(mr)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to marshal merge request to JSON", "error", err)
+			return mcp.NewToolResultError("Failed to format merge request response"), nil
+		}
+
+		debugLogger.InfoContext(ctx, "Successfully created merge request", "iid", mr.IID, "project_path", projectPath)
+		return mcp.NewToolResultText(string(jsonData)), nil
+	})
+}
+
+// extractCreateMergeRequestOptions extracts create merge request options from arguments.
+func extractCreateMergeRequestOptions(
+	args map[string]interface{}, sourceBranch, targetBranch, title string,
+) *app.CreateMergeRequestOptions {
+	opts := &app.CreateMergeRequestOptions{
+		SourceBranch: sourceBranch,
+		TargetBranch: targetBranch,
+		Title:        title,
+	}
+
+	if description, ok := args["description"].(string); ok {
+		opts.Description = description
+	}
+	opts.Labels = extractStringArray(args, "labels")
+
+	for _, username := range extractStringArray(args, "assignees") {
+		opts.Assignees = append(opts.Assignees, username)
+	}
+	for _, username := range extractStringArray(args, "reviewers") {
+		opts.Reviewers = append(opts.Reviewers, username)
+	}
+
+	if milestone, ok := args["milestone"].(string); ok && milestone != "" {
+		opts.Milestone = milestone
+	} else if milestoneFloat, ok := args["milestone"].(float64); ok {
+		opts.Milestone = milestoneFloat
+	}
+
+	if relatedIssue, ok := args["related_issue"].(string); ok {
+		opts.RelatedIssue = relatedIssue
+	}
+	if copyLabels, ok := args["copy_issue_labels"].(bool); ok {
+		opts.CopyIssueLabels = copyLabels
+	}
+	if copyMilestone, ok := args["copy_issue_milestone"].(bool); ok {
+		opts.CopyIssueMilestone = copyMilestone
+	}
+	if copyAssignees, ok := args["copy_issue_assignees"].(bool); ok {
+		opts.CopyIssueAssignees = copyAssignees
+	}
+	if removeSourceBranch, ok := args["remove_source_branch"].(bool); ok {
+		opts.RemoveSourceBranch = removeSourceBranch
+	}
+	if draft, ok := args["draft"].(bool); ok {
+		opts.Draft = draft
+	}
+
+	return opts
+}
+
+// setupUpdateMergeRequestTool creates and registers the update_merge_request tool.
+func setupUpdateMergeRequestTool(s *server.MCPServer, appInstance *app.App, debugLogger *slog.Logger) {
+	updateMergeRequestTool := mcp.NewTool("update_merge_request",
+		mcp.WithDescription("Update an existing merge request for a GitLab project by project path"),
+		mcp.WithString("project_path",
+			mcp.Required(),
+			mcp.Description("GitLab project path (e.g., 'namespace/project-name')"),
+		),
+		mcp.WithNumber("mr_iid",
+			mcp.Required(),
+			mcp.Description("Merge request internal ID (IID) to update"),
+		),
+		mcp.WithString("title",
+			mcp.Description("Updated merge request title"),
+		),
+		mcp.WithString("description",
+			mcp.Description("Updated merge request description"),
+		),
+		mcp.WithString("state",
+			mcp.Description("Merge request state event: 'close' or 'reopen'"),
+		),
+		mcp.WithString("target_branch",
+			mcp.Description("Updated target branch"),
+		),
+		mcp.WithArray("labels",
+			mcp.Description(
+				"Labels to assign. Each entry is either a bare name (replaces all labels) or "+
+					"+name/-name to add/remove a single label without touching the rest",
+			),
+		),
+		mcp.WithArray("assignees",
+			mcp.Description(
+				"Assignee usernames. Each entry is either a bare username (replaces all assignees) or "+
+					"+username/-username to add/remove a single assignee without touching the rest",
+			),
+		),
+		mcp.WithArray("reviewers",
+			mcp.Description(
+				"Reviewer usernames. Each entry is either a bare username (replaces all reviewers) or "+
+					"+username/-username to add/remove a single reviewer without touching the rest",
+			),
+		),
+	)
+
+	s.AddTool(updateMergeRequestTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		ctx = mdcForTool(ctx, "update_merge_request")
+		args := request.GetArguments()
+		debugLogger.DebugContext(ctx, "Received update_merge_request tool request", "args", args)
+
+		projectPath, ok := args["project_path"].(string)
+		if !ok || projectPath == "" {
+			debugLogger.ErrorContext(ctx, "project_path is not a valid string", "value", args["project_path"])
+			return mcp.NewToolResultError("project_path must be a non-empty string"), nil
+		}
+
+		mrIIDFloat, ok := args["mr_iid"].(float64)
+		if !ok {
+			debugLogger.ErrorContext(ctx, "mr_iid is missing or not a number", "value", args["mr_iid"])
+			return mcp.NewToolResultError("mr_iid must be a number"), nil
+		}
+		mrIID := int64(mrIIDFloat)
+
+		opts := extractUpdateMergeRequestOptions(args)
+
+		debugLogger.DebugContext(ctx, "Processing update_merge_request request", "project_path", projectPath, "mr_iid", mrIID)
+
+		mr, err := appInstance.UpdateProjectMergeRequest(projectPath, mrIID, opts)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to update merge request", "error", err, "project_path", projectPath, "mr_iid", mrIID)
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to update merge request: %v", err)), nil
+		}
+
+		jsonData, err := json.Marshal(mr)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to marshal merge request to JSON", "error", err)
+			return mcp.NewToolResultError("Failed to format merge request response"), nil
+		}
+
+		debugLogger.InfoContext(ctx, "Successfully updated merge request", "mr_iid", mrIID, "project_path", projectPath)
+		return mcp.NewToolResultText(string(jsonData)), nil
+	})
+}
+
+// extractUpdateMergeRequestOptions extracts update merge request options from arguments.
+func extractUpdateMergeRequestOptions(args map[string]interface{}) *app.UpdateMergeRequestOptions {
+	opts := &app.UpdateMergeRequestOptions{}
+
+	if title, ok := args["title"].(string); ok && title != "" {
+		opts.Title = title
+	}
+	if description, ok := args["description"].(string); ok {
+		opts.Description = description
+	}
+	if state, ok := args["state"].(string); ok && state != "" {
+		opts.State = state
+	}
+	if targetBranch, ok := args["target_branch"].(string); ok && targetBranch != "" {
+		opts.TargetBranch = targetBranch
+	}
+	opts.Labels = extractStringArray(args, "labels")
+	opts.Assignees = extractStringArray(args, "assignees")
+	opts.Reviewers = extractStringArray(args, "reviewers")
+
+	return opts
+}
+
+// extractStringArray extracts a string array argument, ignoring any non-string elements.
+func extractStringArray(args map[string]interface{}, key string) []string {
+	raw, ok := args[key].([]interface{})
+	if !ok {
+		return nil
+	}
+
+	result := make([]string, 0, len(raw))
+	for _, item := range raw {
+		if str, ok := item.(string); ok {
+			result = append(result, str)
+		}
+	}
+	return result
+}
+
+// setupApproveMergeRequestTool creates and registers the approve_merge_request tool.
+func setupApproveMergeRequestTool(s *server.MCPServer, appInstance *app.App, debugLogger *slog.Logger) {
+	approveMergeRequestTool := mcp.NewTool("approve_merge_request",
+		mcp.WithDescription("Approve a merge request on behalf of the authenticated user"),
+		mcp.WithString("project_path",
+			mcp.Required(),
+			mcp.Description("GitLab project path (e.g., 'namespace/project-name')"),
+		),
+		mcp.WithNumber("mr_iid",
+			mcp.Required(),
+			mcp.Description("Merge request internal ID (IID)"),
+		),
+	)
+
+	s.AddTool(approveMergeRequestTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		ctx = mdcForTool(ctx, "approve_merge_request")
+		args := request.GetArguments()
+		debugLogger.DebugContext(ctx, "Received approve_merge_request tool request", "args", args)
+
+		projectPath, ok := args["project_path"].(string)
+		if !ok || projectPath == "" {
+			debugLogger.ErrorContext(ctx, "project_path is not a valid string", "value", args["project_path"])
+			return mcp.NewToolResultError("project_path must be a non-empty string"), nil
+		}
+
+		mrIIDFloat, ok := args["mr_iid"].(float64)
+		if !ok {
+			debugLogger.ErrorContext(ctx, "mr_iid is missing or not a number", "value", args["mr_iid"])
+			return mcp.NewToolResultError("mr_iid must be a number"), nil
+		}
+		mrIID := int64(mrIIDFloat)
+
+		debugLogger.DebugContext(ctx, "Processing approve_merge_request request", "project_path", projectPath, "mr_iid", mrIID)
+
+		if err := appInstance.ApproveProjectMergeRequest(projectPath, mrIID); err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to approve merge request", "error", err, "project_path", projectPath, "mr_iid", mrIID)
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to approve merge request: %v", err)), nil
+		}
+
+		debugLogger.InfoContext(ctx, "Successfully approved merge request", "mr_iid", mrIID, "project_path", projectPath)
+		return mcp.NewToolResultText(fmt.Sprintf("Merge request !%d approved", mrIID)), nil
+	})
+}
+
+// setupGetMergeRequestApprovalsTool creates and registers the merge_request_approvals_get tool.
+func setupGetMergeRequestApprovalsTool(s *server.MCPServer, appInstance *app.App, debugLogger *slog.Logger) {
+	getApprovalsTool := mcp.NewTool("merge_request_approvals_get",
+		mcp.WithDescription("Get the approval status of a merge request (who has approved, who still needs to)"),
+		mcp.WithString("project_path",
+			mcp.Required(),
+			mcp.Description("GitLab project path (e.g., 'namespace/project-name')"),
+		),
+		mcp.WithNumber("mr_iid",
+			mcp.Required(),
+			mcp.Description("Merge request internal ID (IID)"),
+		),
+	)
+
+	s.AddTool(getApprovalsTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		ctx = mdcForTool(ctx, "merge_request_approvals_get")
+		args := request.GetArguments()
+		debugLogger.DebugContext(ctx, "Received merge_request_approvals_get tool request", "args", args)
+
+		projectPath, ok := args["project_path"].(string)
+		if !ok || projectPath == "" {
+			debugLogger.ErrorContext(ctx, "project_path is not a valid string", "value", args["project_path"])
+			return mcp.NewToolResultError("project_path must be a non-empty string"), nil
+		}
+
+		mrIIDFloat, ok := args["mr_iid"].(float64)
+		if !ok {
+			debugLogger.ErrorContext(ctx, "mr_iid is missing or not a number", "value", args["mr_iid"])
+			return mcp.NewToolResultError("mr_iid must be a number"), nil
+		}
+		mrIID := int64(mrIIDFloat)
+
+		status, err := appInstance.GetMergeRequestApprovals(projectPath, mrIID)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to get merge request approval status", "error", err, "project_path", projectPath, "mr_iid", mrIID)
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to get merge request approval status: %v", err)), nil
+		}
+
+		jsonData, err := json.Marshal(status)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to marshal approval status", "error", err)
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to marshal approval status: %v", err)), nil
+		}
+
+		debugLogger.InfoContext(ctx, "Successfully retrieved merge request approval status", "mr_iid", mrIID, "project_path", projectPath)
+		return mcp.NewToolResultText(string(jsonData)), nil
+	})
+}
+
+// setupUnapproveMergeRequestTool creates and registers the merge_request_unapprove tool.
+func setupUnapproveMergeRequestTool(s *server.MCPServer, appInstance *app.App, debugLogger *slog.Logger) {
+	unapproveTool := mcp.NewTool("merge_request_unapprove",
+		mcp.WithDescription("Withdraw the authenticated user's approval of a merge request"),
+		mcp.WithString("project_path",
+			mcp.Required(),
+			mcp.Description("GitLab project path (e.g., 'namespace/project-name')"),
+		),
+		mcp.WithNumber("mr_iid",
+			mcp.Required(),
+			mcp.Description("Merge request internal ID (IID)"),
+		),
+	)
+
+	s.AddTool(unapproveTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		ctx = mdcForTool(ctx, "merge_request_unapprove")
+		args := request.GetArguments()
+		debugLogger.DebugContext(ctx, "Received merge_request_unapprove tool request", "args", args)
+
+		projectPath, ok := args["project_path"].(string)
+		if !ok || projectPath == "" {
+			debugLogger.ErrorContext(ctx, "project_path is not a valid string", "value", args["project_path"])
+			return mcp.NewToolResultError("project_path must be a non-empty string"), nil
+		}
+
+		mrIIDFloat, ok := args["mr_iid"].(float64)
+		if !ok {
+			debugLogger.ErrorContext(ctx, "mr_iid is missing or not a number", "value", args["mr_iid"])
+			return mcp.NewToolResultError("mr_iid must be a number"), nil
+		}
+		mrIID := int64(mrIIDFloat)
+
+		if err := appInstance.UnapproveProjectMergeRequest(projectPath, mrIID); err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to unapprove merge request", "error", err, "project_path", projectPath, "mr_iid", mrIID)
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to unapprove merge request: %v", err)), nil
+		}
+
+		debugLogger.InfoContext(ctx, "Successfully unapproved merge request", "mr_iid", mrIID, "project_path", projectPath)
+		return mcp.NewToolResultText(fmt.Sprintf("Merge request !%d unapproved", mrIID)), nil
+	})
+}
+
+// setupChangeApprovalConfigurationTool creates and registers the merge_request_approval_configuration_change tool.
+func setupChangeApprovalConfigurationTool(s *server.MCPServer, appInstance *app.App, debugLogger *slog.Logger) {
+	changeConfigTool := mcp.NewTool("merge_request_approval_configuration_change",
+		mcp.WithDescription("Change a merge request's approvals-required count"),
+		mcp.WithString("project_path",
+			mcp.Required(),
+			mcp.Description("GitLab project path (e.g., 'namespace/project-name')"),
+		),
+		mcp.WithNumber("mr_iid",
+			mcp.Required(),
+			mcp.Description("Merge request internal ID (IID)"),
+		),
+		mcp.WithNumber("approvals_required",
+			mcp.Description("Number of approvals required before the merge request can be merged"),
+		),
+	)
+
+	s.AddTool(changeConfigTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		ctx = mdcForTool(ctx, "merge_request_approval_configuration_change")
+		args := request.GetArguments()
+		debugLogger.DebugContext(ctx, "Received merge_request_approval_configuration_change tool request", "args", args)
+
+		projectPath, ok := args["project_path"].(string)
+		if !ok || projectPath == "" {
+			debugLogger.ErrorContext(ctx, "project_path is not a valid string", "value", args["project_path"])
+			return mcp.NewToolResultError("project_path must be a non-empty string"), nil
+		}
+
+		mrIIDFloat, ok := args["mr_iid"].(float64)
+		if !ok {
+			debugLogger.ErrorContext(ctx, "mr_iid is missing or not a number", "value", args["mr_iid"])
+			return mcp.NewToolResultError("mr_iid must be a number"), nil
+		}
+		mrIID := int64(mrIIDFloat)
+
+		opts := &app.ChangeApprovalConfigurationOptions{}
+		if approvalsRequiredFloat, ok := args["approvals_required"].(float64); ok {
+			approvalsRequired := int64(approvalsRequiredFloat)
+			opts.ApprovalsRequired = &approvalsRequired
+		}
+		status, err := appInstance.ChangeMergeRequestApprovalConfiguration(projectPath, mrIID, opts)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to change merge request approval configuration", "error", err, "project_path", projectPath, "mr_iid", mrIID)
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to change merge request approval configuration: %v", err)), nil
+		}
+
+		jsonData, err := json.Marshal(status)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to marshal approval status", "error", err)
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to marshal approval status: %v", err)), nil
+		}
+
+		debugLogger.InfoContext(ctx, "Successfully changed merge request approval configuration", "mr_iid", mrIID, "project_path", projectPath)
+		return mcp.NewToolResultText(string(jsonData)), nil
+	})
+}
+
+// setupListApprovalRulesTool creates and registers the approval_rules_list tool.
+func setupListApprovalRulesTool(s *server.MCPServer, appInstance *app.App, debugLogger *slog.Logger) {
+	listRulesTool := mcp.NewTool("approval_rules_list",
+		mcp.WithDescription("List the project-level merge request approval rules"),
+		mcp.WithString("project_path",
+			mcp.Required(),
+			mcp.Description("GitLab project path (e.g., 'namespace/project-name')"),
+		),
+	)
+
+	s.AddTool(listRulesTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		ctx = mdcForTool(ctx, "approval_rules_list")
+		args := request.GetArguments()
+		debugLogger.DebugContext(ctx, "Received approval_rules_list tool request", "args", args)
+
+		projectPath, ok := args["project_path"].(string)
+		if !ok || projectPath == "" {
+			debugLogger.ErrorContext(ctx, "project_path is not a valid string", "value", args["project_path"])
+			return mcp.NewToolResultError("project_path must be a non-empty string"), nil
+		}
+
+		rules, err := appInstance.ListProjectApprovalRules(projectPath)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to list project approval rules", "error", err, "project_path", projectPath)
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to list project approval rules: %v", err)), nil
+		}
+
+		jsonData, err := json.Marshal(rules)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to marshal approval rules", "error", err)
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to marshal approval rules: %v", err)), nil
+		}
+
+		debugLogger.InfoContext(ctx, "Successfully listed project approval rules", "count", len(rules), "project_path", projectPath)
+		return mcp.NewToolResultText(string(jsonData)), nil
+	})
+}
+
+// parseApprovalRuleOptions extracts the shared name/approvals_required/user_ids/group_ids fields
+// used by the create and update approval rule tools.
+func parseApprovalRuleOptions(args map[string]any) *app.ApprovalRuleOptions {
+	opts := &app.ApprovalRuleOptions{}
+
+	if name, ok := args["name"].(string); ok {
+		opts.Name = name
+	}
+	if approvalsRequiredFloat, ok := args["approvals_required"].(float64); ok {
+		opts.ApprovalsRequired = int64(approvalsRequiredFloat)
+	}
+	if userIDsRaw, ok := args["user_ids"].([]any); ok {
+		for _, id := range userIDsRaw {
+			if idFloat, ok := id.(float64); ok {
+				opts.UserIDs = append(opts.UserIDs, int64(idFloat))
+			}
+		}
+	}
+	if groupIDsRaw, ok := args["group_ids"].([]any); ok {
+		for _, id := range groupIDsRaw {
+			if idFloat, ok := id.(float64); ok {
+				opts.GroupIDs = append(opts.GroupIDs, int64(idFloat))
+			}
+		}
+	}
+
+	return opts
+}
+
+// setupCreateApprovalRuleTool creates and registers the approval_rule_create tool.
+func setupCreateApprovalRuleTool(s *server.MCPServer, appInstance *app.App, debugLogger *slog.Logger) {
+	createRuleTool := mcp.NewTool("approval_rule_create",
+		mcp.WithDescription("Create a project-level merge request approval rule"),
+		mcp.WithString("project_path",
+			mcp.Required(),
+			mcp.Description("GitLab project path (e.g., 'namespace/project-name')"),
+		),
+		mcp.WithString("name",
+			mcp.Required(),
+			mcp.Description("Name of the approval rule"),
+		),
+		mcp.WithNumber("approvals_required",
+			mcp.Description("Number of approvals required by this rule"),
+		),
+		mcp.WithArray("user_ids",
+			mcp.Description("IDs of users eligible to approve under this rule"),
+		),
+		mcp.WithArray("group_ids",
+			mcp.Description("IDs of groups eligible to approve under this rule"),
+		),
+	)
+
+	s.AddTool(createRuleTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		ctx = mdcForTool(ctx, "approval_rule_create")
+		args := request.GetArguments()
+		debugLogger.DebugContext(ctx, "Received approval_rule_create tool request", "args", args)
+
+		projectPath, ok := args["project_path"].(string)
+		if !ok || projectPath == "" {
+			debugLogger.ErrorContext(ctx, "project_path is not a valid string", "value", args["project_path"])
+			return mcp.NewToolResultError("project_path must be a non-empty string"), nil
+		}
+
+		opts := parseApprovalRuleOptions(args)
+
+		rule, err := appInstance.CreateProjectApprovalRule(projectPath, opts)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to create project approval rule", "error", err, "project_path", projectPath)
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to create project approval rule: %v", err)), nil
+		}
+
+		jsonData, err := json.Marshal(rule)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to marshal approval rule", "error", err)
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to marshal approval rule: %v", err)), nil
+		}
+
+		debugLogger.InfoContext(ctx, "Successfully created project approval rule", "id", rule.ID, "project_path", projectPath)
+		return mcp.NewToolResultText(string(jsonData)), nil
+	})
+}
+
+// setupUpdateApprovalRuleTool creates and registers the approval_rule_update tool.
+func setupUpdateApprovalRuleTool(s *server.MCPServer, appInstance *app.App, debugLogger *slog.Logger) {
+	updateRuleTool := mcp.NewTool("approval_rule_update",
+		mcp.WithDescription("Update a project-level merge request approval rule"),
+		mcp.WithString("project_path",
+			mcp.Required(),
+			mcp.Description("GitLab project path (e.g., 'namespace/project-name')"),
+		),
+		mcp.WithNumber("rule_id",
+			mcp.Required(),
+			mcp.Description("ID of the approval rule to update"),
+		),
+		mcp.WithString("name",
+			mcp.Description("New name for the approval rule"),
+		),
+		mcp.WithNumber("approvals_required",
+			mcp.Description("New number of approvals required by this rule"),
+		),
+		mcp.WithArray("user_ids",
+			mcp.Description("IDs of users eligible to approve under this rule"),
+		),
+		mcp.WithArray("group_ids",
+			mcp.Description("IDs of groups eligible to approve under this rule"),
+		),
+	)
+
+	s.AddTool(updateRuleTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		ctx = mdcForTool(ctx, "approval_rule_update")
+		args := request.GetArguments()
+		debugLogger.DebugContext(ctx, "Received approval_rule_update tool request", "args", args)
+
+		projectPath, ok := args["project_path"].(string)
+		if !ok || projectPath == "" {
+			debugLogger.ErrorContext(ctx, "project_path is not a valid string", "value", args["project_path"])
+			return mcp.NewToolResultError("project_path must be a non-empty string"), nil
+		}
+
+		ruleIDFloat, ok := args["rule_id"].(float64)
+		if !ok {
+			debugLogger.ErrorContext(ctx, "rule_id is missing or not a number", "value", args["rule_id"])
+			return mcp.NewToolResultError("rule_id must be a number"), nil
+		}
+		ruleID := int64(ruleIDFloat)
+
+		opts := parseApprovalRuleOptions(args)
+
+		rule, err := appInstance.UpdateProjectApprovalRule(projectPath, ruleID, opts)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to update project approval rule", "error", err, "project_path", projectPath, "rule_id", ruleID)
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to update project approval rule: %v", err)), nil
+		}
+
+		jsonData, err := json.Marshal(rule)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to marshal approval rule", "error", err)
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to marshal approval rule: %v", err)), nil
+		}
+
+		debugLogger.InfoContext(ctx, "Successfully updated project approval rule", "id", rule.ID, "project_path", projectPath)
+		return mcp.NewToolResultText(string(jsonData)), nil
+	})
+}
+
+// setupDeleteApprovalRuleTool creates and registers the approval_rule_delete tool.
+func setupDeleteApprovalRuleTool(s *server.MCPServer, appInstance *app.App, debugLogger *slog.Logger) {
+	deleteRuleTool := mcp.NewTool("approval_rule_delete",
+		mcp.WithDescription("Delete a project-level merge request approval rule"),
+		mcp.WithString("project_path",
+			mcp.Required(),
+			mcp.Description("GitLab project path (e.g., 'namespace/project-name')"),
+		),
+		mcp.WithNumber("rule_id",
+			mcp.Required(),
+			mcp.Description("ID of the approval rule to delete"),
+		),
+	)
+
+	s.AddTool(deleteRuleTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		ctx = mdcForTool(ctx, "approval_rule_delete")
+		args := request.GetArguments()
+		debugLogger.DebugContext(ctx, "Received approval_rule_delete tool request", "args", args)
+
+		projectPath, ok := args["project_path"].(string)
+		if !ok || projectPath == "" {
+			debugLogger.ErrorContext(ctx, "project_path is not a valid string", "value", args["project_path"])
+			return mcp.NewToolResultError("project_path must be a non-empty string"), nil
+		}
+
+		ruleIDFloat, ok := args["rule_id"].(float64)
+		if !ok {
+			debugLogger.ErrorContext(ctx, "rule_id is missing or not a number", "value", args["rule_id"])
+			return mcp.NewToolResultError("rule_id must be a number"), nil
+		}
+		ruleID := int64(ruleIDFloat)
+
+		if err := appInstance.DeleteProjectApprovalRule(projectPath, ruleID); err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to delete project approval rule", "error", err, "project_path", projectPath, "rule_id", ruleID)
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to delete project approval rule: %v", err)), nil
+		}
+
+		debugLogger.InfoContext(ctx, "Successfully deleted project approval rule", "id", ruleID, "project_path", projectPath)
+		return mcp.NewToolResultText(fmt.Sprintf("Approval rule %d deleted", ruleID)), nil
+	})
+}
+
+// setupListMilestonesTool creates and registers the milestones_list tool.
+func setupListMilestonesTool(s *server.MCPServer, appInstance *app.App, debugLogger *slog.Logger) {
+	listMilestonesTool := mcp.NewTool("milestones_list",
+		mcp.WithDescription("List a project's milestones, optionally filtered by state or search term"),
+		mcp.WithString("project_path",
+			mcp.Required(),
+			mcp.Description("GitLab project path (e.g., 'namespace/project-name')"),
+		),
+		mcp.WithString("state",
+			mcp.Description("Filter by state: 'active' or 'closed'"),
+		),
+		mcp.WithString("search",
+			mcp.Description("Filter milestones by title or description search term"),
+		),
+	)
+
+	s.AddTool(listMilestonesTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		ctx = mdcForTool(ctx, "milestones_list")
+		args := request.GetArguments()
+		debugLogger.DebugContext(ctx, "Received milestones_list tool request", "args", args)
+
+		projectPath, ok := args["project_path"].(string)
+		if !ok || projectPath == "" {
+			debugLogger.ErrorContext(ctx, "project_path is not a valid string", "value", args["project_path"])
+			return mcp.NewToolResultError("project_path must be a non-empty string"), nil
+		}
+
+		opts := &app.ListMilestonesOptions{}
+		if state, ok := args["state"].(string); ok {
+			opts.State = state
+		}
+		if search, ok := args["search"].(string); ok {
+			opts.Search = search
+		}
+
+		milestones, err := appInstance.ListProjectMilestones(projectPath, opts)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to list project milestones", "error", err, "project_path", projectPath)
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to list project milestones: %v", err)), nil
+		}
+
+		jsonData, err := json.Marshal(milestones)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to marshal milestones", "error", err)
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to marshal milestones: %v", err)), nil
+		}
+
+		debugLogger.InfoContext(ctx, "Successfully listed project milestones", "count", len(milestones), "project_path", projectPath)
+		return mcp.NewToolResultText(string(jsonData)), nil
+	})
+}
+
+// setupGetMilestoneByTitleTool creates and registers the milestone_get_by_title tool.
+func setupGetMilestoneByTitleTool(s *server.MCPServer, appInstance *app.App, debugLogger *slog.Logger) {
+	getMilestoneTool := mcp.NewTool("milestone_get_by_title",
+		mcp.WithDescription("Resolve a project milestone by its exact title"),
+		mcp.WithString("project_path",
+			mcp.Required(),
+			mcp.Description("GitLab project path (e.g., 'namespace/project-name')"),
+		),
+		mcp.WithString("title",
+			mcp.Required(),
+			mcp.Description("Exact milestone title to resolve"),
+		),
+	)
+
+	s.AddTool(getMilestoneTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		ctx = mdcForTool(ctx, "milestone_get_by_title")
+		args := request.GetArguments()
+		debugLogger.DebugContext(ctx, "Received milestone_get_by_title tool request", "args", args)
+
+		projectPath, ok := args["project_path"].(string)
+		if !ok || projectPath == "" {
+			debugLogger.ErrorContext(ctx, "project_path is not a valid string", "value", args["project_path"])
+			return mcp.NewToolResultError("project_path must be a non-empty string"), nil
+		}
+
+		title, ok := args["title"].(string)
+		if !ok || title == "" {
+			debugLogger.ErrorContext(ctx, "title is missing or not a string", "value", args["title"])
+			return mcp.NewToolResultError("title must be a non-empty string"), nil
+		}
+
+		milestone, err := appInstance.ProjectMilestoneByTitle(projectPath, title)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to resolve milestone by title", "error", err, "project_path", projectPath, "title", title)
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to resolve milestone by title: %v", err)), nil
+		}
+
+		jsonData, err := json.Marshal(milestone)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to marshal milestone", "error", err)
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to marshal milestone: %v", err)), nil
+		}
+
+		debugLogger.InfoContext(ctx, "Successfully resolved milestone by title", "id", milestone.ID, "project_path", projectPath)
+		return mcp.NewToolResultText(string(jsonData)), nil
+	})
+}
+
+// setupCreateMilestoneTool creates and registers the milestone_create tool.
+func setupCreateMilestoneTool(s *server.MCPServer, appInstance *app.App, debugLogger *slog.Logger) {
+	createMilestoneTool := mcp.NewTool("milestone_create",
+		mcp.WithDescription("Create a new milestone in a GitLab project"),
+		mcp.WithString("project_path",
+			mcp.Required(),
+			mcp.Description("GitLab project path (e.g., 'namespace/project-name')"),
+		),
+		mcp.WithString("title",
+			mcp.Required(),
+			mcp.Description("Milestone title"),
+		),
+		mcp.WithString("description",
+			mcp.Description("Milestone description"),
+		),
+		mcp.WithString("start_date",
+			mcp.Description("Milestone start date (e.g., '2025-01-01')"),
+		),
+		mcp.WithString("due_date",
+			mcp.Description("Milestone due date (e.g., '2025-03-01')"),
+		),
+	)
+
+	s.AddTool(createMilestoneTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		ctx = mdcForTool(ctx, "milestone_create")
+		args := request.GetArguments()
+		debugLogger.DebugContext(ctx, "Received milestone_create tool request", "args", args)
+
+		projectPath, ok := args["project_path"].(string)
+		if !ok || projectPath == "" {
+			debugLogger.ErrorContext(ctx, "project_path is not a valid string", "value", args["project_path"])
+			return mcp.NewToolResultError("project_path must be a non-empty string"), nil
+		}
+
+		title, ok := args["title"].(string)
+		if !ok || title == "" {
+			debugLogger.ErrorContext(ctx, "title is missing or not a string", "value", args["title"])
+			return mcp.NewToolResultError("title must be a non-empty string"), nil
+		}
+
+		opts := &app.CreateMilestoneOptions{Title: title}
+		if description, ok := args["description"].(string); ok {
+			opts.Description = description
+		}
+		if startDate, ok := args["start_date"].(string); ok {
+			opts.StartDate = startDate
+		}
+		if dueDate, ok := args["due_date"].(string); ok {
+			opts.DueDate = dueDate
+		}
+
+		milestone, err := appInstance.CreateProjectMilestone(projectPath, opts)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to create project milestone", "error", err, "project_path", projectPath)
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to create project milestone: %v", err)), nil
+		}
+
+		jsonData, err := json.Marshal(milestone)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to marshal milestone", "error", err)
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to marshal milestone: %v", err)), nil
+		}
+
+		debugLogger.InfoContext(ctx, "Successfully created project milestone", "id", milestone.ID, "project_path", projectPath)
+		return mcp.NewToolResultText(string(jsonData)), nil
+	})
+}
+
+// setupUpdateMilestoneTool creates and registers the milestone_update tool.
+func setupUpdateMilestoneTool(s *server.MCPServer, appInstance *app.App, debugLogger *slog.Logger) {
+	updateMilestoneTool := mcp.NewTool("milestone_update",
+		mcp.WithDescription("Update an existing project milestone"),
+		mcp.WithString("project_path",
+			mcp.Required(),
+			mcp.Description("GitLab project path (e.g., 'namespace/project-name')"),
+		),
+		mcp.WithNumber("milestone_id",
+			mcp.Required(),
+			mcp.Description("ID of the milestone to update"),
+		),
+		mcp.WithString("title",
+			mcp.Description("New title for the milestone"),
+		),
+		mcp.WithString("description",
+			mcp.Description("New description for the milestone"),
+		),
+		mcp.WithString("state",
+			mcp.Description("State event to apply: 'close' or 'activate'"),
+		),
+		mcp.WithString("start_date",
+			mcp.Description("New start date (e.g., '2025-01-01')"),
+		),
+		mcp.WithString("due_date",
+			mcp.Description("New due date (e.g., '2025-03-01')"),
+		),
+	)
+
+	s.AddTool(updateMilestoneTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		ctx = mdcForTool(ctx, "milestone_update")
+		args := request.GetArguments()
+		debugLogger.DebugContext(ctx, "Received milestone_update tool request", "args", args)
+
+		projectPath, ok := args["project_path"].(string)
+		if !ok || projectPath == "" {
+			debugLogger.ErrorContext(ctx, "project_path is not a valid string", "value", args["project_path"])
+			return mcp.NewToolResultError("project_path must be a non-empty string"), nil
+		}
+
+		milestoneIDFloat, ok := args["milestone_id"].(float64)
+		if !ok {
+			debugLogger.ErrorContext(ctx, "milestone_id is missing or not a number", "value", args["milestone_id"])
+			return mcp.NewToolResultError("milestone_id must be a number"), nil
+		}
+		milestoneID := int64(milestoneIDFloat)
+
+		opts := &app.UpdateMilestoneOptions{}
+		if title, ok := args["title"].(string); ok {
+			opts.Title = title
+		}
+		if description, ok := args["description"].(string); ok {
+			opts.Description = description
+		}
+		if state, ok := args["state"].(string); ok {
+			opts.State = state
+		}
+		if startDate, ok := args["start_date"].(string); ok {
+			opts.StartDate = startDate
+		}
+		if dueDate, ok := args["due_date"].(string); ok {
+			opts.DueDate = dueDate
+		}
+
+		milestone, err := appInstance.UpdateProjectMilestone(projectPath, milestoneID, opts)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to update project milestone", "error", err, "project_path", projectPath, "milestone_id", milestoneID)
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to update project milestone: %v", err)), nil
+		}
+
+		jsonData, err := json.Marshal(milestone)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to marshal milestone", "error", err)
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to marshal milestone: %v", err)), nil
+		}
+
+		debugLogger.InfoContext(ctx, "Successfully updated project milestone", "id", milestone.ID, "project_path", projectPath)
+		return mcp.NewToolResultText(string(jsonData)), nil
+	})
+}
+
+// setupDeleteMilestoneTool creates and registers the milestone_delete tool.
+func setupDeleteMilestoneTool(s *server.MCPServer, appInstance *app.App, debugLogger *slog.Logger) {
+	deleteMilestoneTool := mcp.NewTool("milestone_delete",
+		mcp.WithDescription("Delete a milestone from a GitLab project"),
+		mcp.WithString("project_path",
+			mcp.Required(),
+			mcp.Description("GitLab project path (e.g., 'namespace/project-name')"),
+		),
+		mcp.WithNumber("milestone_id",
+			mcp.Required(),
+			mcp.Description("ID of the milestone to delete"),
+		),
+	)
+
+	s.AddTool(deleteMilestoneTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		ctx = mdcForTool(ctx, "milestone_delete")
+		args := request.GetArguments()
+		debugLogger.DebugContext(ctx, "Received milestone_delete tool request", "args", args)
+
+		projectPath, ok := args["project_path"].(string)
+		if !ok || projectPath == "" {
+			debugLogger.ErrorContext(ctx, "project_path is not a valid string", "value", args["project_path"])
+			return mcp.NewToolResultError("project_path must be a non-empty string"), nil
+		}
+
+		milestoneIDFloat, ok := args["milestone_id"].(float64)
+		if !ok {
+			debugLogger.ErrorContext(ctx, "milestone_id is missing or not a number", "value", args["milestone_id"])
+			return mcp.NewToolResultError("milestone_id must be a number"), nil
+		}
+		milestoneID := int64(milestoneIDFloat)
+
+		if err := appInstance.DeleteProjectMilestone(projectPath, milestoneID); err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to delete project milestone", "error", err, "project_path", projectPath, "milestone_id", milestoneID)
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to delete project milestone: %v", err)), nil
+		}
+
+		debugLogger.InfoContext(ctx, "Successfully deleted project milestone", "id", milestoneID, "project_path", projectPath)
+		return mcp.NewToolResultText(fmt.Sprintf("Milestone %d deleted", milestoneID)), nil
+	})
+}
+
+// setupMergeMergeRequestTool creates and registers the merge_merge_request tool.
+func setupMergeMergeRequestTool(s *server.MCPServer, appInstance *app.App, debugLogger *slog.Logger) {
+	mergeMergeRequestTool := mcp.NewTool("merge_merge_request",
+		mcp.WithDescription("Merge (accept) a merge request"),
+		mcp.WithString("project_path",
+			mcp.Required(),
+			mcp.Description("GitLab project path (e.g., 'namespace/project-name')"),
+		),
+		mcp.WithNumber("mr_iid",
+			mcp.Required(),
+			mcp.Description("Merge request internal ID (IID)"),
+		),
+		mcp.WithBoolean("squash",
+			mcp.Description("Squash the commits into a single commit when merging"),
+		),
+		mcp.WithBoolean("remove_source_branch",
+			mcp.Description("Remove the source branch after merging"),
+		),
+		mcp.WithBoolean("merge_when_pipeline_succeeds",
+			mcp.Description("Merge automatically once the pipeline for the merge request succeeds"),
+		),
+		mcp.WithString("merge_commit_message",
+			mcp.Description("Custom merge commit message"),
+		),
+		mcp.WithString("squash_commit_message",
+			mcp.Description("Custom commit message used when squash is true"),
+		),
+	)
+
+	s.AddTool(mergeMergeRequestTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		ctx = mdcForTool(ctx, "merge_merge_request")
+		args := request.GetArguments()
+		debugLogger.DebugContext(ctx, "Received merge_merge_request tool request", "args", args)
+
+		projectPath, ok := args["project_path"].(string)
+		if !ok || projectPath == "" {
+			debugLogger.ErrorContext(ctx, "project_path is not a valid string", "value", args["project_path"])
+			return mcp.NewToolResultError("project_path must be a non-empty string"), nil
+		}
+
+		mrIIDFloat, ok := args["mr_iid"].(float64)
+		if !ok {
+			debugLogger.ErrorContext(ctx, "mr_iid is missing or not a number", "value", args["mr_iid"])
+			return mcp.NewToolResultError("mr_iid must be a number"), nil
+		}
+		mrIID := int64(mrIIDFloat)
+
+		opts := &app.AcceptMergeRequestOptions{}
+		if squash, ok := args["squash"].(bool); ok {
+			opts.Squash = squash
+		}
+		if removeSourceBranch, ok := args["remove_source_branch"].(bool); ok {
+			opts.ShouldRemoveSourceBranch = removeSourceBranch
+		}
+		if mergeWhenPipelineSucceeds, ok := args["merge_when_pipeline_succeeds"].(bool); ok {
+			opts.MergeWhenPipelineSucceeds = mergeWhenPipelineSucceeds
+		}
+		if mergeCommitMessage, ok := args["merge_commit_message"].(string); ok {
+			opts.MergeCommitMessage = mergeCommitMessage
+		}
+		if squashCommitMessage, ok := args["squash_commit_message"].(string); ok {
+			opts.SquashCommitMessage = squashCommitMessage
+		}
+
+		debugLogger.DebugContext(ctx, "Processing merge_merge_request request", "project_path", projectPath, "mr_iid", mrIID)
+
+		mr, err := appInstance.AcceptProjectMergeRequest(projectPath, mrIID, opts)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to merge merge request", "error", err, "project_path", projectPath, "mr_iid", mrIID)
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to merge merge request: %v", err)), nil
+		}
+
+		jsonData, err := json.Marshal(mr)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to marshal merge request to JSON", "error", err)
+			return mcp.NewToolResultError("Failed to format merge request response"), nil
+		}
+
+		debugLogger.InfoContext(ctx, "Successfully merged merge request", "mr_iid", mrIID, "project_path", projectPath)
+		return mcp.NewToolResultText(string(jsonData)), nil
+	})
+}
+
+// setupCancelMergeRequestAutoMergeTool creates and registers the cancel_merge_request_auto_merge tool.
+func setupCancelMergeRequestAutoMergeTool(s *server.MCPServer, appInstance *app.App, debugLogger *slog.Logger) {
+	cancelAutoMergeTool := mcp.NewTool("cancel_merge_request_auto_merge",
+		mcp.WithDescription("Cancel a pending merge-when-pipeline-succeeds on a merge request"),
+		mcp.WithString("project_path",
+			mcp.Required(),
+			mcp.Description("GitLab project path (e.g., 'namespace/project-name')"),
+		),
+		mcp.WithNumber("mr_iid",
+			mcp.Required(),
+			mcp.Description("Merge request internal ID (IID)"),
+		),
+	)
+
+	s.AddTool(cancelAutoMergeTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		ctx = mdcForTool(ctx, "cancel_merge_request_auto_merge")
+		args := request.GetArguments()
+		debugLogger.DebugContext(ctx, "Received cancel_merge_request_auto_merge tool request", "args", args)
+
+		projectPath, ok := args["project_path"].(string)
+		if !ok || projectPath == "" {
+			debugLogger.ErrorContext(ctx, "project_path is not a valid string", "value", args["project_path"])
+			return mcp.NewToolResultError("project_path must be a non-empty string"), nil
+		}
+
+		mrIIDFloat, ok := args["mr_iid"].(float64)
+		if !ok {
+			debugLogger.ErrorContext(ctx, "mr_iid is missing or not a number", "value", args["mr_iid"])
+			return mcp.NewToolResultError("mr_iid must be a number"), nil
+		}
+		mrIID := int64(mrIIDFloat)
+
+		debugLogger.DebugContext(ctx, "Processing cancel_merge_request_auto_merge request",
+			"project_path", projectPath, "mr_iid", mrIID)
+
+		mr, err := appInstance.CancelMergeRequestAutoMerge(projectPath, mrIID)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to cancel merge request auto-merge",
+				"error", err, "project_path", projectPath, "mr_iid", mrIID)
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to cancel merge request auto-merge: %v", err)), nil
+		}
+
+		jsonData, err := json.Marshal(mr)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to marshal merge request to JSON", "error", err)
+			return mcp.NewToolResultError("Failed to format merge request response"), nil
+		}
+
+		debugLogger.InfoContext(ctx, "Successfully cancelled merge request auto-merge", "mr_iid", mrIID, "project_path", projectPath)
+		return mcp.NewToolResultText(string(jsonData)), nil
+	})
+}
+
+// setupCloseMergeRequestTool creates and registers the close_merge_request tool.
+func setupCloseMergeRequestTool(s *server.MCPServer, appInstance *app.App, debugLogger *slog.Logger) {
+	closeMergeRequestTool := mcp.NewTool("close_merge_request",
+		mcp.WithDescription("Close a merge request without merging it"),
+		mcp.WithString("project_path",
+			mcp.Required(),
+			mcp.Description("GitLab project path (e.g., 'namespace/project-name')"),
+		),
+		mcp.WithNumber("mr_iid",
+			mcp.Required(),
+			mcp.Description("Merge request internal ID (IID)"),
+		),
+	)
+
+	s.AddTool(closeMergeRequestTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		ctx = mdcForTool(ctx, "close_merge_request")
+		args := request.GetArguments()
+		debugLogger.DebugContext(ctx, "Received close_merge_request tool request", "args", args)
+
+		projectPath, ok := args["project_path"].(string)
+		if !ok || projectPath == "" {
+			debugLogger.ErrorContext(ctx, "project_path is not a valid string", "value", args["project_path"])
+			return mcp.NewToolResultError("project_path must be a non-empty string"), nil
+		}
+
+		mrIIDFloat, ok := args["mr_iid"].(float64)
+		if !ok {
+			debugLogger.ErrorContext(ctx, "mr_iid is missing or not a number", "value", args["mr_iid"])
+			return mcp.NewToolResultError("mr_iid must be a number"), nil
+		}
+		mrIID := int64(mrIIDFloat)
+
+		mr, err := appInstance.CloseMergeRequest(projectPath, mrIID)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to close merge request", "error", err, "project_path", projectPath, "mr_iid", mrIID)
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to close merge request: %v", err)), nil
+		}
+
+		jsonData, err := json.Marshal(mr)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to marshal merge request to JSON", "error", err)
+			return mcp.NewToolResultError("Failed to format merge request response"), nil
+		}
+
+		debugLogger.InfoContext(ctx, "Successfully closed merge request", "mr_iid", mrIID, "project_path", projectPath)
+		return mcp.NewToolResultText(string(jsonData)), nil
+	})
+}
+
+// setupRebaseMergeRequestTool creates and registers the rebase_merge_request tool.
+func setupRebaseMergeRequestTool(s *server.MCPServer, appInstance *app.App, debugLogger *slog.Logger) {
+	rebaseMergeRequestTool := mcp.NewTool("rebase_merge_request",
+		mcp.WithDescription("Schedule a rebase of a merge request's source branch onto its target branch"),
+		mcp.WithString("project_path",
+			mcp.Required(),
+			mcp.Description("GitLab project path (e.g., 'namespace/project-name')"),
+		),
+		mcp.WithNumber("mr_iid",
+			mcp.Required(),
+			mcp.Description("Merge request internal ID (IID)"),
+		),
+		mcp.WithBoolean("skip_ci",
+			mcp.Description("Skip CI pipelines for the rebase commit"),
+		),
+	)
+
+	s.AddTool(rebaseMergeRequestTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		ctx = mdcForTool(ctx, "rebase_merge_request")
+		args := request.GetArguments()
+		debugLogger.DebugContext(ctx, "Received rebase_merge_request tool request", "args", args)
+
+		projectPath, ok := args["project_path"].(string)
+		if !ok || projectPath == "" {
+			debugLogger.ErrorContext(ctx, "project_path is not a valid string", "value", args["project_path"])
+			return mcp.NewToolResultError("project_path must be a non-empty string"), nil
+		}
+
+		mrIIDFloat, ok := args["mr_iid"].(float64)
+		if !ok {
+			debugLogger.ErrorContext(ctx, "mr_iid is missing or not a number", "value", args["mr_iid"])
+			return mcp.NewToolResultError("mr_iid must be a number"), nil
+		}
+		mrIID := int64(mrIIDFloat)
+		skipCI, _ := args["skip_ci"].(bool)
+
+		if err := appInstance.RebaseMergeRequest(projectPath, mrIID, skipCI); err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to rebase merge request", "error", err, "project_path", projectPath, "mr_iid", mrIID)
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to rebase merge request: %v", err)), nil
+		}
+
+		debugLogger.InfoContext(ctx, "Successfully queued merge request rebase", "mr_iid", mrIID, "project_path", projectPath)
+		return mcp.NewToolResultText(fmt.Sprintf("Rebase queued for merge request !%d", mrIID)), nil
+	})
+}
+
+// setupMarkMergeRequestReadyTool creates and registers the mark_merge_request_ready tool.
+func setupMarkMergeRequestReadyTool(s *server.MCPServer, appInstance *app.App, debugLogger *slog.Logger) {
+	markReadyTool := mcp.NewTool("mark_merge_request_ready",
+		mcp.WithDescription("Remove the Draft/WIP status from a merge request by stripping its title prefix"),
+		mcp.WithString("project_path",
+			mcp.Required(),
+			mcp.Description("GitLab project path (e.g., 'namespace/project-name')"),
+		),
+		mcp.WithNumber("mr_iid",
+			mcp.Required(),
+			mcp.Description("Merge request internal ID (IID)"),
+		),
+	)
+
+	s.AddTool(markReadyTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		ctx = mdcForTool(ctx, "mark_merge_request_ready")
+		args := request.GetArguments()
+		debugLogger.DebugContext(ctx, "Received mark_merge_request_ready tool request", "args", args)
+
+		projectPath, ok := args["project_path"].(string)
+		if !ok || projectPath == "" {
+			debugLogger.ErrorContext(ctx, "project_path is not a valid string", "value", args["project_path"])
+			return mcp.NewToolResultError("project_path must be a non-empty string"), nil
+		}
+
+		mrIIDFloat, ok := args["mr_iid"].(float64)
+		if !ok {
+			debugLogger.ErrorContext(ctx, "mr_iid is missing or not a number", "value", args["mr_iid"])
+			return mcp.NewToolResultError("mr_iid must be a number"), nil
+		}
+		mrIID := int64(mrIIDFloat)
+
+		mr, err := appInstance.MarkMergeRequestReady(projectPath, mrIID)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to mark merge request ready", "error", err, "project_path", projectPath, "mr_iid", mrIID)
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to mark merge request ready: %v", err)), nil
+		}
+
+		jsonData, err := json.Marshal(mr)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to marshal merge request to JSON", "error", err)
+			return mcp.NewToolResultError("Failed to format merge request response"), nil
+		}
+
+		debugLogger.InfoContext(ctx, "Successfully marked merge request ready", "mr_iid", mrIID, "project_path", projectPath)
+		return mcp.NewToolResultText(string(jsonData)), nil
+	})
+}
+
+// setupListMergeRequestNotesTool creates and registers the merge_request_notes_list tool.
+func setupListMergeRequestNotesTool(s *server.MCPServer, appInstance *app.App, debugLogger *slog.Logger) {
+	listNotesTool := mcp.NewTool("merge_request_notes_list",
+		mcp.WithDescription("List the notes (comments) on a merge request"),
+		mcp.WithString("project_path",
+			mcp.Required(),
+			mcp.Description("GitLab project path (e.g., 'namespace/project-name')"),
+		),
+		mcp.WithNumber("mr_iid",
+			mcp.Required(),
+			mcp.Description("Merge request internal ID (IID)"),
+		),
+	)
+
+	s.AddTool(listNotesTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		ctx = mdcForTool(ctx, "merge_request_notes_list")
+		args := request.GetArguments()
+		debugLogger.DebugContext(ctx, "Received merge_request_notes_list tool request", "args", args)
+
+		projectPath, ok := args["project_path"].(string)
+		if !ok || projectPath == "" {
+			debugLogger.ErrorContext(ctx, "project_path is not a valid string", "value", args["project_path"])
+			return mcp.NewToolResultError("project_path must be a non-empty string"), nil
+		}
+
+		mrIIDFloat, ok := args["mr_iid"].(float64)
+		if !ok {
+			debugLogger.ErrorContext(ctx, "mr_iid is missing or not a number", "value", args["mr_iid"])
+			return mcp.NewToolResultError("mr_iid must be a number"), nil
+		}
+		mrIID := int64(mrIIDFloat)
+
+		notes, err := appInstance.ListMergeRequestNotes(projectPath, mrIID)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to list merge request notes", "error", err, "project_path", projectPath, "mr_iid", mrIID)
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to list merge request notes: %v", err)), nil
+		}
+
+		jsonData, err := json.Marshal(notes)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to marshal merge request notes", "error", err)
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to marshal merge request notes: %v", err)), nil
+		}
+
+		debugLogger.InfoContext(ctx, "Successfully listed merge request notes", "count", len(notes), "project_path", projectPath)
+		return mcp.NewToolResultText(string(jsonData)), nil
+	})
+}
+
+// setupGetLinkedIssuesTool creates and registers the get_linked_issues tool.
+func setupGetLinkedIssuesTool(s *server.MCPServer, appInstance *app.App, debugLogger *slog.Logger) {
+	getLinkedIssuesTool := mcp.NewTool("get_linked_issues",
+		mcp.WithDescription("Scan an issue's or merge request's description and comments for "+
+			"references to other issues/merge requests (e.g. 'Closes #1, #2', 'see group/other#7')"),
+		mcp.WithString("project_path",
+			mcp.Required(),
+			mcp.Description("GitLab project path (e.g., 'namespace/project-name')"),
+		),
+		mcp.WithString("resource_type",
+			mcp.Required(),
+			mcp.Description("Resource to scan: 'issues' or 'merge_requests'"),
+		),
+		mcp.WithNumber("iid",
+			mcp.Required(),
+			mcp.Description("Internal ID (IID) of the issue or merge request to scan"),
+		),
+	)
+
+	s.AddTool(getLinkedIssuesTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		ctx = mdcForTool(ctx, "get_linked_issues")
+		args := request.GetArguments()
+		debugLogger.DebugContext(ctx, "Received get_linked_issues tool request", "args", args)
+
+		projectPath, ok := args["project_path"].(string)
+		if !ok || projectPath == "" {
+			debugLogger.ErrorContext(ctx, "project_path is not a valid string", "value", args["project_path"])
+			return mcp.NewToolResultError("project_path must be a non-empty string"), nil
+		}
+
+		resourceType, ok := args["resource_type"].(string)
+		if !ok || resourceType == "" {
+			debugLogger.ErrorContext(ctx, "resource_type is not a valid string", "value", args["resource_type"])
+			return mcp.NewToolResultError("resource_type must be a non-empty string"), nil
+		}
+
+		iidFloat, ok := args["iid"].(float64)
+		if !ok {
+			debugLogger.ErrorContext(ctx, "iid is missing or not a number", "value", args["iid"])
+			return mcp.NewToolResultError("iid must be a number"), nil
+		}
+		iid := int64(iidFloat)
+
+		refs, err := appInstance.GetLinkedIssues(projectPath, app.ResourceKind(resourceType), iid)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to get linked issues", "error", err, "project_path", projectPath, "iid", iid)
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to get linked issues: %v", err)), nil
+		}
+
+		jsonData, err := json.Marshal(refs)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to marshal linked issues", "error", err)
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to marshal linked issues: %v", err)), nil
+		}
+
+		debugLogger.InfoContext(ctx, "Successfully got linked issues", "count", len(refs), "project_path", projectPath)
+		return mcp.NewToolResultText(string(jsonData)), nil
+	})
+}
+
+// setupExportProjectIssuesTool creates and registers the export_project_issues tool.
+func setupExportProjectIssuesTool(s *server.MCPServer, appInstance *app.App, debugLogger *slog.Logger) {
+	exportIssuesTool := mcp.NewTool("export_project_issues",
+		mcp.WithDescription("Export every issue in a project as NDJSON or a base64-encoded tar of JSON files, "+
+			"for offline analysis. Walks the project's full issue history, not just a single page."),
+		mcp.WithString("project_path",
+			mcp.Required(),
+			mcp.Description("GitLab project path (e.g., 'namespace/project-name')"),
+		),
+		mcp.WithString("format",
+			mcp.Description("Export format: 'ndjson' (default) or 'tar'"),
+		),
+		mcp.WithBoolean("include_notes",
+			mcp.Description("Include each issue's notes/comments in the export (default: false)"),
+		),
+		mcp.WithBoolean("include_labels",
+			mcp.Description("Include each issue's labels in the export (default: false)"),
+		),
+		mcp.WithBoolean("include_milestones",
+			mcp.Description("Include each issue's milestone in the export (default: false)"),
+		),
+	)
+
+	s.AddTool(exportIssuesTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		ctx = mdcForTool(ctx, "export_project_issues")
+		args := request.GetArguments()
+		debugLogger.DebugContext(ctx, "Received export_project_issues tool request", "args", args)
+
+		projectPath, ok := args["project_path"].(string)
+		if !ok || projectPath == "" {
+			debugLogger.ErrorContext(ctx, "project_path is not a valid string", "value", args["project_path"])
+			return mcp.NewToolResultError("project_path must be a non-empty string"), nil
+		}
+
+		format := app.ExportFormatNDJSON
+		if raw, ok := args["format"].(string); ok && raw != "" {
+			format = app.ExportFormat(raw)
+		}
+
+		opts := &app.ExportOptions{}
+		if includeNotes, ok := args["include_notes"].(bool); ok {
+			opts.IncludeNotes = includeNotes
+		}
+		if includeLabels, ok := args["include_labels"].(bool); ok {
+			opts.IncludeLabels = includeLabels
+		}
+		if includeMilestones, ok := args["include_milestones"].(bool); ok {
+			opts.IncludeMilestones = includeMilestones
+		}
+
+		var buf bytes.Buffer
+		if err := appInstance.ExportProjectIssues(projectPath, &buf, format, opts); err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to export project issues", "error", err, "project_path", projectPath)
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to export project issues: %v", err)), nil
+		}
+
+		debugLogger.InfoContext(ctx, "Successfully exported project issues", "project_path", projectPath, "format", format)
+
+		if format == app.ExportFormatTar {
+			return mcp.NewToolResultText(base64.StdEncoding.EncodeToString(buf.Bytes())), nil
+		}
+		return mcp.NewToolResultText(buf.String()), nil
+	})
+}
+
+// setupDeleteMergeRequestTool creates and registers the delete_merge_request tool.
+func setupDeleteMergeRequestTool(s *server.MCPServer, appInstance *app.App, debugLogger *slog.Logger) {
+	deleteMergeRequestTool := mcp.NewTool("delete_merge_request",
+		mcp.WithDescription("Delete a merge request"),
+		mcp.WithString("project_path",
+			mcp.Required(),
+			mcp.Description("GitLab project path (e.g., 'namespace/project-name')"),
+		),
+		mcp.WithNumber("mr_iid",
+			mcp.Required(),
+			mcp.Description("Merge request internal ID (IID)"),
+		),
+	)
+
+	s.AddTool(deleteMergeRequestTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		ctx = mdcForTool(ctx, "delete_merge_request")
+		args := request.GetArguments()
+		debugLogger.DebugContext(ctx, "Received delete_merge_request tool request", "args", args)
+
+		projectPath, ok := args["project_path"].(string)
+		if !ok || projectPath == "" {
+			debugLogger.ErrorContext(ctx, "project_path is not a valid string", "value", args["project_path"])
+			return mcp.NewToolResultError("project_path must be a non-empty string"), nil
+		}
+
+		mrIIDFloat, ok := args["mr_iid"].(float64)
+		if !ok {
+			debugLogger.ErrorContext(ctx, "mr_iid is missing or not a number", "value", args["mr_iid"])
+			return mcp.NewToolResultError("mr_iid must be a number"), nil
+		}
+		mrIID := int64(mrIIDFloat)
+
+		debugLogger.DebugContext(ctx, "Processing delete_merge_request request", "project_path", projectPath, "mr_iid", mrIID)
+
+		if err := appInstance.DeleteProjectMergeRequest(projectPath, mrIID); err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to delete merge request", "error", err, "project_path", projectPath, "mr_iid", mrIID)
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to delete merge request: %v", err)), nil
+		}
+
+		debugLogger.InfoContext(ctx, "Successfully deleted merge request", "mr_iid", mrIID, "project_path", projectPath)
+		return mcp.NewToolResultText(fmt.Sprintf("Merge request !%d deleted", mrIID)), nil
+	})
+}
+
+// setupCommentMergeRequestTool creates and registers the comment_merge_request tool.
+func setupCommentMergeRequestTool(s *server.MCPServer, appInstance *app.App, debugLogger *slog.Logger) {
+	commentMergeRequestTool := mcp.NewTool("comment_merge_request",
+		mcp.WithDescription("Add a comment (note) to a merge request"),
+		mcp.WithString("project_path",
+			mcp.Required(),
+			mcp.Description("GitLab project path (e.g., 'namespace/project-name')"),
+		),
+		mcp.WithNumber("mr_iid",
+			mcp.Required(),
+			mcp.Description("Merge request internal ID (IID)"),
+		),
+		mcp.WithString("body",
+			mcp.Required(),
+			mcp.Description("Comment body"),
+		),
+	)
+
+	s.AddTool(commentMergeRequestTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		ctx = mdcForTool(ctx, "comment_merge_request")
+		args := request.GetArguments()
+		debugLogger.DebugContext(ctx, "Received comment_merge_request tool request", "args", args)
+
+		projectPath, ok := args["project_path"].(string)
+		if !ok || projectPath == "" {
+			debugLogger.ErrorContext(ctx, "project_path is not a valid string", "value", args["project_path"])
+			return mcp.NewToolResultError("project_path must be a non-empty string"), nil
+		}
+
+		mrIIDFloat, ok := args["mr_iid"].(float64)
+		if !ok {
+			debugLogger.ErrorContext(ctx, "mr_iid is missing or not a number", "value", args["mr_iid"])
+			return mcp.NewToolResultError("mr_iid must be a number"), nil
+		}
+		mrIID := int64(mrIIDFloat)
+
+		body, ok := args["body"].(string)
+		if !ok || body == "" {
+			debugLogger.ErrorContext(ctx, "body is not a valid string", "value", args["body"])
+			return mcp.NewToolResultError("body must be a non-empty string"), nil
+		}
+
+		debugLogger.DebugContext(ctx, "Processing comment_merge_request request", "project_path", projectPath, "mr_iid", mrIID)
+
+		note, err := appInstance.AddMergeRequestNote(projectPath, mrIID, &app.AddMergeRequestNoteOptions{Body: body})
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to comment on merge request", "error", err, "project_path", projectPath, "mr_iid", mrIID)
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to comment on merge request: %v", err)), nil
+		}
+
+		jsonData, err := json.Marshal(note)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to marshal note to JSON", "error", err)
+			return mcp.NewToolResultError("Failed to format note response"), nil
+		}
+
+		debugLogger.InfoContext(ctx, "Successfully commented on merge request", "note_id", note.ID, "mr_iid", mrIID, "project_path", projectPath)
+		return mcp.NewToolResultText(string(jsonData)), nil
+	})
+}
+
+// setupListMergeRequestDiscussionsTool creates and registers the list_merge_request_discussions tool.
+func setupListMergeRequestDiscussionsTool(s *server.MCPServer, appInstance *app.App, debugLogger *slog.Logger) {
+	listDiscussionsTool := mcp.NewTool("list_merge_request_discussions",
+		mcp.WithDescription("List the discussion threads on a merge request"),
+		mcp.WithString("project_path",
+			mcp.Required(),
+			mcp.Description("GitLab project path (e.g., 'namespace/project-name')"),
+		),
+		mcp.WithNumber("mr_iid",
+			mcp.Required(),
+			mcp.Description("Merge request internal ID (IID)"),
+		),
+	)
+
+	s.AddTool(listDiscussionsTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		ctx = mdcForTool(ctx, "list_merge_request_discussions")
+		args := request.GetArguments()
+		debugLogger.DebugContext(ctx, "Received list_merge_request_discussions tool request", "args", args)
+
+		projectPath, ok := args["project_path"].(string)
+		if !ok || projectPath == "" {
+			debugLogger.ErrorContext(ctx, "project_path is not a valid string", "value", args["project_path"])
+			return mcp.NewToolResultError("project_path must be a non-empty string"), nil
+		}
+
+		mrIIDFloat, ok := args["mr_iid"].(float64)
+		if !ok {
+			debugLogger.ErrorContext(ctx, "mr_iid is missing or not a number", "value", args["mr_iid"])
+			return mcp.NewToolResultError("mr_iid must be a number"), nil
+		}
+		mrIID := int64(mrIIDFloat)
+
+		debugLogger.DebugContext(ctx, "Processing list_merge_request_discussions request", "project_path", projectPath, "mr_iid", mrIID)
+
+		discussions, err := appInstance.ListMergeRequestDiscussions(projectPath, mrIID)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to list merge request discussions", "error", err, "project_path", projectPath, "mr_iid", mrIID)
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to list merge request discussions: %v", err)), nil
+		}
+
+		jsonData, err := json.Marshal(discussions)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to marshal discussions to JSON", "error", err)
+			return mcp.NewToolResultError("Failed to format discussions response"), nil
+		}
+
+		debugLogger.InfoContext(ctx, "Successfully listed merge request discussions", "count", len(discussions), "mr_iid", mrIID, "project_path", projectPath)
+		return mcp.NewToolResultText(string(jsonData)), nil
+	})
+}
+
+// setupGetMergeRequestDiscussionTool creates and registers the get_merge_request_discussion tool.
+func setupGetMergeRequestDiscussionTool(s *server.MCPServer, appInstance *app.App, debugLogger *slog.Logger) {
+	getDiscussionTool := mcp.NewTool("get_merge_request_discussion",
+		mcp.WithDescription("Get a single discussion thread on a merge request"),
+		mcp.WithString("project_path",
+			mcp.Required(),
+			mcp.Description("GitLab project path (e.g., 'namespace/project-name')"),
+		),
+		mcp.WithNumber("mr_iid",
+			mcp.Required(),
+			mcp.Description("Merge request internal ID (IID)"),
+		),
+		mcp.WithString("discussion_id",
+			mcp.Required(),
+			mcp.Description("Discussion thread ID"),
+		),
+	)
+
+	s.AddTool(getDiscussionTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		ctx = mdcForTool(ctx, "get_merge_request_discussion")
+		args := request.GetArguments()
+		debugLogger.DebugContext(ctx, "Received get_merge_request_discussion tool request", "args", args)
+
+		projectPath, ok := args["project_path"].(string)
+		if !ok || projectPath == "" {
+			debugLogger.ErrorContext(ctx, "project_path is not a valid string", "value", args["project_path"])
+			return mcp.NewToolResultError("project_path must be a non-empty string"), nil
+		}
+
+		mrIIDFloat, ok := args["mr_iid"].(float64)
+		if !ok {
+			debugLogger.ErrorContext(ctx, "mr_iid is missing or not a number", "value", args["mr_iid"])
+			return mcp.NewToolResultError("mr_iid must be a number"), nil
+		}
+		mrIID := int64(mrIIDFloat)
+
+		discussionID, ok := args["discussion_id"].(string)
+		if !ok || discussionID == "" {
+			debugLogger.ErrorContext(ctx, "discussion_id is not a valid string", "value", args["discussion_id"])
+			return mcp.NewToolResultError("discussion_id must be a non-empty string"), nil
+		}
+
+		debugLogger.DebugContext(ctx, "Processing get_merge_request_discussion request",
+			"project_path", projectPath, "mr_iid", mrIID, "discussion_id", discussionID)
+
+		discussion, err := appInstance.GetMergeRequestDiscussion(projectPath, mrIID, discussionID)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to get merge request discussion",
+				"error", err, "project_path", projectPath, "mr_iid", mrIID, "discussion_id", discussionID)
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to get merge request discussion: %v", err)), nil
+		}
+
+		jsonData, err := json.Marshal(discussion)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to marshal discussion to JSON", "error", err)
+			return mcp.NewToolResultError("Failed to format discussion response"), nil
+		}
+
+		debugLogger.InfoContext(ctx, "Successfully retrieved merge request discussion", "discussion_id", discussionID, "mr_iid", mrIID)
+		return mcp.NewToolResultText(string(jsonData)), nil
+	})
+}
+
+// setupCreateMergeRequestDiscussionTool creates and registers the create_merge_request_discussion tool.
+func setupCreateMergeRequestDiscussionTool(s *server.MCPServer, appInstance *app.App, debugLogger *slog.Logger) {
+	createDiscussionTool := mcp.NewTool("create_merge_request_discussion",
+		mcp.WithDescription("Start a new discussion thread on a merge request, optionally anchored to a line of the diff"),
+		mcp.WithString("project_path",
+			mcp.Required(),
+			mcp.Description("GitLab project path (e.g., 'namespace/project-name')"),
+		),
+		mcp.WithNumber("mr_iid",
+			mcp.Required(),
+			mcp.Description("Merge request internal ID (IID)"),
+		),
+		mcp.WithString("body",
+			mcp.Required(),
+			mcp.Description("Discussion body"),
+		),
+		mcp.WithString("base_sha",
+			mcp.Description("Base commit SHA of the diff (required together with the other position fields for an inline comment)"),
+		),
+		mcp.WithString("start_sha",
+			mcp.Description("Start commit SHA of the diff"),
+		),
+		mcp.WithString("head_sha",
+			mcp.Description("Head commit SHA of the diff"),
+		),
+		mcp.WithString("old_path",
+			mcp.Description("File path before the change"),
+		),
+		mcp.WithString("new_path",
+			mcp.Description("File path after the change"),
+		),
+		mcp.WithNumber("old_line",
+			mcp.Description("Line number in the old file"),
+		),
+		mcp.WithNumber("new_line",
+			mcp.Description("Line number in the new file"),
+		),
+	)
+
+	s.AddTool(createDiscussionTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		ctx = mdcForTool(ctx, "create_merge_request_discussion")
+		args := request.GetArguments()
+		debugLogger.DebugContext(ctx, "Received create_merge_request_discussion tool request", "args", args)
+
+		projectPath, ok := args["project_path"].(string)
+		if !ok || projectPath == "" {
+			debugLogger.ErrorContext(ctx, "project_path is not a valid string", "value", args["project_path"])
+			return mcp.NewToolResultError("project_path must be a non-empty string"), nil
+		}
+
+		mrIIDFloat, ok := args["mr_iid"].(float64)
+		if !ok {
+			debugLogger.ErrorContext(ctx, "mr_iid is missing or not a number", "value", args["mr_iid"])
+			return mcp.NewToolResultError("mr_iid must be a number"), nil
+		}
+		mrIID := int64(mrIIDFloat)
+
+		body, ok := args["body"].(string)
+		if !ok || body == "" {
+			debugLogger.ErrorContext(ctx, "body is not a valid string", "value", args["body"])
+			return mcp.NewToolResultError("body must be a non-empty string"), nil
+		}
+
+		opts := &app.CreateMergeRequestDiscussionOptions{Body: body}
+
+		baseSHA, _ := args["base_sha"].(string)
+		startSHA, _ := args["start_sha"].(string)
+		headSHA, _ := args["head_sha"].(string)
+		oldPath, _ := args["old_path"].(string)
+		newPath, _ := args["new_path"].(string)
+		if baseSHA != "" || startSHA != "" || headSHA != "" || oldPath != "" || newPath != "" {
+			oldLineFloat, _ := args["old_line"].(float64)
+			newLineFloat, _ := args["new_line"].(float64)
+			opts.Position = &app.DiscussionPosition{
+				BaseSHA:  baseSHA,
+				StartSHA: startSHA,
+				HeadSHA:  headSHA,
+				OldPath:  oldPath,
+				NewPath:  newPath,
+				OldLine:  int(oldLineFloat),
+				NewLine:  int(newLineFloat),
+			}
+		}
+
+		debugLogger.DebugContext(ctx, "Processing create_merge_request_discussion request", "project_path", projectPath, "mr_iid", mrIID)
+
+		discussion, err := appInstance.CreateMergeRequestDiscussion(projectPath, mrIID, opts)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to create merge request discussion", "error", err, "project_path", projectPath, "mr_iid", mrIID)
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to create merge request discussion: %v", err)), nil
+		}
+
+		jsonData, err := json.Marshal(discussion)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to marshal discussion to JSON", "error", err)
+			return mcp.NewToolResultError("Failed to format discussion response"), nil
+		}
+
+		debugLogger.InfoContext(ctx, "Successfully created merge request discussion", "discussion_id", discussion.ID, "mr_iid", mrIID)
+		return mcp.NewToolResultText(string(jsonData)), nil
+	})
+}
+
+// setupAddMergeRequestDiscussionNoteTool creates and registers the add_merge_request_discussion_note tool.
+func setupAddMergeRequestDiscussionNoteTool(s *server.MCPServer, appInstance *app.App, debugLogger *slog.Logger) {
+	addNoteTool := mcp.NewTool("add_merge_request_discussion_note",
+		mcp.WithDescription("Reply to an existing discussion thread on a merge request"),
+		mcp.WithString("project_path",
+			mcp.Required(),
+			mcp.Description("GitLab project path (e.g., 'namespace/project-name')"),
+		),
+		mcp.WithNumber("mr_iid",
+			mcp.Required(),
+			mcp.Description("Merge request internal ID (IID)"),
+		),
+		mcp.WithString("discussion_id",
+			mcp.Required(),
+			mcp.Description("Discussion thread ID"),
+		),
+		mcp.WithString("body",
+			mcp.Required(),
+			mcp.Description("Reply body"),
+		),
+	)
+
+	s.AddTool(addNoteTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		ctx = mdcForTool(ctx, "add_merge_request_discussion_note")
+		args := request.GetArguments()
+		debugLogger.DebugContext(ctx, "Received add_merge_request_discussion_note tool request", "args", args)
+
+		projectPath, ok := args["project_path"].(string)
+		if !ok || projectPath == "" {
+			debugLogger.ErrorContext(ctx, "project_path is not a valid string", "value", args["project_path"])
+			return mcp.NewToolResultError("project_path must be a non-empty string"), nil
+		}
+
+		mrIIDFloat, ok := args["mr_iid"].(float64)
+		if !ok {
+			debugLogger.ErrorContext(ctx, "mr_iid is missing or not a number", "value", args["mr_iid"])
+			return mcp.NewToolResultError("mr_iid must be a number"), nil
+		}
+		mrIID := int64(mrIIDFloat)
+
+		discussionID, ok := args["discussion_id"].(string)
+		if !ok || discussionID == "" {
+			debugLogger.ErrorContext(ctx, "discussion_id is not a valid string", "value", args["discussion_id"])
+			return mcp.NewToolResultError("discussion_id must be a non-empty string"), nil
+		}
+
+		body, ok := args["body"].(string)
+		if !ok || body == "" {
+			debugLogger.ErrorContext(ctx, "body is not a valid string", "value", args["body"])
+			return mcp.NewToolResultError("body must be a non-empty string"), nil
+		}
+
+		debugLogger.DebugContext(ctx, "Processing add_merge_request_discussion_note request",
+			"project_path", projectPath, "mr_iid", mrIID, "discussion_id", discussionID)
+
+		note, err := appInstance.AddMergeRequestDiscussionNote(projectPath, mrIID, discussionID, body)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to add merge request discussion note",
+				"error", err, "project_path", projectPath, "mr_iid", mrIID, "discussion_id", discussionID)
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to add merge request discussion note: %v", err)), nil
+		}
+
+		jsonData, err := json.Marshal(note)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to marshal note to JSON", "error", err)
+			return mcp.NewToolResultError("Failed to format note response"), nil
+		}
+
+		debugLogger.InfoContext(ctx, "Successfully added merge request discussion note", "note_id", note.ID, "mr_iid", mrIID)
+		return mcp.NewToolResultText(string(jsonData)), nil
+	})
+}
+
+// setupResolveMergeRequestDiscussionTool creates and registers the resolve_merge_request_discussion tool.
+func setupResolveMergeRequestDiscussionTool(s *server.MCPServer, appInstance *app.App, debugLogger *slog.Logger) {
+	resolveDiscussionTool := mcp.NewTool("resolve_merge_request_discussion",
+		mcp.WithDescription("Mark a discussion thread on a merge request resolved or unresolved"),
+		mcp.WithString("project_path",
+			mcp.Required(),
+			mcp.Description("GitLab project path (e.g., 'namespace/project-name')"),
+		),
+		mcp.WithNumber("mr_iid",
+			mcp.Required(),
+			mcp.Description("Merge request internal ID (IID)"),
+		),
+		mcp.WithString("discussion_id",
+			mcp.Required(),
+			mcp.Description("Discussion thread ID"),
+		),
+		mcp.WithBoolean("resolved",
+			mcp.Description("Whether the thread should be marked resolved (default true)"),
+		),
+	)
+
+	s.AddTool(resolveDiscussionTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		ctx = mdcForTool(ctx, "resolve_merge_request_discussion")
+		args := request.GetArguments()
+		debugLogger.DebugContext(ctx, "Received resolve_merge_request_discussion tool request", "args", args)
+
+		projectPath, ok := args["project_path"].(string)
+		if !ok || projectPath == "" {
+			debugLogger.ErrorContext(ctx, "project_path is not a valid string", "value", args["project_path"])
+			return mcp.NewToolResultError("project_path must be a non-empty string"), nil
+		}
+
+		mrIIDFloat, ok := args["mr_iid"].(float64)
+		if !ok {
+			debugLogger.ErrorContext(ctx, "mr_iid is missing or not a number", "value", args["mr_iid"])
+			return mcp.NewToolResultError("mr_iid must be a number"), nil
+		}
+		mrIID := int64(mrIIDFloat)
+
+		discussionID, ok := args["discussion_id"].(string)
+		if !ok || discussionID == "" {
+			debugLogger.ErrorContext(ctx, "discussion_id is not a valid string", "value", args["discussion_id"])
+			return mcp.NewToolResultError("discussion_id must be a non-empty string"), nil
+		}
+
+		resolved := true
+		if v, ok := args["resolved"].(bool); ok {
+			resolved = v
+		}
+
+		debugLogger.DebugContext(ctx, "Processing resolve_merge_request_discussion request",
+			"project_path", projectPath, "mr_iid", mrIID, "discussion_id", discussionID, "resolved", resolved)
+
+		discussion, err := appInstance.ResolveMergeRequestDiscussion(projectPath, mrIID, discussionID, resolved)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to resolve merge request discussion",
+				"error", err, "project_path", projectPath, "mr_iid", mrIID, "discussion_id", discussionID)
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to resolve merge request discussion: %v", err)), nil
+		}
+
+		jsonData, err := json.Marshal(discussion)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to marshal discussion to JSON", "error", err)
+			return mcp.NewToolResultError("Failed to format discussion response"), nil
+		}
+
+		debugLogger.InfoContext(ctx, "Successfully resolved merge request discussion", "discussion_id", discussionID, "mr_iid", mrIID)
+		return mcp.NewToolResultText(string(jsonData)), nil
+	})
+}
+
+// setupResolveMergeRequestDiscussionsByAuthorTool creates and registers the
+// resolve_merge_request_discussions_by_author tool.
+func setupResolveMergeRequestDiscussionsByAuthorTool(s *server.MCPServer, appInstance *app.App, debugLogger *slog.Logger) {
+	resolveByAuthorTool := mcp.NewTool("resolve_merge_request_discussions_by_author",
+		mcp.WithDescription("Resolve every unresolved discussion thread on a merge request started by a given username"),
+		mcp.WithString("project_path",
+			mcp.Required(),
+			mcp.Description("GitLab project path (e.g., 'namespace/project-name')"),
+		),
+		mcp.WithNumber("mr_iid",
+			mcp.Required(),
+			mcp.Description("Merge request internal ID (IID)"),
+		),
+		mcp.WithString("username",
+			mcp.Required(),
+			mcp.Description("Username whose discussion threads should be resolved"),
+		),
+	)
+
+	s.AddTool(resolveByAuthorTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		ctx = mdcForTool(ctx, "resolve_merge_request_discussions_by_author")
+		args := request.GetArguments()
+		debugLogger.DebugContext(ctx, "Received resolve_merge_request_discussions_by_author tool request", "args", args)
+
+		projectPath, ok := args["project_path"].(string)
+		if !ok || projectPath == "" {
+			debugLogger.ErrorContext(ctx, "project_path is not a valid string", "value", args["project_path"])
+			return mcp.NewToolResultError("project_path must be a non-empty string"), nil
+		}
+
+		mrIIDFloat, ok := args["mr_iid"].(float64)
+		if !ok {
+			debugLogger.ErrorContext(ctx, "mr_iid is missing or not a number", "value", args["mr_iid"])
+			return mcp.NewToolResultError("mr_iid must be a number"), nil
+		}
+		mrIID := int64(mrIIDFloat)
+
+		username, ok := args["username"].(string)
+		if !ok || username == "" {
+			debugLogger.ErrorContext(ctx, "username is not a valid string", "value", args["username"])
+			return mcp.NewToolResultError("username must be a non-empty string"), nil
+		}
+
+		debugLogger.DebugContext(ctx, "Processing resolve_merge_request_discussions_by_author request",
+			"project_path", projectPath, "mr_iid", mrIID, "username", username)
+
+		count, err := appInstance.ResolveMergeRequestDiscussionsByAuthor(projectPath, mrIID, username)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to resolve merge request discussions by author",
+				"error", err, "project_path", projectPath, "mr_iid", mrIID, "username", username)
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to resolve merge request discussions by author: %v", err)), nil
+		}
+
+		debugLogger.InfoContext(ctx, "Successfully resolved merge request discussions by author",
+			"count", count, "mr_iid", mrIID, "username", username)
+		return mcp.NewToolResultText(fmt.Sprintf("Resolved %d discussion thread(s) by %s", count, username)), nil
+	})
+}
+
+// setupListIssueDiscussionsTool creates and registers the list_issue_discussions tool.
+func setupListIssueDiscussionsTool(s *server.MCPServer, appInstance *app.App, debugLogger *slog.Logger) {
+	listIssueDiscussionsTool := mcp.NewTool("list_issue_discussions",
+		mcp.WithDescription("List the discussion threads on an issue"),
+		mcp.WithString("project_path",
+			mcp.Required(),
+			mcp.Description("GitLab project path (e.g., 'namespace/project-name')"),
+		),
+		mcp.WithNumber("issue_iid",
+			mcp.Required(),
+			mcp.Description("Issue internal ID (IID)"),
+		),
+	)
+
+	s.AddTool(listIssueDiscussionsTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		ctx = mdcForTool(ctx, "list_issue_discussions")
+		args := request.GetArguments()
+		debugLogger.DebugContext(ctx, "Received list_issue_discussions tool request", "args", args)
+
+		projectPath, ok := args["project_path"].(string)
+		if !ok || projectPath == "" {
+			debugLogger.ErrorContext(ctx, "project_path is not a valid string", "value", args["project_path"])
+			return mcp.NewToolResultError("project_path must be a non-empty string"), nil
+		}
+
+		issueIIDFloat, ok := args["issue_iid"].(float64)
+		if !ok {
+			debugLogger.ErrorContext(ctx, "issue_iid is missing or not a number", "value", args["issue_iid"])
+			return mcp.NewToolResultError("issue_iid must be a number"), nil
+		}
+		issueIID := int64(issueIIDFloat)
+
+		debugLogger.DebugContext(ctx, "Processing list_issue_discussions request", "project_path", projectPath, "issue_iid", issueIID)
+
+		discussions, err := appInstance.ListIssueDiscussions(projectPath, issueIID)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to list issue discussions", "error", err, "project_path", projectPath, "issue_iid", issueIID)
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to list issue discussions: %v", err)), nil
+		}
+
+		jsonData, err := json.Marshal(discussions)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to marshal discussions to JSON", "error", err)
+			return mcp.NewToolResultError("Failed to format discussions response"), nil
+		}
+
+		debugLogger.InfoContext(ctx, "Successfully listed issue discussions", "count", len(discussions), "issue_iid", issueIID, "project_path", projectPath)
+		return mcp.NewToolResultText(string(jsonData)), nil
+	})
+}
+
+// setupGetIssueDiscussionTool creates and registers the get_issue_discussion tool.
+func setupGetIssueDiscussionTool(s *server.MCPServer, appInstance *app.App, debugLogger *slog.Logger) {
+	getIssueDiscussionTool := mcp.NewTool("get_issue_discussion",
+		mcp.WithDescription("Get a single discussion thread on an issue"),
+		mcp.WithString("project_path",
+			mcp.Required(),
+			mcp.Description("GitLab project path (e.g., 'namespace/project-name')"),
+		),
+		mcp.WithNumber("issue_iid",
+			mcp.Required(),
+			mcp.Description("Issue internal ID (IID)"),
+		),
+		mcp.WithString("discussion_id",
+			mcp.Required(),
+			mcp.Description("Discussion thread ID"),
+		),
+	)
+
+	s.AddTool(getIssueDiscussionTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		ctx = mdcForTool(ctx, "get_issue_discussion")
+		args := request.GetArguments()
+		debugLogger.DebugContext(ctx, "Received get_issue_discussion tool request", "args", args)
+
+		projectPath, ok := args["project_path"].(string)
+		if !ok || projectPath == "" {
+			debugLogger.ErrorContext(ctx, "project_path is not a valid string", "value", args["project_path"])
+			return mcp.NewToolResultError("project_path must be a non-empty string"), nil
+		}
+
+		issueIIDFloat, ok := args["issue_iid"].(float64)
+		if !ok {
+			debugLogger.ErrorContext(ctx, "issue_iid is missing or not a number", "value", args["issue_iid"])
+			return mcp.NewToolResultError("issue_iid must be a number"), nil
+		}
+		issueIID := int64(issueIIDFloat)
+
+		discussionID, ok := args["discussion_id"].(string)
+		if !ok || discussionID == "" {
+			debugLogger.ErrorContext(ctx, "discussion_id is not a valid string", "value", args["discussion_id"])
+			return mcp.NewToolResultError("discussion_id must be a non-empty string"), nil
+		}
+
+		debugLogger.DebugContext(ctx, "Processing get_issue_discussion request",
+			"project_path", projectPath, "issue_iid", issueIID, "discussion_id", discussionID)
+
+		discussion, err := appInstance.GetIssueDiscussion(projectPath, issueIID, discussionID)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to get issue discussion",
+				"error", err, "project_path", projectPath, "issue_iid", issueIID, "discussion_id", discussionID)
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to get issue discussion: %v", err)), nil
+		}
+
+		jsonData, err := json.Marshal(discussion)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to marshal discussion to JSON", "error", err)
+			return mcp.NewToolResultError("Failed to format discussion response"), nil
+		}
+
+		debugLogger.InfoContext(ctx, "Successfully retrieved issue discussion", "discussion_id", discussionID, "issue_iid", issueIID)
+		return mcp.NewToolResultText(string(jsonData)), nil
+	})
+}
+
+// setupCreateIssueDiscussionTool creates and registers the create_issue_discussion tool.
+func setupCreateIssueDiscussionTool(s *server.MCPServer, appInstance *app.App, debugLogger *slog.Logger) {
+	createIssueDiscussionTool := mcp.NewTool("create_issue_discussion",
+		mcp.WithDescription("Start a new discussion thread on an issue"),
+		mcp.WithString("project_path",
+			mcp.Required(),
+			mcp.Description("GitLab project path (e.g., 'namespace/project-name')"),
+		),
+		mcp.WithNumber("issue_iid",
+			mcp.Required(),
+			mcp.Description("Issue internal ID (IID)"),
+		),
+		mcp.WithString("body",
+			mcp.Required(),
+			mcp.Description("Discussion body"),
+		),
+	)
+
+	s.AddTool(createIssueDiscussionTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		ctx = mdcForTool(ctx, "create_issue_discussion")
+		args := request.GetArguments()
+		debugLogger.DebugContext(ctx, "Received create_issue_discussion tool request", "args", args)
+
+		projectPath, ok := args["project_path"].(string)
+		if !ok || projectPath == "" {
+			debugLogger.ErrorContext(ctx, "project_path is not a valid string", "value", args["project_path"])
+			return mcp.NewToolResultError("project_path must be a non-empty string"), nil
+		}
+
+		issueIIDFloat, ok := args["issue_iid"].(float64)
+		if !ok {
+			debugLogger.ErrorContext(ctx, "issue_iid is missing or not a number", "value", args["issue_iid"])
+			return mcp.NewToolResultError("issue_iid must be a number"), nil
+		}
+		issueIID := int64(issueIIDFloat)
+
+		body, ok := args["body"].(string)
+		if !ok || body == "" {
+			debugLogger.ErrorContext(ctx, "body is not a valid string", "value", args["body"])
+			return mcp.NewToolResultError("body must be a non-empty string"), nil
+		}
+
+		debugLogger.DebugContext(ctx, "Processing create_issue_discussion request", "project_path", projectPath, "issue_iid", issueIID)
+
+		discussion, err := appInstance.CreateIssueDiscussion(projectPath, issueIID, body)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to create issue discussion", "error", err, "project_path", projectPath, "issue_iid", issueIID)
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to create issue discussion: %v", err)), nil
+		}
+
+		jsonData, err := json.Marshal(discussion)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to marshal discussion to JSON", "error", err)
+			return mcp.NewToolResultError("Failed to format discussion response"), nil
+		}
+
+		debugLogger.InfoContext(ctx, "Successfully created issue discussion", "discussion_id", discussion.ID, "issue_iid", issueIID)
+		return mcp.NewToolResultText(string(jsonData)), nil
+	})
+}
+
+// setupAddIssueDiscussionNoteTool creates and registers the add_issue_discussion_note tool.
+func setupAddIssueDiscussionNoteTool(s *server.MCPServer, appInstance *app.App, debugLogger *slog.Logger) {
+	addIssueNoteTool := mcp.NewTool("add_issue_discussion_note",
+		mcp.WithDescription("Reply to an existing discussion thread on an issue"),
+		mcp.WithString("project_path",
+			mcp.Required(),
+			mcp.Description("GitLab project path (e.g., 'namespace/project-name')"),
+		),
+		mcp.WithNumber("issue_iid",
+			mcp.Required(),
+			mcp.Description("Issue internal ID (IID)"),
+		),
+		mcp.WithString("discussion_id",
+			mcp.Required(),
+			mcp.Description("Discussion thread ID"),
+		),
+		mcp.WithString("body",
+			mcp.Required(),
+			mcp.Description("Reply body"),
+		),
+	)
+
+	s.AddTool(addIssueNoteTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		ctx = mdcForTool(ctx, "add_issue_discussion_note")
+		args := request.GetArguments()
+		debugLogger.DebugContext(ctx, "Received add_issue_discussion_note tool request", "args", args)
+
+		projectPath, ok := args["project_path"].(string)
+		if !ok || projectPath == "" {
+			debugLogger.ErrorContext(ctx, "project_path is not a valid string", "value", args["project_path"])
+			return mcp.NewToolResultError("project_path must be a non-empty string"), nil
+		}
+
+		issueIIDFloat, ok := args["issue_iid"].(float64)
+		if !ok {
+			debugLogger.ErrorContext(ctx, "issue_iid is missing or not a number", "value", args["issue_iid"])
+			return mcp.NewToolResultError("issue_iid must be a number"), nil
+		}
+		issueIID := int64(issueIIDFloat)
+
+		discussionID, ok := args["discussion_id"].(string)
+		if !ok || discussionID == "" {
+			debugLogger.ErrorContext(ctx, "discussion_id is not a valid string", "value", args["discussion_id"])
+			return mcp.NewToolResultError("discussion_id must be a non-empty string"), nil
+		}
+
+		body, ok := args["body"].(string)
+		if !ok || body == "" {
+			debugLogger.ErrorContext(ctx, "body is not a valid string", "value", args["body"])
+			return mcp.NewToolResultError("body must be a non-empty string"), nil
+		}
+
+		debugLogger.DebugContext(ctx, "Processing add_issue_discussion_note request",
+			"project_path", projectPath, "issue_iid", issueIID, "discussion_id", discussionID)
+
+		note, err := appInstance.AddIssueDiscussionNote(projectPath, issueIID, discussionID, body)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to add issue discussion note",
+				"error", err, "project_path", projectPath, "issue_iid", issueIID, "discussion_id", discussionID)
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to add issue discussion note: %v", err)), nil
+		}
+
+		jsonData, err := json.Marshal(note)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to marshal note to JSON", "error", err)
+			return mcp.NewToolResultError("Failed to format note response"), nil
+		}
+
+		debugLogger.InfoContext(ctx, "Successfully added issue discussion note", "note_id", note.ID, "issue_iid", issueIID)
+		return mcp.NewToolResultText(string(jsonData)), nil
+	})
+}
+
+// extractTimeTrackingResource extracts and validates the "resource" argument shared by the
+// time-tracking tools.
+func extractTimeTrackingResource(args map[string]interface{}) (app.TimeTrackingResource, error) {
+	resource, ok := args["resource"].(string)
+	if !ok || resource == "" {
+		return "", errors.New("resource must be a non-empty string")
+	}
+
+	switch app.TimeTrackingResource(resource) {
+	case app.TimeTrackingIssue, app.TimeTrackingMergeRequest:
+		return app.TimeTrackingResource(resource), nil
+	default:
+		return "", errors.New(`resource must be "issue" or "merge_request"`)
+	}
+}
+
+// setupSetTimeEstimateTool creates and registers the gitlab_time_set_estimate tool.
+func setupSetTimeEstimateTool(s *server.MCPServer, appInstance *app.App, debugLogger *slog.Logger) {
+	setEstimateTool := mcp.NewTool("gitlab_time_set_estimate",
+		mcp.WithDescription("Set the time estimate on an issue or merge request from a human-readable duration"),
+		mcp.WithString("project_path",
+			mcp.Required(),
+			mcp.Description("GitLab project path (e.g., 'namespace/project-name')"),
+		),
+		mcp.WithString("resource",
+			mcp.Required(),
+			mcp.Description(`Resource kind: "issue" or "merge_request"`),
+		),
+		mcp.WithNumber("iid",
+			mcp.Required(),
+			mcp.Description("Issue or merge request internal ID (IID)"),
+		),
+		mcp.WithString("duration",
+			mcp.Required(),
+			mcp.Description(`Human-readable duration (e.g. "1h30m", "2d", "1w")`),
+		),
+	)
+
+	s.AddTool(setEstimateTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		ctx = mdcForTool(ctx, "gitlab_time_set_estimate")
+		args := request.GetArguments()
+		debugLogger.DebugContext(ctx, "Received gitlab_time_set_estimate tool request", "args", args)
+
+		projectPath, ok := args["project_path"].(string)
+		if !ok || projectPath == "" {
+			debugLogger.ErrorContext(ctx, "project_path is not a valid string", "value", args["project_path"])
+			return mcp.NewToolResultError("project_path must be a non-empty string"), nil
+		}
+
+		resource, err := extractTimeTrackingResource(args)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Invalid resource", "error", err, "value", args["resource"])
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		iidFloat, ok := args["iid"].(float64)
+		if !ok {
+			debugLogger.ErrorContext(ctx, "iid is missing or not a number", "value", args["iid"])
+			return mcp.NewToolResultError("iid must be a number"), nil
+		}
+		iid := int64(iidFloat)
+
+		duration, ok := args["duration"].(string)
+		if !ok || duration == "" {
+			debugLogger.ErrorContext(ctx, "duration is not a valid string", "value", args["duration"])
+			return mcp.NewToolResultError("duration must be a non-empty string"), nil
+		}
+
+		debugLogger.DebugContext(ctx, "Processing gitlab_time_set_estimate request",
+			"project_path", projectPath, "resource", resource, "iid", iid, "duration", duration)
+
+		stats, err := appInstance.SetTimeEstimate(resource, projectPath, iid, duration)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to set time estimate", "error", err, "project_path", projectPath, "iid", iid)
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to set time estimate: %v", err)), nil
+		}
+
+		jsonData, err := json.Marshal(stats)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to marshal time stats to JSON", "error", err)
+			return mcp.NewToolResultError("Failed to format time stats response"), nil
+		}
+
+		debugLogger.InfoContext(ctx, "Successfully set time estimate", "resource", resource, "iid", iid)
+		return mcp.NewToolResultText(string(jsonData)), nil
+	})
+}
+
+// setupResetTimeEstimateTool creates and registers the gitlab_time_reset_estimate tool.
+func setupResetTimeEstimateTool(s *server.MCPServer, appInstance *app.App, debugLogger *slog.Logger) {
+	resetEstimateTool := mcp.NewTool("gitlab_time_reset_estimate",
+		mcp.WithDescription("Clear the time estimate on an issue or merge request"),
+		mcp.WithString("project_path",
+			mcp.Required(),
+			mcp.Description("GitLab project path (e.g., 'namespace/project-name')"),
+		),
+		mcp.WithString("resource",
+			mcp.Required(),
+			mcp.Description(`Resource kind: "issue" or "merge_request"`),
+		),
+		mcp.WithNumber("iid",
+			mcp.Required(),
+			mcp.Description("Issue or merge request internal ID (IID)"),
+		),
+	)
+
+	s.AddTool(resetEstimateTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		ctx = mdcForTool(ctx, "gitlab_time_reset_estimate")
+		args := request.GetArguments()
+		debugLogger.DebugContext(ctx, "Received gitlab_time_reset_estimate tool request", "args", args)
+
+		projectPath, ok := args["project_path"].(string)
+		if !ok || projectPath == "" {
+			debugLogger.ErrorContext(ctx, "project_path is not a valid string", "value", args["project_path"])
+			return mcp.NewToolResultError("project_path must be a non-empty string"), nil
+		}
+
+		resource, err := extractTimeTrackingResource(args)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Invalid resource", "error", err, "value", args["resource"])
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		iidFloat, ok := args["iid"].(float64)
+		if !ok {
+			debugLogger.ErrorContext(ctx, "iid is missing or not a number", "value", args["iid"])
+			return mcp.NewToolResultError("iid must be a number"), nil
+		}
+		iid := int64(iidFloat)
+
+		debugLogger.DebugContext(ctx, "Processing gitlab_time_reset_estimate request",
+			"project_path", projectPath, "resource", resource, "iid", iid)
+
+		stats, err := appInstance.ResetTimeEstimate(resource, projectPath, iid)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to reset time estimate", "error", err, "project_path", projectPath, "iid", iid)
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to reset time estimate: %v", err)), nil
+		}
+
+		jsonData, err := json.Marshal(stats)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to marshal time stats to JSON", "error", err)
+			return mcp.NewToolResultError("Failed to format time stats response"), nil
+		}
+
+		debugLogger.InfoContext(ctx, "Successfully reset time estimate", "resource", resource, "iid", iid)
+		return mcp.NewToolResultText(string(jsonData)), nil
+	})
+}
+
+// setupAddSpentTimeTool creates and registers the gitlab_time_add_spent tool.
+func setupAddSpentTimeTool(s *server.MCPServer, appInstance *app.App, debugLogger *slog.Logger) {
+	addSpentTool := mcp.NewTool("gitlab_time_add_spent",
+		mcp.WithDescription(
+			"Log time spent on an issue or merge request from a human-readable duration "+
+				`(a negative duration, e.g. "-1h", subtracts previously logged time)`,
+		),
+		mcp.WithString("project_path",
+			mcp.Required(),
+			mcp.Description("GitLab project path (e.g., 'namespace/project-name')"),
+		),
+		mcp.WithString("resource",
+			mcp.Required(),
+			mcp.Description(`Resource kind: "issue" or "merge_request"`),
+		),
+		mcp.WithNumber("iid",
+			mcp.Required(),
+			mcp.Description("Issue or merge request internal ID (IID)"),
+		),
+		mcp.WithString("duration",
+			mcp.Required(),
+			mcp.Description(`Human-readable duration (e.g. "1h30m", "2d", "-1h")`),
+		),
+	)
+
+	s.AddTool(addSpentTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		ctx = mdcForTool(ctx, "gitlab_time_add_spent")
+		args := request.GetArguments()
+		debugLogger.DebugContext(ctx, "Received gitlab_time_add_spent tool request", "args", args)
+
+		projectPath, ok := args["project_path"].(string)
+		if !ok || projectPath == "" {
+			debugLogger.ErrorContext(ctx, "project_path is not a valid string", "value", args["project_path"])
+			return mcp.NewToolResultError("project_path must be a non-empty string"), nil
+		}
+
+		resource, err := extractTimeTrackingResource(args)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Invalid resource", "error", err, "value", args["resource"])
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		iidFloat, ok := args["iid"].(float64)
+		if !ok {
+			debugLogger.ErrorContext(ctx, "iid is missing or not a number", "value", args["iid"])
+			return mcp.NewToolResultError("iid must be a number"), nil
+		}
+		iid := int64(iidFloat)
+
+		duration, ok := args["duration"].(string)
+		if !ok || duration == "" {
+			debugLogger.ErrorContext(ctx, "duration is not a valid string", "value", args["duration"])
+			return mcp.NewToolResultError("duration must be a non-empty string"), nil
+		}
+
+		debugLogger.DebugContext(ctx, "Processing gitlab_time_add_spent request",
+			"project_path", projectPath, "resource", resource, "iid", iid, "duration", duration)
+
+		stats, err := appInstance.AddSpentTime(resource, projectPath, iid, duration)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to add spent time", "error", err, "project_path", projectPath, "iid", iid)
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to add spent time: %v", err)), nil
+		}
+
+		jsonData, err := json.Marshal(stats)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to marshal time stats to JSON", "error", err)
+			return mcp.NewToolResultError("Failed to format time stats response"), nil
+		}
+
+		debugLogger.InfoContext(ctx, "Successfully added spent time", "resource", resource, "iid", iid)
+		return mcp.NewToolResultText(string(jsonData)), nil
+	})
+}
+
+// setupResetSpentTimeTool creates and registers the gitlab_time_reset_spent tool.
+func setupResetSpentTimeTool(s *server.MCPServer, appInstance *app.App, debugLogger *slog.Logger) {
+	resetSpentTool := mcp.NewTool("gitlab_time_reset_spent",
+		mcp.WithDescription("Clear all logged time spent on an issue or merge request"),
+		mcp.WithString("project_path",
+			mcp.Required(),
+			mcp.Description("GitLab project path (e.g., 'namespace/project-name')"),
+		),
+		mcp.WithString("resource",
+			mcp.Required(),
+			mcp.Description(`Resource kind: "issue" or "merge_request"`),
+		),
+		mcp.WithNumber("iid",
+			mcp.Required(),
+			mcp.Description("Issue or merge request internal ID (IID)"),
+		),
+	)
+
+	s.AddTool(resetSpentTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		ctx = mdcForTool(ctx, "gitlab_time_reset_spent")
+		args := request.GetArguments()
+		debugLogger.DebugContext(ctx, "Received gitlab_time_reset_spent tool request", "args", args)
+
+		projectPath, ok := args["project_path"].(string)
+		if !ok || projectPath == "" {
+			debugLogger.ErrorContext(ctx, "project_path is not a valid string", "value", args["project_path"])
+			return mcp.NewToolResultError("project_path must be a non-empty string"), nil
+		}
+
+		resource, err := extractTimeTrackingResource(args)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Invalid resource", "error", err, "value", args["resource"])
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		iidFloat, ok := args["iid"].(float64)
+		if !ok {
+			debugLogger.ErrorContext(ctx, "iid is missing or not a number", "value", args["iid"])
+			return mcp.NewToolResultError("iid must be a number"), nil
+		}
+		iid := int64(iidFloat)
+
+		debugLogger.DebugContext(ctx, "Processing gitlab_time_reset_spent request",
+			"project_path", projectPath, "resource", resource, "iid", iid)
+
+		stats, err := appInstance.ResetSpentTime(resource, projectPath, iid)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to reset spent time", "error", err, "project_path", projectPath, "iid", iid)
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to reset spent time: %v", err)), nil
+		}
+
+		jsonData, err := json.Marshal(stats)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to marshal time stats to JSON", "error", err)
+			return mcp.NewToolResultError("Failed to format time stats response"), nil
+		}
+
+		debugLogger.InfoContext(ctx, "Successfully reset spent time", "resource", resource, "iid", iid)
+		return mcp.NewToolResultText(string(jsonData)), nil
+	})
+}
+
+// setupResetTimeStatsTool creates and registers the gitlab_time_reset_all tool.
+func setupResetTimeStatsTool(s *server.MCPServer, appInstance *app.App, debugLogger *slog.Logger) {
+	resetAllTool := mcp.NewTool("gitlab_time_reset_all",
+		mcp.WithDescription("Clear both the time estimate and the logged spent time on an issue or merge request"),
+		mcp.WithString("project_path",
+			mcp.Required(),
+			mcp.Description("GitLab project path (e.g., 'namespace/project-name')"),
+		),
+		mcp.WithString("resource",
+			mcp.Required(),
+			mcp.Description(`Resource kind: "issue" or "merge_request"`),
+		),
+		mcp.WithNumber("iid",
+			mcp.Required(),
+			mcp.Description("Issue or merge request internal ID (IID)"),
+		),
+	)
+
+	s.AddTool(resetAllTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		ctx = mdcForTool(ctx, "gitlab_time_reset_all")
+		args := request.GetArguments()
+		debugLogger.DebugContext(ctx, "Received gitlab_time_reset_all tool request", "args", args)
+
+		projectPath, ok := args["project_path"].(string)
+		if !ok || projectPath == "" {
+			debugLogger.ErrorContext(ctx, "project_path is not a valid string", "value", args["project_path"])
+			return mcp.NewToolResultError("project_path must be a non-empty string"), nil
+		}
+
+		resource, err := extractTimeTrackingResource(args)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Invalid resource", "error", err, "value", args["resource"])
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		iidFloat, ok := args["iid"].(float64)
+		if !ok {
+			debugLogger.ErrorContext(ctx, "iid is missing or not a number", "value", args["iid"])
+			return mcp.NewToolResultError("iid must be a number"), nil
+		}
+		iid := int64(iidFloat)
+
+		debugLogger.DebugContext(ctx, "Processing gitlab_time_reset_all request",
+			"project_path", projectPath, "resource", resource, "iid", iid)
+
+		stats, err := appInstance.ResetTimeStats(resource, projectPath, iid)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to reset time stats", "error", err, "project_path", projectPath, "iid", iid)
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to reset time stats: %v", err)), nil
+		}
+
+		jsonData, err := json.Marshal(stats)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to marshal time stats to JSON", "error", err)
+			return mcp.NewToolResultError("Failed to format time stats response"), nil
+		}
+
+		debugLogger.InfoContext(ctx, "Successfully reset time stats", "resource", resource, "iid", iid)
+		return mcp.NewToolResultText(string(jsonData)), nil
+	})
+}
+
+// setupGetTimeSpentTool creates and registers the gitlab_time_get_spent tool.
+func setupGetTimeSpentTool(s *server.MCPServer, appInstance *app.App, debugLogger *slog.Logger) {
+	getSpentTool := mcp.NewTool("gitlab_time_get_spent",
+		mcp.WithDescription("Get the time-tracking totals (estimate and time spent) for an issue or merge request"),
+		mcp.WithString("project_path",
+			mcp.Required(),
+			mcp.Description("GitLab project path (e.g., 'namespace/project-name')"),
+		),
+		mcp.WithString("resource",
+			mcp.Required(),
+			mcp.Description(`Resource kind: "issue" or "merge_request"`),
+		),
+		mcp.WithNumber("iid",
+			mcp.Required(),
+			mcp.Description("Issue or merge request internal ID (IID)"),
+		),
+	)
+
+	s.AddTool(getSpentTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		ctx = mdcForTool(ctx, "gitlab_time_get_spent")
+		args := request.GetArguments()
+		debugLogger.DebugContext(ctx, "Received gitlab_time_get_spent tool request", "args", args)
+
+		projectPath, ok := args["project_path"].(string)
+		if !ok || projectPath == "" {
+			debugLogger.ErrorContext(ctx, "project_path is not a valid string", "value", args["project_path"])
+			return mcp.NewToolResultError("project_path must be a non-empty string"), nil
+		}
+
+		resource, err := extractTimeTrackingResource(args)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Invalid resource", "error", err, "value", args["resource"])
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		iidFloat, ok := args["iid"].(float64)
+		if !ok {
+			debugLogger.ErrorContext(ctx, "iid is missing or not a number", "value", args["iid"])
+			return mcp.NewToolResultError("iid must be a number"), nil
+		}
+		iid := int64(iidFloat)
+
+		debugLogger.DebugContext(ctx, "Processing gitlab_time_get_spent request",
+			"project_path", projectPath, "resource", resource, "iid", iid)
+
+		stats, err := appInstance.GetTimeSpent(resource, projectPath, iid)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to get time spent", "error", err, "project_path", projectPath, "iid", iid)
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to get time spent: %v", err)), nil
+		}
+
+		jsonData, err := json.Marshal(stats)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to marshal time stats to JSON", "error", err)
+			return mcp.NewToolResultError("Failed to format time stats response"), nil
+		}
+
+		debugLogger.InfoContext(ctx, "Successfully retrieved time spent", "resource", resource, "iid", iid)
+		return mcp.NewToolResultText(string(jsonData)), nil
+	})
+}
+
+// setupDeleteIssueTool creates and registers the gitlab_issue_delete tool.
+func setupDeleteIssueTool(s *server.MCPServer, appInstance *app.App, debugLogger *slog.Logger) {
+	deleteIssueTool := mcp.NewTool("gitlab_issue_delete",
+		mcp.WithDescription("Delete an issue from a GitLab project"),
+		mcp.WithString("project_path",
+			mcp.Required(),
+			mcp.Description("GitLab project path (e.g., 'namespace/project-name')"),
+		),
+		mcp.WithNumber("issue_iid",
+			mcp.Required(),
+			mcp.Description("Issue internal ID (IID) to delete"),
+		),
+	)
+
+	s.AddTool(deleteIssueTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		ctx = mdcForTool(ctx, "gitlab_issue_delete")
+		args := request.GetArguments()
+		debugLogger.DebugContext(ctx, "Received gitlab_issue_delete tool request", "args", args)
+
+		projectPath, ok := args["project_path"].(string)
+		if !ok || projectPath == "" {
+			debugLogger.ErrorContext(ctx, "project_path is not a valid string", "value", args["project_path"])
+			return mcp.NewToolResultError("project_path must be a non-empty string"), nil
+		}
+
+		issueIIDFloat, ok := args["issue_iid"].(float64)
+		if !ok {
+			debugLogger.ErrorContext(ctx, "issue_iid is missing or not a number", "value", args["issue_iid"])
+			return mcp.NewToolResultError("issue_iid must be a number"), nil
+		}
+		issueIID := int64(issueIIDFloat)
+
+		debugLogger.DebugContext(ctx, "Processing gitlab_issue_delete request", "project_path", projectPath, "issue_iid", issueIID)
+
+		if err := appInstance.DeleteProjectIssue(projectPath, issueIID); err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to delete issue", "error", err, "project_path", projectPath, "issue_iid", issueIID)
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to delete issue: %v", err)), nil
+		}
+
+		debugLogger.InfoContext(ctx, "Successfully deleted issue", "project_path", projectPath, "issue_iid", issueIID)
+		return mcp.NewToolResultText(fmt.Sprintf("Issue %d deleted successfully", issueIID)), nil
+	})
+}
+
+// setupMoveIssueTool creates and registers the gitlab_issue_move tool.
+func setupMoveIssueTool(s *server.MCPServer, appInstance *app.App, debugLogger *slog.Logger) {
+	moveIssueTool := mcp.NewTool("gitlab_issue_move",
+		mcp.WithDescription("Move an issue to a different project, identified by its numeric project ID"),
+		mcp.WithString("project_path",
+			mcp.Required(),
+			mcp.Description("GitLab project path of the issue's current project (e.g., 'namespace/project-name')"),
+		),
+		mcp.WithNumber("issue_iid",
+			mcp.Required(),
+			mcp.Description("Issue internal ID (IID) to move"),
+		),
+		mcp.WithNumber("target_project_id",
+			mcp.Required(),
+			mcp.Description("Numeric ID of the project to move the issue to"),
+		),
+	)
+
+	s.AddTool(moveIssueTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		ctx = mdcForTool(ctx, "gitlab_issue_move")
+		args := request.GetArguments()
+		debugLogger.DebugContext(ctx, "Received gitlab_issue_move tool request", "args", args)
+
+		projectPath, ok := args["project_path"].(string)
+		if !ok || projectPath == "" {
+			debugLogger.ErrorContext(ctx, "project_path is not a valid string", "value", args["project_path"])
+			return mcp.NewToolResultError("project_path must be a non-empty string"), nil
+		}
+
+		issueIIDFloat, ok := args["issue_iid"].(float64)
+		if !ok {
+			debugLogger.ErrorContext(ctx, "issue_iid is missing or not a number", "value", args["issue_iid"])
+			return mcp.NewToolResultError("issue_iid must be a number"), nil
+		}
+		issueIID := int64(issueIIDFloat)
+
+		targetProjectIDFloat, ok := args["target_project_id"].(float64)
+		if !ok {
+			debugLogger.ErrorContext(ctx, "target_project_id is missing or not a number", "value", args["target_project_id"])
+			return mcp.NewToolResultError("target_project_id must be a number"), nil
+		}
+		targetProjectID := int64(targetProjectIDFloat)
+
+		debugLogger.DebugContext(ctx, "Processing gitlab_issue_move request",
+			"project_path", projectPath, "issue_iid", issueIID, "target_project_id", targetProjectID)
+
+		issue, err := appInstance.MoveProjectIssue(projectPath, issueIID, targetProjectID)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to move issue", "error", err, "project_path", projectPath, "issue_iid", issueIID)
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to move issue: %v", err)), nil
+		}
+
+		jsonData, err := json.Marshal(issue)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to marshal issue to JSON", "error", err)
+			return mcp.NewToolResultError("Failed to format issue response"), nil
+		}
+
+		debugLogger.InfoContext(ctx, "Successfully moved issue", "project_path", projectPath, "issue_iid", issueIID)
+		return mcp.NewToolResultText(string(jsonData)), nil
+	})
+}
+
+// setupSubscribeToIssueTool creates and registers the gitlab_issue_subscribe tool.
+func setupSubscribeToIssueTool(s *server.MCPServer, appInstance *app.App, debugLogger *slog.Logger) {
+	subscribeTool := mcp.NewTool("gitlab_issue_subscribe",
+		mcp.WithDescription("Subscribe the current user to notifications for an issue"),
+		mcp.WithString("project_path",
+			mcp.Required(),
+			mcp.Description("GitLab project path (e.g., 'namespace/project-name')"),
+		),
+		mcp.WithNumber("issue_iid",
+			mcp.Required(),
+			mcp.Description("Issue internal ID (IID)"),
+		),
+	)
+
+	s.AddTool(subscribeTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		ctx = mdcForTool(ctx, "gitlab_issue_subscribe")
+		args := request.GetArguments()
+		debugLogger.DebugContext(ctx, "Received gitlab_issue_subscribe tool request", "args", args)
+
+		projectPath, ok := args["project_path"].(string)
+		if !ok || projectPath == "" {
+			debugLogger.ErrorContext(ctx, "project_path is not a valid string", "value", args["project_path"])
+			return mcp.NewToolResultError("project_path must be a non-empty string"), nil
+		}
+
+		issueIIDFloat, ok := args["issue_iid"].(float64)
+		if !ok {
+			debugLogger.ErrorContext(ctx, "issue_iid is missing or not a number", "value", args["issue_iid"])
+			return mcp.NewToolResultError("issue_iid must be a number"), nil
+		}
+		issueIID := int64(issueIIDFloat)
+
+		debugLogger.DebugContext(ctx, "Processing gitlab_issue_subscribe request", "project_path", projectPath, "issue_iid", issueIID)
+
+		issue, err := appInstance.SubscribeToIssue(projectPath, issueIID)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to subscribe to issue", "error", err, "project_path", projectPath, "issue_iid", issueIID)
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to subscribe to issue: %v", err)), nil
+		}
+
+		jsonData, err := json.Marshal(issue)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to marshal issue to JSON", "error", err)
+			return mcp.NewToolResultError("Failed to format issue response"), nil
+		}
+
+		debugLogger.InfoContext(ctx, "Successfully subscribed to issue", "project_path", projectPath, "issue_iid", issueIID)
+		return mcp.NewToolResultText(string(jsonData)), nil
+	})
+}
+
+// setupUnsubscribeFromIssueTool creates and registers the gitlab_issue_unsubscribe tool.
+func setupUnsubscribeFromIssueTool(s *server.MCPServer, appInstance *app.App, debugLogger *slog.Logger) {
+	unsubscribeTool := mcp.NewTool("gitlab_issue_unsubscribe",
+		mcp.WithDescription("Unsubscribe the current user from notifications for an issue"),
+		mcp.WithString("project_path",
+			mcp.Required(),
+			mcp.Description("GitLab project path (e.g., 'namespace/project-name')"),
+		),
+		mcp.WithNumber("issue_iid",
+			mcp.Required(),
+			mcp.Description("Issue internal ID (IID)"),
+		),
+	)
+
+	s.AddTool(unsubscribeTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		ctx = mdcForTool(ctx, "gitlab_issue_unsubscribe")
+		args := request.GetArguments()
+		debugLogger.DebugContext(ctx, "Received gitlab_issue_unsubscribe tool request", "args", args)
+
+		projectPath, ok := args["project_path"].(string)
+		if !ok || projectPath == "" {
+			debugLogger.ErrorContext(ctx, "project_path is not a valid string", "value", args["project_path"])
+			return mcp.NewToolResultError("project_path must be a non-empty string"), nil
+		}
+
+		issueIIDFloat, ok := args["issue_iid"].(float64)
+		if !ok {
+			debugLogger.ErrorContext(ctx, "issue_iid is missing or not a number", "value", args["issue_iid"])
+			return mcp.NewToolResultError("issue_iid must be a number"), nil
+		}
+		issueIID := int64(issueIIDFloat)
+
+		debugLogger.DebugContext(ctx, "Processing gitlab_issue_unsubscribe request", "project_path", projectPath, "issue_iid", issueIID)
+
+		issue, err := appInstance.UnsubscribeFromIssue(projectPath, issueIID)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to unsubscribe from issue", "error", err, "project_path", projectPath, "issue_iid", issueIID)
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to unsubscribe from issue: %v", err)), nil
+		}
+
+		jsonData, err := json.Marshal(issue)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to marshal issue to JSON", "error", err)
+			return mcp.NewToolResultError("Failed to format issue response"), nil
+		}
+
+		debugLogger.InfoContext(ctx, "Successfully unsubscribed from issue", "project_path", projectPath, "issue_iid", issueIID)
+		return mcp.NewToolResultText(string(jsonData)), nil
+	})
+}
+
+// setupCreateIssueTodoTool creates and registers the gitlab_issue_create_todo tool.
+func setupCreateIssueTodoTool(s *server.MCPServer, appInstance *app.App, debugLogger *slog.Logger) {
+	createTodoTool := mcp.NewTool("gitlab_issue_create_todo",
+		mcp.WithDescription("Create a to-do item for the current user on an issue"),
+		mcp.WithString("project_path",
+			mcp.Required(),
+			mcp.Description("GitLab project path (e.g., 'namespace/project-name')"),
+		),
+		mcp.WithNumber("issue_iid",
+			mcp.Required(),
+			mcp.Description("Issue internal ID (IID)"),
+		),
+	)
+
+	s.AddTool(createTodoTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		ctx = mdcForTool(ctx, "gitlab_issue_create_todo")
+		args := request.GetArguments()
+		debugLogger.DebugContext(ctx, "Received gitlab_issue_create_todo tool request", "args", args)
+
+		projectPath, ok := args["project_path"].(string)
+		if !ok || projectPath == "" {
+			debugLogger.ErrorContext(ctx, "project_path is not a valid string", "value", args["project_path"])
+			return mcp.NewToolResultError("project_path must be a non-empty string"), nil
+		}
+
+		issueIIDFloat, ok := args["issue_iid"].(float64)
+		if !ok {
+			debugLogger.ErrorContext(ctx, "issue_iid is missing or not a number", "value", args["issue_iid"])
+			return mcp.NewToolResultError("issue_iid must be a number"), nil
+		}
+		issueIID := int64(issueIIDFloat)
+
+		debugLogger.DebugContext(ctx, "Processing gitlab_issue_create_todo request", "project_path", projectPath, "issue_iid", issueIID)
+
+		todo, err := appInstance.CreateIssueTodo(projectPath, issueIID)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to create todo for issue", "error", err, "project_path", projectPath, "issue_iid", issueIID)
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to create todo for issue: %v", err)), nil
+		}
+
+		jsonData, err := json.Marshal(todo)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to marshal todo to JSON", "error", err)
+			return mcp.NewToolResultError("Failed to format todo response"), nil
+		}
+
+		debugLogger.InfoContext(ctx, "Successfully created todo for issue", "project_path", projectPath, "issue_iid", issueIID)
+		return mcp.NewToolResultText(string(jsonData)), nil
+	})
+}
+
+// setupListInstanceIssuesTool creates and registers the gitlab_issues_list_instance tool.
+func setupListInstanceIssuesTool(s *server.MCPServer, appInstance *app.App, debugLogger *slog.Logger) {
+	listInstanceIssuesTool := mcp.NewTool("gitlab_issues_list_instance",
+		mcp.WithDescription("List issues across the whole GitLab instance that the current user can see"),
+		mcp.WithString("state",
+			mcp.Description("Filter by issue state: opened, closed, or all (default: opened)"),
+		),
+		mcp.WithString("labels",
+			mcp.Description("Comma-separated list of labels to filter by"),
+		),
+		mcp.WithNumber("limit",
+			mcp.Description("Maximum number of issues to return (default: 100, max: 100)"),
+		),
+		mcp.WithBoolean("list_all",
+			mcp.Description(
+				"Walk every page instead of just the first, bounded by max_items (default: false)",
+			),
+		),
+		mcp.WithNumber("max_items",
+			mcp.Description("Item cap applied when list_all is set (default: a few hundred)"),
+		),
+	)
+
+	s.AddTool(listInstanceIssuesTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		ctx = mdcForTool(ctx, "gitlab_issues_list_instance")
+		args := request.GetArguments()
+		debugLogger.DebugContext(ctx, "Received gitlab_issues_list_instance tool request", "args", args)
+
+		opts := &app.ListIssuesOptions{
+			State: "opened", // default
+			Limit: defaultLimit, // default
+		}
+
+		if state, ok := args["state"].(string); ok && state != "" {
+			opts.State = state
+		}
+
+		if labels, ok := args["labels"].(string); ok && labels != "" {
+			opts.Labels = labels
+		}
+
+		if limitFloat, ok := args["limit"].(float64); ok {
+			opts.Limit = int(limitFloat)
+		}
+
+		if listAll, ok := args["list_all"].(bool); ok {
+			opts.ListAll = listAll
+		}
+
+		if maxItemsFloat, ok := args["max_items"].(float64); ok {
+			opts.MaxItems = int(maxItemsFloat)
+		}
+
+		debugLogger.DebugContext(ctx, "Processing gitlab_issues_list_instance request", "opts", opts)
+
+		issues, err := appInstance.ListInstanceIssues(opts)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to list instance-wide issues", "error", err)
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to list instance-wide issues: %v", err)), nil
+		}
+
+		jsonData, err := marshalListResult(issues, opts.Truncated)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to marshal issues to JSON", "error", err)
+			return mcp.NewToolResultError("Failed to format issues response"), nil
+		}
+
+		debugLogger.InfoContext(ctx, "Successfully retrieved instance-wide issues", "count", len(issues))
+		return mcp.NewToolResultText(string(jsonData)), nil
+	})
+}
+
+// setupListIssueNotesTool creates and registers the gitlab_issue_notes_list tool.
+func setupListIssueNotesTool(s *server.MCPServer, appInstance *app.App, debugLogger *slog.Logger) {
+	listNotesTool := mcp.NewTool("gitlab_issue_notes_list",
+		mcp.WithDescription("List the notes/comments on an issue"),
+		mcp.WithString("project_path",
+			mcp.Required(),
+			mcp.Description("GitLab project path (e.g., 'namespace/project-name')"),
+		),
+		mcp.WithNumber("issue_iid",
+			mcp.Required(),
+			mcp.Description("Issue internal ID (IID)"),
+		),
+	)
+
+	s.AddTool(listNotesTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		ctx = mdcForTool(ctx, "gitlab_issue_notes_list")
+		args := request.GetArguments()
+		debugLogger.DebugContext(ctx, "Received gitlab_issue_notes_list tool request", "args", args)
+
+		projectPath, ok := args["project_path"].(string)
+		if !ok || projectPath == "" {
+			debugLogger.ErrorContext(ctx, "project_path is not a valid string", "value", args["project_path"])
+			return mcp.NewToolResultError("project_path must be a non-empty string"), nil
+		}
+
+		issueIIDFloat, ok := args["issue_iid"].(float64)
+		if !ok {
+			debugLogger.ErrorContext(ctx, "issue_iid is missing or not a number", "value", args["issue_iid"])
+			return mcp.NewToolResultError("issue_iid must be a number"), nil
+		}
+		issueIID := int64(issueIIDFloat)
+
+		debugLogger.DebugContext(ctx, "Processing gitlab_issue_notes_list request", "project_path", projectPath, "issue_iid", issueIID)
+
+		notes, err := appInstance.ListIssueNotes(projectPath, issueIID)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to list issue notes", "error", err, "project_path", projectPath, "issue_iid", issueIID)
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to list issue notes: %v", err)), nil
+		}
+
+		jsonData, err := json.Marshal(notes)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to marshal notes to JSON", "error", err)
+			return mcp.NewToolResultError("Failed to format notes response"), nil
+		}
+
+		debugLogger.InfoContext(ctx, "Successfully retrieved issue notes", "count", len(notes), "project_path", projectPath, "issue_iid", issueIID)
+		return mcp.NewToolResultText(string(jsonData)), nil
+	})
+}
+
+// setupCreateIssueNoteTool creates and registers the gitlab_issue_note_create tool.
+func setupCreateIssueNoteTool(s *server.MCPServer, appInstance *app.App, debugLogger *slog.Logger) {
+	createNoteTool := mcp.NewTool("gitlab_issue_note_create",
+		mcp.WithDescription("Add a note/comment to an issue"),
+		mcp.WithString("project_path",
+			mcp.Description("GitLab project path (e.g., 'namespace/project-name'). Required unless issue is set."),
+		),
+		mcp.WithNumber("issue_iid",
+			mcp.Description("Issue internal ID (IID). Required unless issue is set."),
+		),
+		mcp.WithString("issue",
+			mcp.Description(
+				"Issue reference as a full URL (https://gitlab.com/namespace/project/-/issues/42) or "+
+					"short form (namespace/project#42). Alternative to project_path + issue_iid.",
+			),
+		),
+		mcp.WithString("body",
+			mcp.Required(),
+			mcp.Description("Note body text"),
+		),
+	)
+
+	s.AddTool(createNoteTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		ctx = mdcForTool(ctx, "gitlab_issue_note_create")
+		args := request.GetArguments()
+		debugLogger.DebugContext(ctx, "Received gitlab_issue_note_create tool request", "args", args)
+
+		projectPath, issueIID, err := resolveIssueArgs(args)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to resolve issue reference", "error", err, "args", args)
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		body, ok := args["body"].(string)
+		if !ok || body == "" {
+			debugLogger.ErrorContext(ctx, "body is not a valid string", "value", args["body"])
+			return mcp.NewToolResultError("body must be a non-empty string"), nil
+		}
+
+		debugLogger.DebugContext(ctx, "Processing gitlab_issue_note_create request", "project_path", projectPath, "issue_iid", issueIID)
+
+		note, err := appInstance.AddIssueNote(projectPath, issueIID, &app.AddIssueNoteOptions{Body: body})
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to create issue note", "error", err, "project_path", projectPath, "issue_iid", issueIID)
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to create issue note: %v", err)), nil
+		}
+
+		jsonData, err := json.Marshal(note)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to marshal note to JSON", "error", err)
+			return mcp.NewToolResultError("Failed to format note response"), nil
+		}
+
+		debugLogger.InfoContext(ctx, "Successfully created issue note", "note_id", note.ID, "project_path", projectPath, "issue_iid", issueIID)
+		return mcp.NewToolResultText(string(jsonData)), nil
+	})
+}
+
+// setupReportFindingTool creates and registers the report_finding tool.
+func setupReportFindingTool(s *server.MCPServer, appInstance *app.App, debugLogger *slog.Logger) {
+	reportFindingTool := mcp.NewTool("report_finding",
+		mcp.WithDescription(
+			"File or update an issue for a single finding, deduplicating repeated reports of the "+
+				"same finding by a stable fingerprint instead of creating a new issue every time",
+		),
+		mcp.WithString("project_path",
+			mcp.Required(),
+			mcp.Description("GitLab project path (e.g., 'namespace/project-name')"),
+		),
+		mcp.WithString("title",
+			mcp.Required(),
+			mcp.Description("Finding title, used as the issue title when a new issue is created"),
+		),
+		mcp.WithString("description",
+			mcp.Description("Finding description, used as the issue body or appended as an occurrence note"),
+		),
+		mcp.WithString("severity",
+			mcp.Description("Finding severity (e.g. info, low, medium, high, critical); drives the severity label"),
+		),
+		mcp.WithString("fingerprint",
+			mcp.Required(),
+			mcp.Description("Stable identifier for the finding, used to find and update its existing issue"),
+		),
+		mcp.WithString("template_id",
+			mcp.Description("Reserved for a future per-template label/field preset"),
+		),
+	)
+
+	s.AddTool(reportFindingTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		ctx = mdcForTool(ctx, "report_finding")
+		args := request.GetArguments()
+		debugLogger.DebugContext(ctx, "Received report_finding tool request", "args", args)
+
+		projectPath, ok := args["project_path"].(string)
+		if !ok || projectPath == "" {
+			debugLogger.ErrorContext(ctx, "project_path is not a valid string", "value", args["project_path"])
+			return mcp.NewToolResultError("project_path must be a non-empty string"), nil
+		}
+
+		title, ok := args["title"].(string)
+		if !ok || title == "" {
+			debugLogger.ErrorContext(ctx, "title is not a valid string", "value", args["title"])
+			return mcp.NewToolResultError("title must be a non-empty string"), nil
+		}
+
+		fingerprint, ok := args["fingerprint"].(string)
+		if !ok || fingerprint == "" {
+			debugLogger.ErrorContext(ctx, "fingerprint is not a valid string", "value", args["fingerprint"])
+			return mcp.NewToolResultError("fingerprint must be a non-empty string"), nil
+		}
+
+		opts := &app.ReportFindingOptions{Title: title, Fingerprint: fingerprint}
+		if description, ok := args["description"].(string); ok {
+			opts.Description = description
+		}
+		if severity, ok := args["severity"].(string); ok {
+			opts.Severity = severity
+		}
+		if templateID, ok := args["template_id"].(string); ok {
+			opts.TemplateID = templateID
+		}
+
+		debugLogger.DebugContext(ctx, "Processing report_finding request", "project_path", projectPath, "fingerprint", fingerprint)
+
+		result, err := appInstance.ReportFinding(projectPath, opts)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to report finding", "error", err, "project_path", projectPath)
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to report finding: %v", err)), nil
+		}
+
+		jsonData, err := json.Marshal(result)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to marshal report finding result to JSON", "error", err)
+			return mcp.NewToolResultError("Failed to format report finding response"), nil
+		}
+
+		debugLogger.InfoContext(ctx, "Successfully reported finding",
+			"action", result.Action, "issue_iid", result.Issue.IID, "project_path", projectPath)
+		return mcp.NewToolResultText(string(jsonData)), nil
+	})
+}
+
+// setupGetIssueNoteTool creates and registers the gitlab_issue_note_get tool.
+func setupGetIssueNoteTool(s *server.MCPServer, appInstance *app.App, debugLogger *slog.Logger) {
+	getNoteTool := mcp.NewTool("gitlab_issue_note_get",
+		mcp.WithDescription("Get a single note on an issue by note ID"),
+		mcp.WithString("project_path",
+			mcp.Description("GitLab project path (e.g., 'namespace/project-name'). Required unless issue is set."),
+		),
+		mcp.WithNumber("issue_iid",
+			mcp.Description("Issue internal ID (IID). Required unless issue is set."),
+		),
+		mcp.WithString("issue",
+			mcp.Description(
+				"Issue reference as a full URL (https://gitlab.com/namespace/project/-/issues/42) or "+
+					"short form (namespace/project#42). Alternative to project_path + issue_iid.",
+			),
+		),
+		mcp.WithNumber("note_id",
+			mcp.Required(),
+			mcp.Description("Note ID"),
+		),
+	)
+
+	s.AddTool(getNoteTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		ctx = mdcForTool(ctx, "gitlab_issue_note_get")
+		args := request.GetArguments()
+		debugLogger.DebugContext(ctx, "Received gitlab_issue_note_get tool request", "args", args)
+
+		projectPath, issueIID, err := resolveIssueArgs(args)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to resolve issue reference", "error", err, "args", args)
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		noteIDFloat, ok := args["note_id"].(float64)
+		if !ok {
+			debugLogger.ErrorContext(ctx, "note_id is missing or not a number", "value", args["note_id"])
+			return mcp.NewToolResultError("note_id must be a number"), nil
+		}
+		noteID := int64(noteIDFloat)
+
+		debugLogger.DebugContext(ctx, "Processing gitlab_issue_note_get request",
+			"project_path", projectPath, "issue_iid", issueIID, "note_id", noteID)
+
+		note, err := appInstance.GetIssueNote(projectPath, issueIID, noteID)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to get issue note", "error", err, "project_path", projectPath, "issue_iid", issueIID)
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to get issue note: %v", err)), nil
+		}
+
+		jsonData, err := json.Marshal(note)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to marshal note to JSON", "error", err)
+			return mcp.NewToolResultError("Failed to format note response"), nil
+		}
+
+		debugLogger.InfoContext(ctx, "Successfully retrieved issue note", "project_path", projectPath, "note_id", noteID)
+		return mcp.NewToolResultText(string(jsonData)), nil
+	})
+}
+
+// setupUpdateIssueNoteTool creates and registers the gitlab_issue_note_update tool.
+func setupUpdateIssueNoteTool(s *server.MCPServer, appInstance *app.App, debugLogger *slog.Logger) {
+	updateNoteTool := mcp.NewTool("gitlab_issue_note_update",
+		mcp.WithDescription("Update the body of an existing note on an issue"),
+		mcp.WithString("project_path",
+			mcp.Description("GitLab project path (e.g., 'namespace/project-name'). Required unless issue is set."),
+		),
+		mcp.WithNumber("issue_iid",
+			mcp.Description("Issue internal ID (IID). Required unless issue is set."),
+		),
+		mcp.WithString("issue",
+			mcp.Description(
+				"Issue reference as a full URL (https://gitlab.com/namespace/project/-/issues/42) or "+
+					"short form (namespace/project#42). Alternative to project_path + issue_iid.",
+			),
+		),
+		mcp.WithNumber("note_id",
+			mcp.Required(),
+			mcp.Description("Note ID"),
+		),
+		mcp.WithString("body",
+			mcp.Required(),
+			mcp.Description("New note body"),
+		),
+	)
+
+	s.AddTool(updateNoteTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		ctx = mdcForTool(ctx, "gitlab_issue_note_update")
+		args := request.GetArguments()
+		debugLogger.DebugContext(ctx, "Received gitlab_issue_note_update tool request", "args", args)
+
+		projectPath, issueIID, err := resolveIssueArgs(args)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to resolve issue reference", "error", err, "args", args)
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		noteIDFloat, ok := args["note_id"].(float64)
+		if !ok {
+			debugLogger.ErrorContext(ctx, "note_id is missing or not a number", "value", args["note_id"])
+			return mcp.NewToolResultError("note_id must be a number"), nil
+		}
+		noteID := int64(noteIDFloat)
+
+		body, ok := args["body"].(string)
+		if !ok || body == "" {
+			debugLogger.ErrorContext(ctx, "body is not a valid string", "value", args["body"])
+			return mcp.NewToolResultError("body must be a non-empty string"), nil
+		}
+
+		debugLogger.DebugContext(ctx, "Processing gitlab_issue_note_update request",
+			"project_path", projectPath, "issue_iid", issueIID, "note_id", noteID)
+
+		note, err := appInstance.UpdateIssueNote(projectPath, issueIID, noteID, body)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to update issue note", "error", err, "project_path", projectPath, "issue_iid", issueIID)
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to update issue note: %v", err)), nil
+		}
+
+		jsonData, err := json.Marshal(note)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to marshal note to JSON", "error", err)
+			return mcp.NewToolResultError("Failed to format note response"), nil
+		}
+
+		debugLogger.InfoContext(ctx, "Successfully updated issue note", "project_path", projectPath, "note_id", noteID)
+		return mcp.NewToolResultText(string(jsonData)), nil
+	})
+}
+
+// setupDeleteIssueNoteTool creates and registers the gitlab_issue_note_delete tool.
+func setupDeleteIssueNoteTool(s *server.MCPServer, appInstance *app.App, debugLogger *slog.Logger) {
+	deleteNoteTool := mcp.NewTool("gitlab_issue_note_delete",
+		mcp.WithDescription("Delete a note from an issue"),
+		mcp.WithString("project_path",
+			mcp.Description("GitLab project path (e.g., 'namespace/project-name'). Required unless issue is set."),
+		),
+		mcp.WithNumber("issue_iid",
+			mcp.Description("Issue internal ID (IID). Required unless issue is set."),
+		),
+		mcp.WithString("issue",
+			mcp.Description(
+				"Issue reference as a full URL (https://gitlab.com/namespace/project/-/issues/42) or "+
+					"short form (namespace/project#42). Alternative to project_path + issue_iid.",
+			),
+		),
+		mcp.WithNumber("note_id",
+			mcp.Required(),
+			mcp.Description("Note ID"),
+		),
+	)
+
+	s.AddTool(deleteNoteTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		ctx = mdcForTool(ctx, "gitlab_issue_note_delete")
+		args := request.GetArguments()
+		debugLogger.DebugContext(ctx, "Received gitlab_issue_note_delete tool request", "args", args)
+
+		projectPath, issueIID, err := resolveIssueArgs(args)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to resolve issue reference", "error", err, "args", args)
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		noteIDFloat, ok := args["note_id"].(float64)
+		if !ok {
+			debugLogger.ErrorContext(ctx, "note_id is missing or not a number", "value", args["note_id"])
+			return mcp.NewToolResultError("note_id must be a number"), nil
+		}
+		noteID := int64(noteIDFloat)
+
+		debugLogger.DebugContext(ctx, "Processing gitlab_issue_note_delete request",
+			"project_path", projectPath, "issue_iid", issueIID, "note_id", noteID)
+
+		if err := appInstance.DeleteIssueNote(projectPath, issueIID, noteID); err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to delete issue note", "error", err, "project_path", projectPath, "issue_iid", issueIID)
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to delete issue note: %v", err)), nil
+		}
+
+		debugLogger.InfoContext(ctx, "Successfully deleted issue note", "project_path", projectPath, "note_id", noteID)
+		return mcp.NewToolResultText(fmt.Sprintf("Note %d deleted successfully", noteID)), nil
+	})
+}
+
+// setupGetLogLevelTool creates and registers the gitlab_get_log_level tool.
+func setupGetLogLevelTool(s *server.MCPServer, appInstance *app.App, debugLogger *slog.Logger) {
+	getLogLevelTool := mcp.NewTool("gitlab_get_log_level",
+		mcp.WithDescription("Get the server's current runtime log level"),
+	)
+
+	s.AddTool(getLogLevelTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		ctx = mdcForTool(ctx, "gitlab_get_log_level")
+		level := appInstance.GetLogLevel()
+		debugLogger.DebugContext(ctx, "Retrieved log level", "level", level)
+		return mcp.NewToolResultText(level), nil
+	})
+}
+
+// setupSetLogLevelTool creates and registers the gitlab_set_log_level tool.
+func setupSetLogLevelTool(s *server.MCPServer, appInstance *app.App, debugLogger *slog.Logger) {
+	setLogLevelTool := mcp.NewTool("gitlab_set_log_level",
+		mcp.WithDescription("Change the server's runtime log level without restarting it"),
+		mcp.WithString("level",
+			mcp.Required(),
+			mcp.Description("New log level: debug, info, warn, or error"),
+		),
+	)
+
+	s.AddTool(setLogLevelTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		ctx = mdcForTool(ctx, "gitlab_set_log_level")
+		args := request.GetArguments()
+
+		level, ok := args["level"].(string)
+		if !ok || level == "" {
+			debugLogger.ErrorContext(ctx, "level is not a valid string", "value", args["level"])
+			return mcp.NewToolResultError("level must be one of: debug, info, warn, error"), nil
+		}
+
+		previous, err := appInstance.SetLogLevel(level)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to set log level", "error", err, "level", level)
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		jsonData, err := json.Marshal(map[string]string{
+			"previous": previous,
+			"new":      appInstance.GetLogLevel(),
+		})
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to marshal log level response to JSON", "error", err)
+			return mcp.NewToolResultError("Failed to format log level response"), nil
+		}
+
+		debugLogger.InfoContext(ctx, "Log level changed via MCP tool", "previous", previous, "new", appInstance.GetLogLevel())
+		return mcp.NewToolResultText(string(jsonData)), nil
+	})
+}
+
+// gitlabResourceURITemplate is the MCP resource URI template for issues, merge requests, and
+// pipelines, matching app.ResourceURI's gitlab://project/{id}/{kind}/{iid} shape.
+const gitlabResourceURITemplate = "gitlab://project/{id}/{kind}/{iid}"
+
+// setupGitLabResources registers the gitlab://project/{id}/{kind}/{iid} resource template and
+// starts a PollingDriver-backed app.ResourceManager that broadcasts
+// notifications/resources/updated whenever a subscribed issue, merge request, or pipeline
+// changes.
+func setupGitLabResources(s *server.MCPServer, appInstance *app.App, debugLogger *slog.Logger) {
+	resourceTemplate := mcp.NewResourceTemplate(
+		gitlabResourceURITemplate,
+		"GitLab resource",
+		mcp.WithTemplateDescription("A GitLab issue, merge request, or pipeline, addressed by project ID, kind, and IID"),
+		mcp.WithTemplateMIMEType("application/json"),
+	)
+
+	s.AddResourceTemplate(resourceTemplate, func(
+		ctx context.Context, request mcp.ReadResourceRequest,
+	) ([]mcp.ResourceContents, error) {
+		body, err := readGitLabResource(appInstance, request.Params.URI)
+		if err != nil {
+			debugLogger.ErrorContext(ctx, "Failed to read GitLab resource", "uri", request.Params.URI, "error", err)
+			return nil, fmt.Errorf("failed to read resource %s: %w", request.Params.URI, err)
+		}
+		return []mcp.ResourceContents{
+			mcp.TextResourceContents{URI: request.Params.URI, MIMEType: "application/json", Text: string(body)},
+		}, nil
+	})
+
+	driver := app.NewPollingDriver(appInstance, 0)
+	app.NewResourceManager(driver, func(uri string) {
+		debugLogger.Debug("GitLab resource changed", "uri", uri)
+		s.SendNotificationToAllClients("notifications/resources/updated", map[string]any{"uri": uri})
+	})
+}
+
+// readGitLabResource fetches and JSON-encodes the issue, merge request, or pipeline identified by
+// a gitlab://project/{id}/{kind}/{iid} resource URI.
+func readGitLabResource(appInstance *app.App, uri string) ([]byte, error) {
+	projectID, kind, iid, err := app.ParseResourceURI(uri)
+	if err != nil {
+		return nil, err
+	}
+	projectPath := strconv.FormatInt(projectID, 10)
+
+	var resource any
+	switch kind {
+	case app.ResourceKindIssue:
+		resource, err = appInstance.GetProjectIssue(projectPath, iid)
+	case app.ResourceKindMergeRequest:
+		resource, err = appInstance.GetProjectMergeRequest(projectPath, iid)
+	case app.ResourceKindPipeline:
+		resource, err = appInstance.GetPipeline(projectPath, iid)
+	default:
+		return nil, fmt.Errorf("%w: %q", app.ErrUnknownResourceURI, uri)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(resource)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal resource: %w", err)
+	}
+	return data, nil
+}
+
+// setupMetricsSink builds the metrics.Sink selected by GITLAB_MCP_METRICS_BACKEND ("prometheus",
+// "statsd", or unset for the default no-op sink). For "prometheus" it also starts a background
+// HTTP server exposing /metrics on GITLAB_MCP_METRICS_ADDR (default ":9090").
+// setupRetryConfig builds the App's retry policy from GITLAB_MCP_RETRY_MAX_ATTEMPTS,
+// GITLAB_MCP_RETRY_BASE_DELAY_MS, and GITLAB_MCP_RETRY_MAX_DELAY_MS, falling back to
+// app.DefaultRetryPolicy() for whichever of the three aren't set or don't parse.
+func setupRetryConfig() app.RetryConfig {
+	policy := app.DefaultRetryPolicy()
+
+	if raw := os.Getenv("GITLAB_MCP_RETRY_MAX_ATTEMPTS"); raw != "" {
+		if v, err := strconv.Atoi(raw); err == nil && v > 0 {
+			policy.MaxAttempts = v
+		}
+	}
+	if raw := os.Getenv("GITLAB_MCP_RETRY_BASE_DELAY_MS"); raw != "" {
+		if v, err := strconv.Atoi(raw); err == nil && v > 0 {
+			policy.BaseDelay = time.Duration(v) * time.Millisecond
+		}
+	}
+	if raw := os.Getenv("GITLAB_MCP_RETRY_MAX_DELAY_MS"); raw != "" {
+		if v, err := strconv.Atoi(raw); err == nil && v > 0 {
+			policy.MaxDelay = time.Duration(v) * time.Millisecond
+		}
+	}
+
+	return app.DefaultRetryConfig(policy)
+}
+
+// setupReportConfig loads report_finding's severity mapping from app.DefaultReportConfigPath()
+// (~/.config/gitlab-mcp/config.yaml), falling back to app.DefaultReportConfig() and logging a
+// warning if the file exists but fails to parse.
+func setupReportConfig(debugLogger *slog.Logger) app.ReportConfig {
+	cfg, err := app.LoadReportConfig(app.DefaultReportConfigPath())
+	if err != nil {
+		debugLogger.Warn("Failed to load report config, using defaults", "error", err)
+		return app.DefaultReportConfig()
+	}
+	return cfg
+}
+
+func setupMetricsSink(debugLogger *slog.Logger) metrics.Sink {
+	switch os.Getenv("GITLAB_MCP_METRICS_BACKEND") {
+	case "prometheus":
+		addr := os.Getenv("GITLAB_MCP_METRICS_ADDR")
+		if addr == "" {
+			addr = ":9090"
+		}
+		registry := prometheus.NewRegistry()
+		mux := http.NewServeMux()
+		mux.Handle("/metrics", promhttp.HandlerFor(registry, promhttp.HandlerOpts{}))
+		go func() {
+			if err := http.ListenAndServe(addr, mux); err != nil {
+				debugLogger.Error("Prometheus metrics server stopped", "error", err, "addr", addr)
+			}
+		}()
+		debugLogger.Info("Prometheus metrics enabled", "addr", addr)
+		return metrics.NewPrometheusSink(registry)
+
+	case "statsd":
+		addr := os.Getenv("GITLAB_MCP_METRICS_STATSD_ADDR")
+		if addr == "" {
+			addr = "127.0.0.1:8125"
+		}
+		sink, err := metrics.NewStatsdSink(addr)
+		if err != nil {
+			debugLogger.Error("Failed to start statsd metrics sink, falling back to no-op", "error", err, "addr", addr)
+			return metrics.NewNoopSink()
+		}
+		debugLogger.Info("Statsd metrics enabled", "addr", addr)
+		return sink
+
+	default:
+		return metrics.NewNoopSink()
+	}
+}
+
+// requireBearerToken wraps next with a check that the request carries an "Authorization: Bearer
+// <token>" header matching the MCP_AUTH_TOKEN environment variable. If that variable is unset, no
+// auth is enforced and next is returned unwrapped.
+func requireBearerToken(next http.Handler) http.Handler {
+	token := os.Getenv("MCP_AUTH_TOKEN")
+	if token == "" {
+		return next
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer "+token {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// runServer starts s on the given transport ("stdio", "sse", or "http"), blocking until it
+// returns an error. The sse/http transports listen on addr and enforce MCP_AUTH_TOKEN via
+// requireBearerToken.
+func runServer(s *server.MCPServer, transport, addr string, debugLogger *slog.Logger) error {
+	switch transport {
+	case "", "stdio":
+		return server.ServeStdio(s)
+
+	case "sse":
+		sseServer := server.NewSSEServer(s)
+		debugLogger.Info("Starting SSE MCP server", "listen", addr)
+		return http.ListenAndServe(addr, requireBearerToken(sseServer))
+
+	case "http":
+		httpServer := server.NewStreamableHTTPServer(s)
+		debugLogger.Info("Starting streamable HTTP MCP server", "listen", addr)
+		return http.ListenAndServe(addr, requireBearerToken(httpServer))
+
+	default:
+		return fmt.Errorf("%w: %q", ErrUnknownTransport, transport)
+	}
+}
+
+func printHelp() {
+	fmt.Printf(`GitLab MCP Server %s
+
+A Model Context Protocol (MCP) server that provides GitLab integration tools for Claude Code.
+
+USAGE:
+    gitlab-mcp [OPTIONS]
+
+OPTIONS:
+    -h, --help         Show this help message
+    -v, --version      Show version information
+    --transport        Transport to serve on: "stdio" (default), "sse", or "http"
+    --listen           Address to listen on for the "sse"/"http" transports (default: ":8080")
+
+ENVIRONMENT VARIABLES:
+    MCP_AUTH_TOKEN           Bearer token required on requests to the "sse"/"http" transports.
+                             Unset disables auth (stdio is always unauthenticated; trusted by
+                             virtue of being spawned locally)
+    GITLAB_TOKEN             GitLab API token (required unless CI_JOB_TOKEN is set or a
+                             credential for GITLAB_URI is configured in
+                             ~/.config/gitlab-mcp/credentials.yaml)
+    GITLAB_AUTH_TYPE         How GITLAB_TOKEN is sent: "private" (default, PRIVATE-TOKEN header)
+                             or "oauth" (Authorization: Bearer, for instances behind SSO/OIDC)
+    GITLAB_OAUTH_REFRESH_TOKEN   Refresh token used to renew GITLAB_TOKEN when auth type is
+                                 "oauth" and the access token expires
+    GITLAB_OAUTH_CLIENT_ID       OAuth client ID paired with GITLAB_OAUTH_REFRESH_TOKEN
+    GITLAB_OAUTH_CLIENT_SECRET   OAuth client secret paired with GITLAB_OAUTH_REFRESH_TOKEN
+    GITLAB_OAUTH_TOKEN_URL       OAuth token endpoint used to refresh GITLAB_TOKEN
+    CI_JOB_TOKEN             GitLab CI job token, used automatically inside pipeline jobs in
+                             place of GITLAB_TOKEN
+    GITLAB_URI               GitLab instance URI (default: https://gitlab.com/)
+    GITLAB_MCP_LOG_FORMAT    Log handler: "json" (default) or "text"
+    GITLAB_MCP_LOG_LEVEL     Log level: debug, info, warn, or error (default: debug)
+    GITLAB_MCP_LOG_LEVEL_FILE  Path to a file containing the log level, checked before
+                               GITLAB_MCP_LOG_LEVEL
+    GITLAB_MCP_METRICS_BACKEND       Metrics sink: "prometheus", "statsd", or unset (no-op)
+    GITLAB_MCP_METRICS_ADDR          Prometheus /metrics listen address (default: :9090)
+    GITLAB_MCP_METRICS_STATSD_ADDR   statsd collector address (default: 127.0.0.1:8125)
+    GITLAB_MCP_RETRY_MAX_ATTEMPTS    Max attempts per retryable GitLab API call (default: 4)
+    GITLAB_MCP_RETRY_BASE_DELAY_MS   Base retry backoff in milliseconds (default: 200)
+    GITLAB_MCP_RETRY_MAX_DELAY_MS    Cap on retry backoff in milliseconds (default: 5000)
+    GITLAB_RESOLVER_CACHE_TTL        TTL for cached user/milestone/label resolver lookups, as a
+                                      Go duration (e.g. "5m") (default: 5m)
+
+    report_finding's severity label mapping is read from ~/.config/gitlab-mcp/config.yaml
+    (severity_order, severity_labels); a missing file falls back to a built-in
+    info/low/medium/high/critical mapping.
+
+DESCRIPTION:
+    This MCP server provides the following tools for GitLab integration:
+    
+    • list_issues     - List issues for a GitLab project
+    • create_issues   - Create new issues with metadata
+    • update_issues   - Update existing issues
+    • list_labels     - List project labels with filtering
+    • list_epic_boards - List epic boards for a GitLab group
+    • get_epic_board  - Get a single epic board with its lists and epics
+    • filter_by_iteration - List project issues belonging to a specific iteration
+    • add_issues_to_epic - Bulk-assign many issues to a single epic
+    • list_pipelines  - List CI/CD pipelines for a project, filterable by ref and status
+    • get_pipeline_status - Get a single pipeline's normalized status
+    • trigger_pipeline - Trigger a new pipeline run for a branch or tag
+    • retry_pipeline  - Retry the failed or canceled jobs of a pipeline
+    • cancel_pipeline - Cancel a running pipeline
+    • get_job_log     - Get the trace log of a single CI/CD job
+    • get_last_pipeline - Get the most recent pipeline for a given branch or tag
+    • cancel_pipeline_job - Cancel a single running CI/CD job
+    • play_job        - Trigger a manual CI/CD job to start running
+    • list_pipeline_jobs - List the jobs belonging to a pipeline
+    • get_pipeline_variables - Get the variables used to trigger a pipeline
+    • list_merge_requests - List merge requests for a project, filterable by state/branch/author/labels
+    • list_group_merge_requests - List merge requests across all projects in a group
+    • list_group_projects - List the projects belonging to a group, optionally including subgroups
+    • list_group_issues - List issues across all projects in a group
+    • list_group_labels - List labels defined at the group level
+    • get_namespace    - Resolve a path to its namespace and report whether it is a user or group
+    • get_merge_request - Get a single merge request by IID
+    • get_merge_request_changes - Get a merge request along with its file diffs
+    • get_merge_request_commits - List the commits that make up a merge request
+    • create_merge_request - Create a new merge request for a GitLab project
+    • update_merge_request - Update a merge request's title, description, state, branch, labels, assignees, or reviewers
+    • approve_merge_request - Approve a merge request on behalf of the authenticated user
+    • merge_request_approvals_get - Get the approval status of a merge request
+    • merge_request_unapprove - Withdraw the authenticated user's approval of a merge request
+    • merge_request_approval_configuration_change - Change a merge request's approvals-required count
+    • approval_rules_list - List a project's merge request approval rules
+    • approval_rule_create - Create a project-level merge request approval rule
+    • approval_rule_update - Update a project-level merge request approval rule
+    • approval_rule_delete - Delete a project-level merge request approval rule
+    • milestones_list - List a project's milestones, optionally filtered by state or search term
+    • milestone_get_by_title - Resolve a project milestone by its exact title
+    • milestone_create - Create a new milestone in a GitLab project
+    • milestone_update - Update an existing project milestone
+    • milestone_delete - Delete a milestone from a GitLab project
+    • merge_merge_request - Merge (accept) a merge request, optionally squashing or removing the source branch
+    • cancel_merge_request_auto_merge - Cancel a pending merge-when-pipeline-succeeds
+    • close_merge_request - Close a merge request without merging it
+    • rebase_merge_request - Schedule a rebase of a merge request's source branch onto its target branch
+    • merge_request_notes_list - List the notes (comments) on a merge request
+    • get_linked_issues - Scan an issue's or merge request's description and comments for references to other issues/merge requests
+    • export_project_issues - Export every issue in a project as NDJSON or a tar of JSON files, for offline analysis
+    • delete_merge_request - Delete a merge request
+    • comment_merge_request - Add a comment to a merge request
+    • list_merge_request_discussions - List discussion threads on a merge request
+    • get_merge_request_discussion - Get a single discussion thread on a merge request
+    • create_merge_request_discussion - Start a discussion thread on a merge request, optionally anchored to a diff line
+    • add_merge_request_discussion_note - Reply to a discussion thread on a merge request
+    • resolve_merge_request_discussion - Mark a merge request discussion thread resolved or unresolved
+    • resolve_merge_request_discussions_by_author - Resolve all of a merge request's discussion threads started by a given username
+    • list_issue_discussions - List discussion threads on an issue
+    • get_issue_discussion - Get a single discussion thread on an issue
+    • create_issue_discussion - Start a discussion thread on an issue
+    • add_issue_discussion_note - Reply to a discussion thread on an issue
+    • gitlab_time_set_estimate - Set the time estimate on an issue or merge request
+    • gitlab_time_reset_estimate - Clear the time estimate on an issue or merge request
+    • gitlab_time_add_spent - Log time spent on an issue or merge request, accepting human-readable durations
+    • gitlab_time_reset_spent - Clear all logged time spent on an issue or merge request
+    • gitlab_time_reset_all - Clear both the time estimate and logged spent time on an issue or merge request
+    • gitlab_time_get_spent - Get the time-tracking totals for an issue or merge request
+    • gitlab_issue_delete - Delete an issue from a project
+    • gitlab_issue_move - Move an issue to a different project
+    • gitlab_issue_subscribe - Subscribe the current user to an issue
+    • gitlab_issue_unsubscribe - Unsubscribe the current user from an issue
+    • gitlab_issue_create_todo - Create a to-do item for the current user on an issue
+    • gitlab_issues_list_instance - List issues across the whole GitLab instance
+    • gitlab_issue_notes_list - List the notes on an issue
+    • gitlab_issue_note_create - Add a note/comment to an issue
+    • report_finding - File or update an issue for a finding, deduplicated by a stable fingerprint
+    • gitlab_issue_note_get - Get a single note on an issue
+    • gitlab_issue_note_update - Update the body of a note on an issue
+    • gitlab_issue_note_delete - Delete a note from an issue
+    • gitlab_get_log_level - Get the server's current runtime log level
+    • gitlab_set_log_level - Change the server's runtime log level without restarting it
+
+    RESOURCES:
+    • gitlab://project/{id}/issues/{iid}         - A single issue
+    • gitlab://project/{id}/merge_requests/{iid} - A single merge request
+    • gitlab://project/{id}/pipelines/{iid}      - A single pipeline
+    Subscribed resources are polled for changes and trigger a
+    notifications/resources/updated message when they change.
+
+    The server communicates via JSON-RPC 2.0 over stdin/stdout and is designed
+    to be used with Claude Code's MCP architecture.
+
+EXAMPLES:
+    # Start the MCP server (typically called by Claude Code)
+    gitlab-mcp
+    
+    # Show help
+    gitlab-mcp -h
+    
+    # Show version
+    gitlab-mcp -v
+
+For more information, visit: https://github.com/sgaunet/gitlab-mcp
+`, version)
+}
+
+func main() {
+	// Parse command line flags
+	var (
+		showHelp    = flag.Bool("h", false, "Show help message")
+		showHelpLong = flag.Bool("help", false, "Show help message")
+		showVersion = flag.Bool("v", false, "Show version information")
+		showVersionLong = flag.Bool("version", false, "Show version information")
+		transport   = flag.String("transport", "stdio", "Transport to serve on: stdio, sse, or http")
+		listen      = flag.String("listen", ":8080", "Address to listen on for the sse/http transports")
+	)
+	
+	flag.Parse()
+	
+	// Handle help flags
+	if *showHelp || *showHelpLong {
+		printHelp()
+		os.Exit(0)
+	}
+	
+	// Handle version flags
+	if *showVersion || *showVersionLong {
+		fmt.Printf("%s\n", version)
+		os.Exit(0)
+	}
+
+	// Initialize the app
+	appInstance, err := app.New()
+	if err != nil {
+		log.Fatalf("Failed to initialize app: %v", err)
+	}
+
+	// Set debug logger, sharing its LevelVar with the app so gitlab_set_log_level can adjust the
+	// level of every subsystem at runtime without a restart.
+	logLevel := logger.NewLevelVar("debug")
+	debugLogger := logger.NewLogger(logLevel)
+	appInstance.SetLogger(debugLogger)
+	appInstance.SetLogLevelVar(logLevel)
+	appInstance.SetMetricsSink(setupMetricsSink(debugLogger))
+	appInstance.SetRetryConfig(setupRetryConfig())
+	appInstance.SetReportConfig(setupReportConfig(debugLogger))
+
+	debugLogger.Info("Starting GitLab MCP Server", "version", version)
+
+	// Validate connection
+	if err := appInstance.ValidateConnection(); err != nil {
+		log.Fatalf("Failed to validate GitLab connection: %v", err)
+	}
+
+	// Create MCP server
+	s := server.NewMCPServer(
+		"GitLab MCP Server",
+		version,
+		server.WithToolCapabilities(true),
+		server.WithResourceCapabilities(true, false),
+	)
+
+	// Create and register list_issues tool
+	setupListIssuesTool(s, appInstance, debugLogger)
+
+	// Create and register create_issues tool
+	setupCreateIssueTool(s, appInstance, debugLogger)
+
+	// Create and register update_issues tool
+	setupUpdateIssueTool(s, appInstance, debugLogger)
+
+	// Create and register list_labels tool
+	setupListLabelsTool(s, appInstance, debugLogger)
+
+	// Create and register list_epic_boards tool
+	setupListEpicBoardsTool(s, appInstance, debugLogger)
+
+	// Create and register get_epic_board tool
+	setupGetEpicBoardTool(s, appInstance, debugLogger)
+
+	// Create and register filter_by_iteration tool
+	setupFilterByIterationTool(s, appInstance, debugLogger)
+
+	// Create and register add_issues_to_epic tool
+	setupAddIssuesToEpicTool(s, appInstance, debugLogger)
+
+	// Create and register update_projects_description tool
+	setupUpdateProjectsDescriptionTool(s, appInstance, debugLogger)
+
+	// Create and register update_projects_topics tool
+	setupUpdateProjectsTopicsTool(s, appInstance, debugLogger)
+
+	// Create and register list_pipelines tool
+	setupListPipelinesTool(s, appInstance, debugLogger)
+
+	// Create and register get_pipeline_status tool
+	setupGetPipelineStatusTool(s, appInstance, debugLogger)
+
+	// Create and register trigger_pipeline tool
+	setupTriggerPipelineTool(s, appInstance, debugLogger)
+
+	// Create and register retry_pipeline tool
+	setupRetryPipelineTool(s, appInstance, debugLogger)
+
+	// Create and register cancel_pipeline tool
+	setupCancelPipelineTool(s, appInstance, debugLogger)
+
+	// Create and register get_job_log tool
+	setupGetJobLogTool(s, appInstance, debugLogger)
+
+	// Create and register get_last_pipeline tool
+	setupGetLastPipelineTool(s, appInstance, debugLogger)
+
+	// Create and register cancel_pipeline_job tool
+	setupCancelPipelineJobTool(s, appInstance, debugLogger)
+
+	// Create and register play_job tool
+	setupPlayJobTool(s, appInstance, debugLogger)
+
+	// Create and register list_pipeline_jobs tool
+	setupListPipelineJobsTool(s, appInstance, debugLogger)
+
+	// Create and register get_pipeline_variables tool
+	setupGetPipelineVariablesTool(s, appInstance, debugLogger)
+
+	// Create and register list_merge_requests tool
+	setupListMergeRequestsTool(s, appInstance, debugLogger)
+
+	// Create and register list_group_merge_requests tool
+	setupListGroupMergeRequestsTool(s, appInstance, debugLogger)
+
+	// Create and register list_group_projects tool
+	setupListGroupProjectsTool(s, appInstance, debugLogger)
+
+	// Create and register list_group_issues tool
+	setupListGroupIssuesTool(s, appInstance, debugLogger)
+
+	// Create and register list_group_labels tool
+	setupListGroupLabelsTool(s, appInstance, debugLogger)
+
+	// Create and register get_namespace tool
+	setupGetNamespaceTool(s, appInstance, debugLogger)
+
+	// Create and register get_merge_request tool
+	setupGetMergeRequestTool(s, appInstance, debugLogger)
+
+	// Create and register get_merge_request_changes tool
+	setupGetMergeRequestChangesTool(s, appInstance, debugLogger)
+
+	// Create and register get_merge_request_commits tool
+	setupGetMergeRequestCommitsTool(s, appInstance, debugLogger)
+
+	// Create and register update_merge_request tool
+	setupCreateMergeRequestTool(s, appInstance, debugLogger)
+	setupUpdateMergeRequestTool(s, appInstance, debugLogger)
+
+	// Create and register approve_merge_request tool
+	setupApproveMergeRequestTool(s, appInstance, debugLogger)
+
+	// Create and register merge_request_approvals_get tool
+	setupGetMergeRequestApprovalsTool(s, appInstance, debugLogger)
+
+	// Create and register merge_request_unapprove tool
+	setupUnapproveMergeRequestTool(s, appInstance, debugLogger)
+
+	// Create and register merge_request_approval_configuration_change tool
+	setupChangeApprovalConfigurationTool(s, appInstance, debugLogger)
+
+	// Create and register approval_rules_list tool
+	setupListApprovalRulesTool(s, appInstance, debugLogger)
+
+	// Create and register approval_rule_create tool
+	setupCreateApprovalRuleTool(s, appInstance, debugLogger)
+
+	// Create and register approval_rule_update tool
+	setupUpdateApprovalRuleTool(s, appInstance, debugLogger)
+
+	// Create and register approval_rule_delete tool
+	setupDeleteApprovalRuleTool(s, appInstance, debugLogger)
+
+	// Create and register milestones_list tool
+	setupListMilestonesTool(s, appInstance, debugLogger)
+
+	// Create and register milestone_get_by_title tool
+	setupGetMilestoneByTitleTool(s, appInstance, debugLogger)
+
+	// Create and register milestone_create tool
+	setupCreateMilestoneTool(s, appInstance, debugLogger)
+
+	// Create and register milestone_update tool
+	setupUpdateMilestoneTool(s, appInstance, debugLogger)
+
+	// Create and register milestone_delete tool
+	setupDeleteMilestoneTool(s, appInstance, debugLogger)
+
+	// Create and register merge_merge_request tool
+	setupMergeMergeRequestTool(s, appInstance, debugLogger)
+
+	// Create and register cancel_merge_request_auto_merge tool
+	setupCancelMergeRequestAutoMergeTool(s, appInstance, debugLogger)
+
+	// Create and register close_merge_request tool
+	setupCloseMergeRequestTool(s, appInstance, debugLogger)
+
+	// Create and register rebase_merge_request tool
+	setupRebaseMergeRequestTool(s, appInstance, debugLogger)
+	setupMarkMergeRequestReadyTool(s, appInstance, debugLogger)
+
+	// Create and register merge_request_notes_list tool
+	setupListMergeRequestNotesTool(s, appInstance, debugLogger)
+
+	// Create and register get_linked_issues tool
+	setupGetLinkedIssuesTool(s, appInstance, debugLogger)
+
+	// Create and register export_project_issues tool
+	setupExportProjectIssuesTool(s, appInstance, debugLogger)
+
+	// Create and register delete_merge_request tool
+	setupDeleteMergeRequestTool(s, appInstance, debugLogger)
+
+	// Create and register comment_merge_request tool
+	setupCommentMergeRequestTool(s, appInstance, debugLogger)
+
+	// Create and register list_merge_request_discussions tool
+	setupListMergeRequestDiscussionsTool(s, appInstance, debugLogger)
+
+	// Create and register get_merge_request_discussion tool
+	setupGetMergeRequestDiscussionTool(s, appInstance, debugLogger)
+
+	// Create and register create_merge_request_discussion tool
+	setupCreateMergeRequestDiscussionTool(s, appInstance, debugLogger)
+
+	// Create and register add_merge_request_discussion_note tool
+	setupAddMergeRequestDiscussionNoteTool(s, appInstance, debugLogger)
+
+	// Create and register resolve_merge_request_discussion tool
+	setupResolveMergeRequestDiscussionTool(s, appInstance, debugLogger)
+
+	// Create and register resolve_merge_request_discussions_by_author tool
+	setupResolveMergeRequestDiscussionsByAuthorTool(s, appInstance, debugLogger)
+
+	// Create and register list_issue_discussions tool
+	setupListIssueDiscussionsTool(s, appInstance, debugLogger)
+
+	// Create and register get_issue_discussion tool
+	setupGetIssueDiscussionTool(s, appInstance, debugLogger)
+
+	// Create and register create_issue_discussion tool
+	setupCreateIssueDiscussionTool(s, appInstance, debugLogger)
+
+	// Create and register add_issue_discussion_note tool
+	setupAddIssueDiscussionNoteTool(s, appInstance, debugLogger)
+
+	// Create and register gitlab_time_set_estimate tool
+	setupSetTimeEstimateTool(s, appInstance, debugLogger)
+
+	// Create and register gitlab_time_reset_estimate tool
+	setupResetTimeEstimateTool(s, appInstance, debugLogger)
+
+	// Create and register gitlab_time_add_spent tool
+	setupAddSpentTimeTool(s, appInstance, debugLogger)
+
+	// Create and register gitlab_time_reset_spent tool
+	setupResetSpentTimeTool(s, appInstance, debugLogger)
+
+	// Create and register gitlab_time_reset_all tool
+	setupResetTimeStatsTool(s, appInstance, debugLogger)
+
+	// Create and register gitlab_time_get_spent tool
+	setupGetTimeSpentTool(s, appInstance, debugLogger)
+
+	// Create and register gitlab_issue_delete tool
+	setupDeleteIssueTool(s, appInstance, debugLogger)
+
+	// Create and register gitlab_issue_move tool
+	setupMoveIssueTool(s, appInstance, debugLogger)
+
+	// Create and register gitlab_issue_subscribe tool
+	setupSubscribeToIssueTool(s, appInstance, debugLogger)
+
+	// Create and register gitlab_issue_unsubscribe tool
+	setupUnsubscribeFromIssueTool(s, appInstance, debugLogger)
+
+	// Create and register gitlab_issue_create_todo tool
+	setupCreateIssueTodoTool(s, appInstance, debugLogger)
+
+	// Create and register gitlab_issues_list_instance tool
+	setupListInstanceIssuesTool(s, appInstance, debugLogger)
+
+	// Create and register gitlab_issue_notes_list tool
+	setupListIssueNotesTool(s, appInstance, debugLogger)
+
+	// Create and register gitlab_issue_note_create tool
+	setupCreateIssueNoteTool(s, appInstance, debugLogger)
+
+	// Create and register report_finding tool
+	setupReportFindingTool(s, appInstance, debugLogger)
+
+	// Create and register gitlab_issue_note_get tool
+	setupGetIssueNoteTool(s, appInstance, debugLogger)
+
+	// Create and register gitlab_issue_note_update tool
+	setupUpdateIssueNoteTool(s, appInstance, debugLogger)
+
+	// Create and register gitlab_issue_note_delete tool
+	setupDeleteIssueNoteTool(s, appInstance, debugLogger)
+
+	// Create and register gitlab_get_log_level tool
+	setupGetLogLevelTool(s, appInstance, debugLogger)
+
+	// Create and register gitlab_set_log_level tool
+	setupSetLogLevelTool(s, appInstance, debugLogger)
+
+	// Expose issues, merge requests, and pipelines as subscribable MCP resources.
+	setupGitLabResources(s, appInstance, debugLogger)
 
-	// Start the stdio server
-	if err := server.ServeStdio(s); err != nil {
+	// Start the server on the requested transport
+	if err := runServer(s, *transport, *listen, debugLogger); err != nil {
 		fmt.Fprintf(os.Stderr, "Server error: %v\n", err)
 		os.Exit(1)
 	}